@@ -1,10 +1,19 @@
 package main
 
 import (
+	"errors"
+	"io"
+	iofs "io/fs"
 	"main/pkg"
+	"main/pkg/address_list_manager"
+	"main/pkg/cache"
+	"main/pkg/chain_registry"
 	configPkg "main/pkg/config"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
+	"main/pkg/registry"
+	"os"
 
 	"github.com/spf13/cobra"
 )
@@ -27,6 +36,19 @@ func ExecuteValidateConfig(configPath string) {
 		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Could not load config!")
 	}
 
+	if err := registry.ValidateChainsParsersConfig(config.Chains); err != nil {
+		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Invalid parsers config!")
+	}
+
+	addressListManager := address_list_manager.NewAddressListManager(
+		loggerPkg.GetDefaultLogger(),
+		config,
+		filesystem,
+	)
+	if err := addressListManager.Load(); err != nil {
+		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Could not load address lists!")
+	}
+
 	if warnings := config.DisplayWarnings(); len(warnings) > 0 {
 		for _, warning := range warnings {
 			warning.Log(loggerPkg.GetDefaultLogger())
@@ -36,8 +58,123 @@ func ExecuteValidateConfig(configPath string) {
 	loggerPkg.GetDefaultLogger().Info().Msg("Provided config is valid.")
 }
 
+func ExecuteImportChainRegistry(
+	configPath string,
+	registryPath string,
+	chains []string,
+	overwrite bool,
+) {
+	filesystem := &fs.OsFS{}
+	logger := loggerPkg.GetDefaultLogger()
+
+	// if the config does not exist yet, it's created with the chains only
+	configBytes, err := filesystem.ReadFile(configPath)
+	if err != nil && !errors.Is(err, iofs.ErrNotExist) {
+		logger.Panic().Err(err).Msg("Could not read config!")
+	}
+
+	importer := chain_registry.NewImporter(logger, os.DirFS(registryPath))
+	updatedConfigBytes, err := importer.ImportChains(configBytes, chains, overwrite)
+	if err != nil {
+		logger.Panic().Err(err).Msg("Could not import chains from chain registry!")
+	}
+
+	// writing via a temporary file, so the config is not left truncated if the write fails
+	if err := fs.WriteFileAtomically(filesystem, configPath, func(writer io.Writer) error {
+		_, err := writer.Write(updatedConfigBytes)
+		return err
+	}); err != nil {
+		logger.Panic().Err(err).Msg("Could not write config!")
+	}
+
+	logger.Info().Str("config", configPath).Msg("Imported chains from chain registry.")
+}
+
+// getCacheStorage opens the persistent cache from config and returns the entry types to work with,
+// either the ones provided or all the persisted ones.
+func getCacheStorage(configPath string, types []string) (*cache.BoltStorage, []constants.CacheEntryType) {
+	logger := loggerPkg.GetDefaultLogger()
+
+	config, err := configPkg.GetConfig(configPath, &fs.OsFS{})
+	if err != nil {
+		logger.Panic().Err(err).Msg("Could not load config!")
+	}
+
+	if config.CachePath == "" {
+		logger.Panic().Msg("Cache path is not set in config!")
+	}
+
+	entryTypes := []constants.CacheEntryType{}
+	for _, entryType := range constants.GetCacheEntryTypes() {
+		if cache.IsPersistent(entryType) {
+			entryTypes = append(entryTypes, entryType)
+		}
+	}
+
+	if len(types) > 0 {
+		entryTypes = make([]constants.CacheEntryType, len(types))
+		for index, entryType := range types {
+			entryTypes[index] = constants.CacheEntryType(entryType)
+			if !cache.IsPersistent(entryTypes[index]) {
+				logger.Panic().Str("type", entryType).Msg("Cache entries of this type are not persisted!")
+			}
+		}
+	}
+
+	storage, err := cache.NewBoltStorage(config.CachePath)
+	if err != nil {
+		logger.Panic().Err(err).Msg("Could not open cache!")
+	}
+
+	return storage, entryTypes
+}
+
+func ExecuteCacheInspect(configPath string, types []string, showKeys bool) {
+	logger := loggerPkg.GetDefaultLogger()
+	storage, entryTypes := getCacheStorage(configPath, types)
+	defer storage.Close()
+
+	for _, entryType := range entryTypes {
+		keys, err := storage.Keys(entryType)
+		if err != nil {
+			logger.Panic().Err(err).Str("type", string(entryType)).Msg("Could not read cache!")
+		}
+
+		logger.Info().Str("type", string(entryType)).Int("entries", len(keys)).Msg("Cache entries")
+
+		if !showKeys {
+			continue
+		}
+
+		for _, key := range keys {
+			logger.Info().Str("type", string(entryType)).Str("key", key).Msg("Cache entry")
+		}
+	}
+}
+
+func ExecuteCachePurge(configPath string, types []string) {
+	logger := loggerPkg.GetDefaultLogger()
+	storage, entryTypes := getCacheStorage(configPath, types)
+	defer storage.Close()
+
+	for _, entryType := range entryTypes {
+		if err := storage.Purge(entryType); err != nil {
+			logger.Panic().Err(err).Str("type", string(entryType)).Msg("Could not purge cache!")
+		}
+
+		logger.Info().Str("type", string(entryType)).Msg("Purged cache entries")
+	}
+}
+
 func main() {
-	var ConfigPath string
+	var (
+		ConfigPath   string
+		RegistryPath string
+		Chains       []string
+		Overwrite    bool
+		CacheTypes   []string
+		ShowKeys     bool
+	)
 
 	rootCmd := &cobra.Command{
 		Use:     "cosmos-transactions-bot --config [config path]",
@@ -57,13 +194,77 @@ func main() {
 		},
 	}
 
+	importChainRegistryCmd := &cobra.Command{
+		Use:     "import-chain-registry --config [config path] --registry-path [chain registry path]",
+		Long:    "Add or update chains in config from a local chain registry checkout.",
+		Version: version,
+		Run: func(cmd *cobra.Command, args []string) {
+			ExecuteImportChainRegistry(ConfigPath, RegistryPath, Chains, Overwrite)
+		},
+	}
+
+	cacheCmd := &cobra.Command{
+		Use:     "cache",
+		Long:    "Inspect or purge the persistent cache.",
+		Version: version,
+	}
+
+	cacheInspectCmd := &cobra.Command{
+		Use:     "inspect --config [config path]",
+		Long:    "Show how many entries of each type the persistent cache has.",
+		Version: version,
+		Run: func(cmd *cobra.Command, args []string) {
+			ExecuteCacheInspect(ConfigPath, CacheTypes, ShowKeys)
+		},
+	}
+
+	cachePurgeCmd := &cobra.Command{
+		Use:     "purge --config [config path]",
+		Long:    "Remove entries from the persistent cache.",
+		Version: version,
+		Run: func(cmd *cobra.Command, args []string) {
+			ExecuteCachePurge(ConfigPath, CacheTypes)
+		},
+	}
+
 	rootCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
 	_ = rootCmd.MarkPersistentFlagRequired("config")
 
 	validateConfigCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
 	_ = validateConfigCmd.MarkPersistentFlagRequired("config")
 
+	importChainRegistryCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
+	importChainRegistryCmd.PersistentFlags().StringVar(&RegistryPath, "registry-path", "", "Chain registry checkout path")
+	importChainRegistryCmd.PersistentFlags().StringSliceVar(
+		&Chains,
+		"chains",
+		nil,
+		"Chain registry names of chains to import, all chains from config are updated if omitted",
+	)
+	importChainRegistryCmd.PersistentFlags().BoolVar(
+		&Overwrite,
+		"overwrite",
+		false,
+		"Overwrite the values already set in config with the chain registry ones",
+	)
+	_ = importChainRegistryCmd.MarkPersistentFlagRequired("config")
+	_ = importChainRegistryCmd.MarkPersistentFlagRequired("registry-path")
+
+	cacheCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
+	cacheCmd.PersistentFlags().StringSliceVar(
+		&CacheTypes,
+		"types",
+		nil,
+		"Cache entry types to work with, all persisted types if omitted",
+	)
+	_ = cacheCmd.MarkPersistentFlagRequired("config")
+	cacheInspectCmd.PersistentFlags().BoolVar(&ShowKeys, "keys", false, "Also show the keys of cache entries")
+	cacheCmd.AddCommand(cacheInspectCmd)
+	cacheCmd.AddCommand(cachePurgeCmd)
+
 	rootCmd.AddCommand(validateConfigCmd)
+	rootCmd.AddCommand(importChainRegistryCmd)
+	rootCmd.AddCommand(cacheCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Could not start application")
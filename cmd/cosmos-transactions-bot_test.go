@@ -1,7 +1,10 @@
 package main
 
 import (
+	"main/pkg/cache"
+	"main/pkg/constants"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -78,3 +81,114 @@ func TestStartConfigProvided(t *testing.T) {
 	os.Args = []string{"cmd", "--config", "../assets/invalid-timezone.yml"}
 	main()
 }
+
+//nolint:paralleltest // disabled
+func TestImportChainRegistryFailed(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	os.Args = []string{
+		"cmd",
+		"import-chain-registry",
+		"--config", configPath,
+		"--registry-path", "../assets/chain-registry",
+		"--chains", "not-existing",
+	}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestImportChainRegistryOk(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	os.Args = []string{
+		"cmd",
+		"import-chain-registry",
+		"--config", configPath,
+		"--registry-path", "../assets/chain-registry",
+		"--chains", "cosmoshub,osmosis",
+	}
+	main()
+
+	configBytes, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.Contains(t, string(configBytes), "chain-id: cosmoshub-4")
+	require.Contains(t, string(configBytes), "chain-id: osmosis-1")
+}
+
+// getCacheConfigPath returns the path to a valid config with cache path set, and the cache path.
+func getCacheConfigPath(t *testing.T) (string, string) {
+	t.Helper()
+
+	configBytes, err := os.ReadFile("../assets/valid.yml")
+	require.NoError(t, err)
+
+	directory := t.TempDir()
+	cachePath := filepath.Join(directory, "cache.db")
+	configBytes = append(configBytes, []byte("\ncache: "+cachePath+"\n")...)
+
+	configPath := filepath.Join(directory, "config.yml")
+	require.NoError(t, os.WriteFile(configPath, configBytes, 0o600))
+	return configPath, cachePath
+}
+
+//nolint:paralleltest // disabled
+func TestCacheInspectNoCachePath(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "cache", "inspect", "--config", "../assets/valid.yml"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestCacheInspectInvalidType(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	configPath, _ := getCacheConfigPath(t)
+	os.Args = []string{"cmd", "cache", "inspect", "--config", configPath, "--types", "price"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestCacheInspectOk(t *testing.T) {
+	configPath, _ := getCacheConfigPath(t)
+	os.Args = []string{"cmd", "cache", "inspect", "--config", configPath, "--keys"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestCachePurgeOk(t *testing.T) {
+	configPath, cachePath := getCacheConfigPath(t)
+
+	storage, err := cache.NewBoltStorage(cachePath)
+	require.NoError(t, err)
+	require.NoError(t, storage.Set(constants.CacheEntryTypeDenomTrace, "key", []byte("value")))
+	require.NoError(t, storage.Set(constants.CacheEntryTypeIbcChannel, "key", []byte("value")))
+	require.NoError(t, storage.Close())
+
+	os.Args = []string{"cmd", "cache", "purge", "--config", configPath, "--types", "denom_trace"}
+	main()
+
+	storage, err = cache.NewBoltStorage(cachePath)
+	require.NoError(t, err)
+	defer storage.Close()
+
+	keys, err := storage.Keys(constants.CacheEntryTypeDenomTrace)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	keys, err = storage.Keys(constants.CacheEntryTypeIbcChannel)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+}
@@ -0,0 +1,230 @@
+package address_list_manager
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"main/pkg/config"
+	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
+	"main/pkg/filter"
+	"main/pkg/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+)
+
+type AddressListManager struct {
+	Logger        zerolog.Logger
+	Config        configTypes.AddressLists
+	Subscriptions configTypes.Subscriptions
+	Lists         filter.AddressLists
+	FS            fs.FS
+
+	// last file contents per list, to only update lists whose files changed
+	contents map[string][]byte
+	mutex    sync.Mutex
+
+	stopChannel chan bool
+}
+
+func NewAddressListManager(
+	logger *zerolog.Logger,
+	config *config.AppConfig,
+	fs fs.FS,
+) *AddressListManager {
+	return &AddressListManager{
+		Logger:        logger.With().Str("component", "address_list_manager").Logger(),
+		Config:        config.AddressLists,
+		Subscriptions: config.Subscriptions,
+		Lists:         filter.AddressLists{},
+		FS:            fs,
+		contents:      map[string][]byte{},
+		stopChannel:   make(chan bool),
+	}
+}
+
+// Load loads all the address lists and binds them to filters referencing them.
+// Unlike reloading, it fails if any of the lists cannot be loaded, as filters
+// would not work as expected without them.
+func (m *AddressListManager) Load() error {
+	for _, listConfig := range m.Config {
+		addresses, err := m.GetAddresses(listConfig)
+		if err != nil {
+			return fmt.Errorf("error loading address list %s: %s", listConfig.Name, err)
+		}
+
+		m.Lists[listConfig.Name] = filter.NewAddressList(listConfig.Name, addresses)
+		m.Logger.Info().
+			Str("name", listConfig.Name).
+			Int("count", len(addresses)).
+			Msg("Address list loaded")
+	}
+
+	for _, subscription := range m.Subscriptions {
+		for _, chainSubscription := range subscription.ChainSubscriptions {
+			for _, filters := range []configTypes.Filters{chainSubscription.Filters, chainSubscription.TxFilters} {
+				for _, expression := range filters {
+					if err := expression.BindAddressLists(m.Lists); err != nil {
+						return fmt.Errorf(
+							"error in subscription %s chain %s: %s",
+							subscription.Name,
+							chainSubscription.Chain,
+							err,
+						)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reload rereads all the address lists loaded from files and updates
+// the ones whose files have changed. If a file cannot be read or parsed,
+// the list keeps its previous addresses.
+func (m *AddressListManager) Reload() {
+	for _, listConfig := range m.Config {
+		if listConfig.Path == "" {
+			continue
+		}
+
+		list, ok := m.Lists[listConfig.Name]
+		if !ok {
+			continue
+		}
+
+		contents, err := m.FS.ReadFile(listConfig.Path)
+		if err != nil {
+			m.Logger.Error().Err(err).Str("name", listConfig.Name).Msg("Could not reload address list")
+			continue
+		}
+
+		if !m.setContents(listConfig.Name, contents) {
+			continue
+		}
+
+		addresses, err := ParseAddresses(listConfig.Path, contents)
+		if err != nil {
+			m.Logger.Error().Err(err).Str("name", listConfig.Name).Msg("Could not reload address list")
+			continue
+		}
+
+		addresses = append(append([]string{}, listConfig.Addresses...), addresses...)
+
+		list.Set(addresses)
+		m.Logger.Info().
+			Str("name", listConfig.Name).
+			Int("count", len(addresses)).
+			Msg("Address list reloaded")
+	}
+}
+
+func (m *AddressListManager) Listen() {
+	ticker := time.NewTicker(constants.AddressListsReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.Reload()
+		case <-m.stopChannel:
+			return
+		}
+	}
+}
+
+func (m *AddressListManager) Stop() {
+	close(m.stopChannel)
+}
+
+// setContents stores file contents for a list, returning true if they have changed.
+func (m *AddressListManager) setContents(name string, contents []byte) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if previous, ok := m.contents[name]; ok && bytes.Equal(previous, contents) {
+		return false
+	}
+
+	m.contents[name] = contents
+	return true
+}
+
+// GetAddresses returns the inline addresses of the list together
+// with the ones from its file, if there's any.
+func (m *AddressListManager) GetAddresses(listConfig *configTypes.AddressList) ([]string, error) {
+	addresses := append([]string{}, listConfig.Addresses...)
+
+	if listConfig.Path == "" {
+		return addresses, nil
+	}
+
+	contents, err := m.FS.ReadFile(listConfig.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	m.setContents(listConfig.Name, contents)
+
+	fileAddresses, err := ParseAddresses(listConfig.Path, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(addresses, fileAddresses...), nil
+}
+
+// ParseAddresses parses a list of addresses from a file, depending on its extension.
+// A .csv file should have an address as the first column of each row, other columns
+// (like labels) are ignored, as well as the "address" header and lines starting with #.
+// A .yml/.yaml file should contain a list of addresses.
+func ParseAddresses(path string, contents []byte) ([]string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return parseCSVAddresses(contents)
+	case ".yml", ".yaml":
+		var addresses []string
+		if err := yaml.Unmarshal(contents, &addresses); err != nil {
+			return nil, err
+		}
+
+		return addresses, nil
+	default:
+		return nil, fmt.Errorf("unsupported address list file: %s", path)
+	}
+}
+
+func parseCSVAddresses(contents []byte) ([]string, error) {
+	reader := csv.NewReader(bytes.NewReader(contents))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	addresses := make([]string, 0)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		address := strings.TrimSpace(record[0])
+		if address == "" || strings.EqualFold(address, "address") {
+			continue
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,170 @@
+package address_list_manager_test
+
+import (
+	"errors"
+	"main/pkg/address_list_manager"
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	"main/pkg/filter"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type ChangingFs struct {
+	fs.MockFs
+	Contents []byte
+	Fail     bool
+}
+
+func (filesystem *ChangingFs) ReadFile(name string) ([]byte, error) {
+	if filesystem.Fail {
+		return nil, errors.New("read error")
+	}
+
+	return filesystem.Contents, nil
+}
+
+func TestAddressListManagerLoadOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{
+		AddressLists: configTypes.AddressLists{
+			{Name: "inline", Addresses: []string{"cosmos1inline"}},
+			{Name: "csv", Path: "address-list.csv", Addresses: []string{"cosmos1inline"}},
+			{Name: "yaml", Path: "address-list.yml"},
+		},
+		Subscriptions: configTypes.Subscriptions{
+			{
+				Name: "subscription",
+				ChainSubscriptions: configTypes.ChainSubscriptions{
+					{
+						Chain:     "chain",
+						Filters:   configTypes.Filters{*filter.MustParse("transfer.recipient IN @csv")},
+						TxFilters: configTypes.Filters{*filter.MustParse("tx.signer NOT IN @yaml")},
+					},
+				},
+			},
+		},
+	}
+
+	manager := address_list_manager.NewAddressListManager(logger, config, &fs.MockFs{})
+	require.NoError(t, manager.Load())
+	require.Len(t, manager.Lists, 3)
+	require.Equal(t, 1, manager.Lists["inline"].Len())
+	require.Equal(t, 3, manager.Lists["csv"].Len())
+	require.True(t, manager.Lists["csv"].Contains("cosmos1exchange2"))
+	require.True(t, manager.Lists["yaml"].Contains("cosmos1treasury1"))
+
+	filters := config.Subscriptions[0].ChainSubscriptions[0].Filters
+	matches, err := filters[0].Matches(map[string][]string{
+		"transfer.recipient": {"cosmos1exchange1"},
+	})
+	require.NoError(t, err)
+	require.True(t, matches)
+}
+
+func TestAddressListManagerLoadFileError(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{
+		AddressLists: configTypes.AddressLists{
+			{Name: "csv", Path: "not-existing.csv"},
+		},
+	}
+
+	manager := address_list_manager.NewAddressListManager(logger, config, &fs.MockFs{})
+	require.Error(t, manager.Load())
+}
+
+func TestAddressListManagerLoadParseError(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{
+		AddressLists: configTypes.AddressLists{
+			{Name: "csv", Path: "invalid-address-list.csv"},
+		},
+	}
+
+	manager := address_list_manager.NewAddressListManager(logger, config, &fs.MockFs{})
+	require.Error(t, manager.Load())
+}
+
+func TestAddressListManagerLoadNotDefined(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{
+		Subscriptions: configTypes.Subscriptions{
+			{
+				Name: "subscription",
+				ChainSubscriptions: configTypes.ChainSubscriptions{
+					{
+						Chain:   "chain",
+						Filters: configTypes.Filters{*filter.MustParse("transfer.recipient IN @csv")},
+					},
+				},
+			},
+		},
+	}
+
+	manager := address_list_manager.NewAddressListManager(logger, config, &fs.MockFs{})
+	require.Error(t, manager.Load())
+}
+
+func TestAddressListManagerReload(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{
+		AddressLists: configTypes.AddressLists{
+			{Name: "inline", Addresses: []string{"cosmos1inline"}},
+			{Name: "yaml", Path: "list.yml", Addresses: []string{"cosmos1inline"}},
+		},
+	}
+
+	filesystem := &ChangingFs{Contents: []byte("- cosmos1first")}
+	manager := address_list_manager.NewAddressListManager(logger, config, filesystem)
+	require.NoError(t, manager.Load())
+	require.True(t, manager.Lists["yaml"].Contains("cosmos1first"))
+
+	filesystem.Contents = []byte("- cosmos1second")
+	manager.Reload()
+	require.False(t, manager.Lists["yaml"].Contains("cosmos1first"))
+	require.True(t, manager.Lists["yaml"].Contains("cosmos1second"))
+	require.True(t, manager.Lists["yaml"].Contains("cosmos1inline"))
+
+	// invalid contents or read errors keep the previous addresses
+	filesystem.Contents = []byte("invalid")
+	manager.Reload()
+	require.True(t, manager.Lists["yaml"].Contains("cosmos1second"))
+
+	filesystem.Fail = true
+	manager.Reload()
+	require.True(t, manager.Lists["yaml"].Contains("cosmos1second"))
+}
+
+func TestAddressListManagerListenAndStop(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{}
+
+	manager := address_list_manager.NewAddressListManager(logger, config, &fs.MockFs{})
+	require.NoError(t, manager.Load())
+
+	go manager.Stop()
+	manager.Listen()
+}
+
+func TestParseAddressesUnsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := address_list_manager.ParseAddresses("list.txt", []byte("cosmos1address"))
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"main/pkg/config"
 	configTypes "main/pkg/config/types"
 	"main/pkg/fs"
+	"sync"
 
 	"gopkg.in/yaml.v3"
 
@@ -16,6 +17,10 @@ type AliasManager struct {
 	Chains  configTypes.Chains
 	Aliases AllAliases
 	FS      fs.FS
+
+	// Whether there are aliases set that failed to be saved.
+	dirty bool
+	mutex sync.RWMutex
 }
 
 func NewAliasManager(
@@ -54,11 +59,33 @@ func (m *AliasManager) Load() {
 		return
 	}
 
+	m.mutex.Lock()
 	m.Aliases = aliasesStruct.ToAliases(m.Chains, m.Logger)
+	m.mutex.Unlock()
+
 	m.Logger.Info().Msg("Aliases loaded")
 }
 
 func (m *AliasManager) Save() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.save()
+}
+
+// Flush saves the aliases if some of them were set but failed to be saved.
+func (m *AliasManager) Flush() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.Enabled() || !m.dirty {
+		return nil
+	}
+
+	return m.save()
+}
+
+func (m *AliasManager) save() error {
 	if !m.Enabled() {
 		m.Logger.Warn().Msg("Aliases path not set, not saving aliases")
 		return nil
@@ -80,10 +107,14 @@ func (m *AliasManager) Save() error {
 		return closeErr
 	}
 
+	m.dirty = false
 	return nil
 }
 
 func (m *AliasManager) Get(subscription, chain, address string) string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
 	return m.Aliases.Get(subscription, chain, address)
 }
 
@@ -100,10 +131,17 @@ func (m *AliasManager) Set(subscription, chainName, address, alias string) error
 			Msg("Could not find chain when setting an alias!")
 	}
 
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	m.Aliases.Set(subscription, chainFound, address, alias)
-	return m.Save()
+	m.dirty = true
+	return m.save()
 }
 
 func (m *AliasManager) GetAliasesLinks(subscription string) []ChainAliasesLinks {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
 	return m.Aliases.GetAliasesLinks(subscription)
 }
@@ -135,6 +135,33 @@ func TestAliasManagerSaveErrorClosing(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestAliasManagerFlush(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{
+		AliasesPath: "savefile.yml",
+		Chains: configTypes.Chains{
+			{Name: "chain"},
+		},
+	}
+	filesystem := &fs.MockFs{FailCreate: true}
+	aliasManager := alias_manager.NewAliasManager(logger, config, filesystem)
+
+	// Nothing to save.
+	require.NoError(t, aliasManager.Flush())
+
+	require.Error(t, aliasManager.Set("subscription", "chain", "wallet", "alias"))
+	require.Error(t, aliasManager.Flush())
+
+	filesystem.FailCreate = false
+	require.NoError(t, aliasManager.Flush())
+
+	// Saved already, so it's not written again.
+	filesystem.FailCreate = true
+	require.NoError(t, aliasManager.Flush())
+}
+
 func TestAliasManagerSetDisabled(t *testing.T) {
 	t.Parallel()
 
@@ -1,35 +1,52 @@
 package pkg
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	fsPkg "main/pkg/fs"
 	"main/pkg/types"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
+	"main/pkg/address_list_manager"
 	"main/pkg/alias_manager"
+	"main/pkg/cache"
 	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/cosmos_directory"
 	"main/pkg/data_fetcher"
 	filtererPkg "main/pkg/filterer"
 	loggerPkg "main/pkg/logger"
 	metricsPkg "main/pkg/metrics"
 	nodesManagerPkg "main/pkg/nodes_manager"
+	outboxPkg "main/pkg/outbox"
+	"main/pkg/pipeline"
+	"main/pkg/registry"
 	reportersPkg "main/pkg/reporters"
+	"main/pkg/state_manager"
 
 	"github.com/rs/zerolog"
 )
 
 type App struct {
-	Logger         zerolog.Logger
-	Config         *configPkg.AppConfig
-	Chains         []*configTypes.Chain
-	NodesManager   *nodesManagerPkg.NodesManager
-	Reporters      reportersPkg.Reporters
-	DataFetcher    *data_fetcher.DataFetcher
-	Filterer       *filtererPkg.Filterer
-	MetricsManager *metricsPkg.Manager
-	QuitChannel    chan os.Signal
+	Logger             zerolog.Logger
+	Config             *configPkg.AppConfig
+	Chains             []*configTypes.Chain
+	NodesManager       *nodesManagerPkg.NodesManager
+	Reporters          reportersPkg.Reporters
+	DataFetcher        *data_fetcher.DataFetcher
+	Filterer           *filtererPkg.Filterer
+	AliasManager       *alias_manager.AliasManager
+	MetricsManager     *metricsPkg.Manager
+	AddressListManager *address_list_manager.AddressListManager
+	StateManager       *state_manager.StateManager
+	Watermark          *state_manager.Watermark
+	Outbox             *outboxPkg.Outbox
+	Pipeline           *pipeline.Pipeline
+	QuitChannel        chan os.Signal
 
 	Version string
 }
@@ -39,18 +56,46 @@ func NewApp(filesystem fsPkg.FS, configPath string, version string) *App {
 	if err != nil {
 		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Could not load config")
 	}
+
+	if err := registry.ValidateChainsParsersConfig(config.Chains); err != nil {
+		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Invalid parsers config")
+	}
+
+	logger := loggerPkg.GetLogger(config.LogConfig)
+	metricsManager := metricsPkg.NewManager(logger, config.Metrics)
+
+	cosmosDirectoryClient := cosmos_directory.NewClient(logger, metricsManager, config.Timeouts)
+	if err := cosmosDirectoryClient.DiscoverChains(context.Background(), config.Chains); err != nil {
+		logger.Panic().Err(err).Msg("Could not load chains from chain registry")
+	}
+
 	warnings := config.DisplayWarnings()
 
 	for _, warning := range warnings {
 		warning.Log(loggerPkg.GetDefaultLogger())
 	}
 
-	logger := loggerPkg.GetLogger(config.LogConfig)
 	aliasManager := alias_manager.NewAliasManager(logger, config, filesystem)
 	aliasManager.Load()
 
-	metricsManager := metricsPkg.NewManager(logger, config.Metrics)
-	nodesManager := nodesManagerPkg.NewNodesManager(logger, config, metricsManager)
+	addressListManager := address_list_manager.NewAddressListManager(logger, config, filesystem)
+	if err := addressListManager.Load(); err != nil {
+		logger.Panic().Err(err).Msg("Could not load address lists")
+	}
+
+	stateManager := state_manager.NewStateManager(logger, config, filesystem)
+	stateManager.Load()
+
+	filterer := filtererPkg.NewFilterer(logger, config, metricsManager)
+	for chain, height := range stateManager.GetLastBlockHeights() {
+		filterer.SetLastBlockHeight(chain, height)
+	}
+
+	nodesManager := nodesManagerPkg.NewNodesManager(logger, config, metricsManager, filterer)
+	for _, hash := range stateManager.GetDeliveredHashes() {
+		nodesManager.Queue.AddHash(hash)
+	}
+
 	dataFetcher := data_fetcher.NewDataFetcher(
 		logger,
 		config,
@@ -58,6 +103,18 @@ func NewApp(filesystem fsPkg.FS, configPath string, version string) *App {
 		metricsManager,
 	)
 
+	if config.CachePath != "" {
+		cacheStorage, err := cache.NewBoltStorage(config.CachePath)
+		if err != nil {
+			logger.Panic().Err(err).Msg("Could not open persistent cache")
+		}
+
+		dataFetcher.Cache.Storage = cacheStorage
+	}
+
+	outbox := outboxPkg.NewOutbox(logger, config, filesystem, metricsManager)
+	outbox.Load()
+
 	reporters := make([]reportersPkg.Reporter, len(config.Reporters))
 	for index, reporterConfig := range config.Reporters {
 		reporters[index] = reportersPkg.GetReporter(
@@ -68,23 +125,27 @@ func NewApp(filesystem fsPkg.FS, configPath string, version string) *App {
 			aliasManager,
 			metricsManager,
 			dataFetcher,
+			outbox,
 			version,
 		)
 	}
 
-	filterer := filtererPkg.NewFilterer(logger, config, metricsManager)
-
 	return &App{
-		Logger:         logger.With().Str("component", "app").Logger(),
-		Config:         config,
-		Chains:         config.Chains,
-		Reporters:      reporters,
-		NodesManager:   nodesManager,
-		DataFetcher:    dataFetcher,
-		Filterer:       filterer,
-		MetricsManager: metricsManager,
-		Version:        version,
-		QuitChannel:    make(chan os.Signal, 1),
+		Logger:             logger.With().Str("component", "app").Logger(),
+		Config:             config,
+		Chains:             config.Chains,
+		Reporters:          reporters,
+		NodesManager:       nodesManager,
+		DataFetcher:        dataFetcher,
+		Filterer:           filterer,
+		AliasManager:       aliasManager,
+		MetricsManager:     metricsManager,
+		AddressListManager: addressListManager,
+		StateManager:       stateManager,
+		Watermark:          state_manager.NewWatermark(),
+		Outbox:             outbox,
+		Version:            version,
+		QuitChannel:        make(chan os.Signal, 1),
 	}
 }
 
@@ -106,7 +167,28 @@ func (a *App) Start() {
 			Msg("Init reporter")
 	}
 
+	reporterNames := make([]string, len(a.Reporters))
+	for index, reporter := range a.Reporters {
+		reporterNames[index] = reporter.Name()
+	}
+
+	a.Pipeline = pipeline.NewPipeline(
+		&a.Logger,
+		a.MetricsManager,
+		reporterNames,
+		constants.ReportWorkersCount,
+		constants.ReportQueueSize,
+		a.Config.Timeouts.Enrichment,
+		a.EnrichReport,
+		a.DeliverReport,
+		a.SaveProgress,
+	)
+	a.Pipeline.Start()
+
 	a.NodesManager.Listen()
+	go a.AddressListManager.Listen()
+	go a.StateManager.Listen()
+	go a.Outbox.Listen()
 
 	signal.Notify(a.QuitChannel, os.Interrupt, syscall.SIGTERM)
 
@@ -115,8 +197,72 @@ func (a *App) Start() {
 		case rawReport := <-a.NodesManager.Channel:
 			a.ProcessReport(rawReport)
 		case <-a.QuitChannel:
-			a.NodesManager.Stop()
-			a.MetricsManager.Stop()
+			a.Stop()
+			return
+		}
+	}
+}
+
+// Stop shuts the app down gracefully: it stops receiving new reports, waits till the ones
+// already received are sent, but not longer than constants.ShutdownTimeout, then stops
+// reporters and saves everything that should survive the restart. If reports are not sent
+// in time, fetching their additional data is cancelled, so they are sent with what was fetched.
+// If they are still not sent after constants.ShutdownCancelTimeout, the app stops anyway,
+// and the outbox and cache stop accepting new data before they are saved for the last time,
+// so the reports still being sent at this point are lost.
+func (a *App) Stop() {
+	a.Logger.Info().Msg("Shutting down...")
+
+	a.NodesManager.Stop()
+
+	drained := make(chan struct{})
+	go func() {
+		a.DrainReports()
+		a.Pipeline.Stop()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		a.Logger.Info().Msg("All received reports are processed")
+	case <-time.After(constants.ShutdownTimeout):
+		a.Logger.Warn().
+			Dur("timeout", constants.ShutdownTimeout).
+			Msg("Could not process all received reports in time, cancelling fetching data for them")
+		a.Pipeline.Cancel()
+
+		select {
+		case <-drained:
+			a.Logger.Info().Msg("All received reports are processed")
+		case <-time.After(constants.ShutdownCancelTimeout):
+			a.Logger.Warn().
+				Dur("timeout", constants.ShutdownCancelTimeout).
+				Msg("Could not send all received reports in time, some of them might be lost")
+		}
+	}
+
+	for _, reporter := range a.Reporters {
+		reporter.Stop()
+	}
+
+	if err := a.AliasManager.Flush(); err != nil {
+		a.Logger.Error().Err(err).Msg("Could not save aliases on shutdown")
+	}
+
+	a.AddressListManager.Stop()
+	a.StateManager.Stop()
+	a.Outbox.Stop()
+	a.DataFetcher.Cache.Close()
+	a.MetricsManager.Stop()
+}
+
+// DrainReports processes the reports that were received from nodes but not processed yet.
+func (a *App) DrainReports() {
+	for {
+		select {
+		case rawReport := <-a.NodesManager.Channel:
+			a.ProcessReport(rawReport)
+		default:
 			return
 		}
 	}
@@ -133,25 +279,101 @@ func (a *App) ProcessReport(rawReport types.Report) {
 			Msg("Got report which is nowhere to send")
 	}
 
-	for reporterName, report := range reportablesForReporters {
-		a.Logger.Info().
-			Str("node", report.Node).
+	if height, ok := getTxHeight(rawReport); ok {
+		a.Watermark.Add(rawReport.Chain.Name, height)
+	}
+
+	a.Pipeline.Submit(rawReport, reportablesForReporters)
+}
+
+// EnrichReport fetches additional data for a report and applies the amount filters to it,
+// returning false if the report should not be sent. It also fetches the quote rates
+// of the currencies the reporter displays amounts values in, so they are not fetched while sending.
+func (a *App) EnrichReport(ctx context.Context, reporterName string, report types.Report) (types.Report, bool) {
+	a.Logger.Info().
+		Str("node", report.Node).
+		Str("chain", report.Chain.Name).
+		Str("reporter", reporterName).
+		Str("hash", report.Reportable.GetHash()).
+		Msg("Got report")
+
+	report.Reportable.GetAdditionalData(ctx, a.DataFetcher, report.Subscription.Name)
+
+	hash := report.Reportable.GetHash()
+	if report.Reportable = a.Filterer.FilterByAmounts(report); report.Reportable == nil {
+		a.Logger.Debug().
 			Str("chain", report.Chain.Name).
 			Str("reporter", reporterName).
-			Str("hash", report.Reportable.GetHash()).
-			Msg("Got report")
+			Str("hash", hash).
+			Msg("Report is filtered out by amount filters")
+		return report, false
+	}
+
+	// quote rates are only needed to display amounts values, so they are not fetched otherwise
+	if tx, ok := report.Reportable.(*types.Tx); ok && tx.GetAllAmounts().HasPrices() {
+		if reporterConfig := a.Config.Reporters.FindByName(reporterName); reporterConfig != nil {
+			report.QuoteRates = a.DataFetcher.GetQuoteRates(ctx, reporterConfig.GetQuoteCurrencies())
+		}
+	}
 
-		report.Reportable.GetAdditionalData(a.DataFetcher, report.Subscription.Name)
+	return report, true
+}
 
-		reporter := a.Reporters.FindByName(reporterName)
+func (a *App) DeliverReport(reporterName string, report types.Report) error {
+	reporter := a.Reporters.FindByName(reporterName)
 
-		if err := reporter.Send(report); err != nil {
-			a.Logger.Error().
-				Err(err).
-				Msg("Error sending report")
-			a.MetricsManager.LogReport(report, reporterName, false)
-		} else {
-			a.MetricsManager.LogReport(report, reporterName, true)
-		}
+	if err := reporter.Send(report); err != nil {
+		a.Logger.Error().
+			Err(err).
+			Msg("Error sending report")
+		a.MetricsManager.LogReport(report, reporterName, false)
+		return err
 	}
+
+	a.MetricsManager.LogReport(report, reporterName, true)
+	return nil
+}
+
+// SaveProgress stores the height up to which all the transactions are processed and the hash
+// of the processed report, so after a restart the app would backfill transactions starting
+// from this height and would not send this report again. If a reporter failed to send it,
+// it was put into the outbox, so it is only marked as delivered once the outbox is saved,
+// otherwise it could be lost if the app crashes before the outbox is saved.
+func (a *App) SaveProgress(rawReport types.Report, delivered bool) {
+	if _, ok := rawReport.Reportable.(*types.Tx); !ok {
+		return
+	}
+
+	if delivered || a.SaveOutbox() {
+		a.StateManager.AddDeliveredHash(rawReport.Reportable.GetHash())
+	}
+
+	if height, ok := getTxHeight(rawReport); ok {
+		a.StateManager.SetLastBlockHeight(
+			rawReport.Chain.Name,
+			a.Watermark.Complete(rawReport.Chain.Name, height),
+		)
+	}
+}
+
+// getTxHeight returns the height of the transaction the report is about,
+// or false if it is not about a transaction.
+func getTxHeight(report types.Report) (int64, bool) {
+	tx, ok := report.Reportable.(*types.Tx)
+	if !ok {
+		return 0, false
+	}
+
+	height, err := strconv.ParseInt(tx.Height.Value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return height, true
+}
+
+// SaveOutbox saves the outbox right away, returning whether the messages in it
+// would survive a restart.
+func (a *App) SaveOutbox() bool {
+	return a.Outbox.Enabled() && a.Outbox.Save() == nil
 }
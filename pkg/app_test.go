@@ -8,7 +8,10 @@ import (
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	metricsPkg "main/pkg/metrics"
+	"main/pkg/outbox"
+	"main/pkg/pipeline"
 	reportersPkg "main/pkg/reporters"
+	"main/pkg/state_manager"
 	"main/pkg/types"
 	"net/http"
 	"syscall"
@@ -87,6 +90,43 @@ func TestAppStart(t *testing.T) {
 	app.QuitChannel <- syscall.SIGTERM
 }
 
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAppStop(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	app := NewApp(&fs.MockFs{}, "valid.yml", "1.2.3")
+	require.NotNil(t, app)
+
+	reporter := &reportersPkg.TestReporter{ReporterName: "reporter"}
+	app.Reporters = reportersPkg.Reporters{reporter}
+	app.Pipeline = pipeline.NewPipeline(
+		&app.Logger,
+		app.MetricsManager,
+		[]string{"reporter"},
+		1,
+		10,
+		app.Config.Timeouts.Enrichment,
+		app.EnrichReport,
+		app.DeliverReport,
+		app.SaveProgress,
+	)
+	app.Pipeline.Start()
+
+	app.NodesManager.Channel <- types.Report{
+		Chain: &configTypes.Chain{Name: "cosmos"},
+		Node:  "node",
+		Reportable: &types.NodeConnectError{
+			Error: errors.New("some error"),
+		},
+	}
+
+	app.Stop()
+
+	require.Empty(t, app.NodesManager.Channel)
+	require.True(t, reporter.Stopped)
+}
+
 func TestAppProcessReport(t *testing.T) {
 	t.Parallel()
 
@@ -140,8 +180,22 @@ func TestAppProcessReport(t *testing.T) {
 		},
 		Filterer:       filterer,
 		MetricsManager: metricsManager,
+		Logger:         *logger,
 	}
 
+	app.Pipeline = pipeline.NewPipeline(
+		logger,
+		metricsManager,
+		[]string{"test-reporter", "test-reporter-2", "test-reporter-3"},
+		2,
+		10,
+		config.Timeouts.Enrichment,
+		app.EnrichReport,
+		app.DeliverReport,
+		app.SaveProgress,
+	)
+	app.Pipeline.Start()
+
 	report := types.Report{
 		Chain: &configTypes.Chain{Name: "chain"},
 		Node:  "node",
@@ -151,4 +205,63 @@ func TestAppProcessReport(t *testing.T) {
 	}
 
 	app.ProcessReport(report)
+	app.Pipeline.Stop()
+}
+
+func TestAppSaveProgress(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{
+		Chains: configTypes.Chains{
+			{Name: "chain"},
+		},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: true})
+
+	app := &App{
+		StateManager: state_manager.NewStateManager(logger, config, &fs.MockFs{}),
+		Watermark:    state_manager.NewWatermark(),
+		Outbox:       outbox.NewOutbox(logger, config, &fs.MockFs{}, metricsManager),
+	}
+	app.Watermark.Add("chain", 123)
+
+	app.SaveProgress(types.Report{
+		Chain:      &configTypes.Chain{Name: "chain"},
+		Reportable: &types.NodeConnectError{Error: errors.New("some error")},
+	}, true)
+	require.Empty(t, app.StateManager.GetDeliveredHashes())
+	require.Empty(t, app.StateManager.GetLastBlockHeights())
+
+	app.SaveProgress(types.Report{
+		Chain:      &configTypes.Chain{Name: "chain"},
+		Reportable: &types.Tx{Hash: configTypes.Link{Value: "hash"}, Height: configTypes.Link{Value: "123"}},
+	}, true)
+	require.Equal(t, []string{"hash"}, app.StateManager.GetDeliveredHashes())
+	require.Equal(t, map[string]int64{"chain": 123}, app.StateManager.GetLastBlockHeights())
+
+	// outbox is disabled, so the failed report would not survive a restart
+	app.SaveProgress(types.Report{
+		Chain:      &configTypes.Chain{Name: "chain"},
+		Reportable: &types.Tx{Hash: configTypes.Link{Value: "failed"}},
+	}, false)
+	require.Equal(t, []string{"hash"}, app.StateManager.GetDeliveredHashes())
+
+	// outbox is saved, so the failed report is going to be resent from it
+	app.Outbox.Path = "outbox.yml"
+	app.SaveProgress(types.Report{
+		Chain:      &configTypes.Chain{Name: "chain"},
+		Reportable: &types.Tx{Hash: configTypes.Link{Value: "failed"}},
+	}, false)
+	require.Equal(t, []string{"hash", "failed"}, app.StateManager.GetDeliveredHashes())
+
+	// outbox could not be saved
+	app.Outbox.FS = &fs.MockFs{FailCreate: true}
+	app.Outbox.Add("reporter", "message", errors.New("error"), 0)
+	app.SaveProgress(types.Report{
+		Chain:      &configTypes.Chain{Name: "chain"},
+		Reportable: &types.Tx{Hash: configTypes.Link{Value: "unsaved"}},
+	}, false)
+	require.Equal(t, []string{"hash", "failed"}, app.StateManager.GetDeliveredHashes())
 }
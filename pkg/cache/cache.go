@@ -1,46 +1,261 @@
 package cache
 
 import (
+	"container/list"
+	"encoding/json"
+	"main/pkg/constants"
+	"main/pkg/metrics"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
 )
 
 type CacheEntry struct {
-	Value    interface{}
-	StoredAt time.Time
+	Key       string
+	Type      constants.CacheEntryType
+	Value     interface{}
+	ExpiresAt time.Time
 }
 
+// IsExpired returns true if the entry TTL has passed. Entries with zero ExpiresAt never expire.
+func (e *CacheEntry) IsExpired() bool {
+	return !e.ExpiresAt.IsZero() && e.ExpiresAt.Before(time.Now())
+}
+
+// Cache is a concurrency-safe cache with per-entry-type TTLs. When it has MaxEntries entries,
+// adding a new one evicts the least recently used one. If Storage is set, the entries that never
+// change are also written there and read from there if they are not in memory.
 type Cache struct {
-	Logger    zerolog.Logger
-	StoreTime time.Duration
-	Entries   map[string]CacheEntry
+	Logger         zerolog.Logger
+	MetricsManager *metrics.Manager
+	MaxEntries     int
+	TTLs           map[constants.CacheEntryType]time.Duration
+	Storage        Storage
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+
+	// guards Storage from being used after it's closed, as data can still be fetched on shutdown
+	storageMutex  sync.RWMutex
+	storageClosed bool
 }
 
-func NewCache() *Cache {
+// GetDefaultTTL returns how long the entries of a given type are stored, or 0 if they never expire.
+func GetDefaultTTL(entryType constants.CacheEntryType) time.Duration {
+	switch entryType {
+	case constants.CacheEntryTypeValidator, constants.CacheEntryTypeStakingParams:
+		return 3 * time.Hour
+	case constants.CacheEntryTypeCosmosDirectoryChains:
+		return time.Hour
+	case constants.CacheEntryTypePrice:
+		return time.Minute
+	case constants.CacheEntryTypeDenomTrace,
+		constants.CacheEntryTypeIbcChannel,
+		constants.CacheEntryTypeHistoricalPrice:
+		// denom traces, channels counterparties and past prices never change
+		return 0
+	default:
+		return 10 * time.Minute
+	}
+}
+
+func NewCache(logger *zerolog.Logger, metricsManager *metrics.Manager) *Cache {
+	ttls := make(map[constants.CacheEntryType]time.Duration)
+	for _, entryType := range constants.GetCacheEntryTypes() {
+		ttls[entryType] = GetDefaultTTL(entryType)
+	}
+
 	return &Cache{
-		StoreTime: 10 * time.Minute,
-		Entries:   make(map[string]CacheEntry),
+		Logger: logger.With().
+			Str("component", "cache").
+			Logger(),
+		MetricsManager: metricsManager,
+		MaxEntries:     constants.CacheMaxEntries,
+		TTLs:           ttls,
+		entries:        make(map[string]*list.Element),
+		lru:            list.New(),
 	}
 }
 
-func (c *Cache) Get(key string) (interface{}, bool) {
-	entry, found := c.Entries[key]
+func getKey(entryType constants.CacheEntryType, key string) string {
+	return string(entryType) + ":" + key
+}
+
+func (c *Cache) Get(entryType constants.CacheEntryType, key string) (interface{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, found := c.entries[getKey(entryType, key)]
 	if !found {
+		c.MetricsManager.LogCacheMiss(entryType)
 		return nil, false
 	}
 
-	// cache expired
-	if entry.StoredAt.Add(c.StoreTime).Before(time.Now()) {
+	entry := getEntry(element)
+	if entry.IsExpired() {
+		c.remove(element)
+		c.MetricsManager.LogCacheMiss(entryType)
 		return nil, false
 	}
 
+	c.lru.MoveToFront(element)
+	c.MetricsManager.LogCacheHit(entryType)
 	return entry.Value, true
 }
 
-func (c *Cache) Set(key string, value interface{}) {
-	c.Entries[key] = CacheEntry{
-		Value:    value,
-		StoredAt: time.Now(),
+func (c *Cache) Set(entryType constants.CacheEntryType, key string, value interface{}) {
+	c.set(entryType, key, value)
+	c.persist(entryType, key, value)
+}
+
+func (c *Cache) set(entryType constants.CacheEntryType, key string, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var expiresAt time.Time
+	if ttl := c.TTLs[entryType]; ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	fullKey := getKey(entryType, key)
+
+	if element, found := c.entries[fullKey]; found {
+		entry := getEntry(element)
+		entry.Value = value
+		entry.ExpiresAt = expiresAt
+		c.lru.MoveToFront(element)
+		return
+	}
+
+	c.entries[fullKey] = c.lru.PushFront(&CacheEntry{
+		Key:       fullKey,
+		Type:      entryType,
+		Value:     value,
+		ExpiresAt: expiresAt,
+	})
+
+	for c.MaxEntries > 0 && c.lru.Len() > c.MaxEntries {
+		oldest := c.lru.Back()
+		c.remove(oldest)
+		c.MetricsManager.LogCacheEviction(getEntry(oldest).Type)
+	}
+
+	c.MetricsManager.LogCacheEntries(c.lru.Len())
+}
+
+func (c *Cache) persist(entryType constants.CacheEntryType, key string, value interface{}) {
+	if c.Storage == nil || !IsPersistent(entryType) || value == nil {
+		return
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		c.Logger.Error().Err(err).Str("type", string(entryType)).Str("key", key).Msg("Could not encode cache entry")
+		return
+	}
+
+	c.storageMutex.RLock()
+	defer c.storageMutex.RUnlock()
+
+	if c.storageClosed {
+		return
+	}
+
+	if err := c.Storage.Set(entryType, key, valueBytes); err != nil {
+		c.Logger.Error().Err(err).Str("type", string(entryType)).Str("key", key).Msg("Could not persist cache entry")
+	}
+}
+
+// Close closes the persistent storage, if there's any. Entries set after it are only kept in memory.
+func (c *Cache) Close() {
+	if c.Storage == nil {
+		return
 	}
+
+	c.storageMutex.Lock()
+	defer c.storageMutex.Unlock()
+
+	c.storageClosed = true
+
+	if err := c.Storage.Close(); err != nil {
+		c.Logger.Error().Err(err).Msg("Could not close persistent cache")
+	}
+}
+
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.lru.Len()
+}
+
+// getEntry returns the list element's entry, the list only has entries, so it never returns nil.
+func getEntry(element *list.Element) *CacheEntry {
+	entry, _ := element.Value.(*CacheEntry)
+	return entry
+}
+
+func (c *Cache) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, getEntry(element).Key)
+	c.MetricsManager.LogCacheEntries(c.lru.Len())
+}
+
+// get returns the cached value if it's present and has the expected type.
+func get[T any](c *Cache, entryType constants.CacheEntryType, key string) (T, bool) {
+	var empty T
+
+	value, found := c.Get(entryType, key)
+	if !found {
+		return load[T](c, entryType, key)
+	}
+
+	typed, ok := value.(T)
+	if !ok {
+		c.Logger.Error().
+			Str("type", string(entryType)).
+			Str("key", key).
+			Msgf("Could not convert cached value to %T", empty)
+		return empty, false
+	}
+
+	return typed, true
+}
+
+// load returns the value from the persistent storage and puts it in memory, so the next
+// reads would not hit the disk.
+func load[T any](c *Cache, entryType constants.CacheEntryType, key string) (T, bool) {
+	var value T
+
+	if c.Storage == nil || !IsPersistent(entryType) {
+		return value, false
+	}
+
+	c.storageMutex.RLock()
+	if c.storageClosed {
+		c.storageMutex.RUnlock()
+		return value, false
+	}
+
+	valueBytes, found, err := c.Storage.Get(entryType, key)
+	c.storageMutex.RUnlock()
+
+	if err != nil {
+		c.Logger.Error().Err(err).Str("type", string(entryType)).Str("key", key).Msg("Could not read persisted cache entry")
+		return value, false
+	}
+
+	if !found {
+		return value, false
+	}
+
+	if err := json.Unmarshal(valueBytes, &value); err != nil {
+		c.Logger.Error().Err(err).Str("type", string(entryType)).Str("key", key).Msg("Could not decode persisted cache entry")
+		return value, false
+	}
+
+	c.set(entryType, key, value)
+	return value, true
 }
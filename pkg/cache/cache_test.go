@@ -2,38 +2,272 @@ package cache_test
 
 import (
 	cachePkg "main/pkg/cache"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types/responses"
+	"path/filepath"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 )
 
+func getCache() *cachePkg.Cache {
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	return cachePkg.NewCache(logger, metricsManager)
+}
+
 func TestCacheSet(t *testing.T) {
 	t.Parallel()
 
-	cache := cachePkg.NewCache()
-	cache.Set("key", "value")
+	cache := getCache()
+	cache.Set(constants.CacheEntryTypeValidator, "key", "value")
 
-	entry, found := cache.Get("key")
+	entry, found := cache.Get(constants.CacheEntryTypeValidator, "key")
 	require.Equal(t, "value", entry)
 	require.True(t, found)
+
+	// same key, but different type
+	_, found = cache.Get(constants.CacheEntryTypeProposal, "key")
+	require.False(t, found)
+
+	cache.Set(constants.CacheEntryTypeValidator, "key", "value2")
+	entry, found = cache.Get(constants.CacheEntryTypeValidator, "key")
+	require.Equal(t, "value2", entry)
+	require.True(t, found)
+	require.Equal(t, 1, cache.Len())
 }
 
 func TestCacheGetNotExists(t *testing.T) {
 	t.Parallel()
 
-	cache := cachePkg.NewCache()
-	_, found := cache.Get("key")
+	cache := getCache()
+	_, found := cache.Get(constants.CacheEntryTypeValidator, "key")
 	require.False(t, found)
 }
 
 func TestCacheGetExpired(t *testing.T) {
 	t.Parallel()
 
-	cache := cachePkg.NewCache()
-	cache.Entries["key"] = cachePkg.CacheEntry{
-		Value: "test", StoredAt: time.Now().Add(-24 * time.Hour),
+	cache := getCache()
+	cache.TTLs[constants.CacheEntryTypePrice] = time.Nanosecond
+	cache.Set(constants.CacheEntryTypePrice, "key", 1.0)
+
+	time.Sleep(time.Millisecond)
+
+	_, found := cache.Get(constants.CacheEntryTypePrice, "key")
+	require.False(t, found)
+	require.Zero(t, cache.Len())
+}
+
+func TestCacheNeverExpires(t *testing.T) {
+	t.Parallel()
+
+	require.Zero(t, cachePkg.GetDefaultTTL(constants.CacheEntryTypeDenomTrace))
+	require.Equal(t, time.Minute, cachePkg.GetDefaultTTL(constants.CacheEntryTypePrice))
+	require.Equal(t, 3*time.Hour, cachePkg.GetDefaultTTL(constants.CacheEntryTypeValidator))
+	require.Equal(t, 10*time.Minute, cachePkg.GetDefaultTTL(constants.CacheEntryTypeProposal))
+
+	entry := cachePkg.CacheEntry{}
+	require.False(t, entry.IsExpired())
+
+	entry.ExpiresAt = time.Now().Add(-time.Second)
+	require.True(t, entry.IsExpired())
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	cache := getCache()
+	cache.MaxEntries = 2
+
+	cache.Set(constants.CacheEntryTypeValidator, "first", 1)
+	cache.Set(constants.CacheEntryTypeValidator, "second", 2)
+
+	// so the second one becomes the least recently used one
+	_, found := cache.Get(constants.CacheEntryTypeValidator, "first")
+	require.True(t, found)
+
+	cache.Set(constants.CacheEntryTypeValidator, "third", 3)
+	require.Equal(t, 2, cache.Len())
+
+	_, found = cache.Get(constants.CacheEntryTypeValidator, "second")
+	require.False(t, found)
+
+	_, found = cache.Get(constants.CacheEntryTypeValidator, "first")
+	require.True(t, found)
+
+	_, found = cache.Get(constants.CacheEntryTypeValidator, "third")
+	require.True(t, found)
+}
+
+func TestCacheConcurrentAccess(t *testing.T) {
+	t.Parallel()
+
+	cache := getCache()
+	cache.MaxEntries = 10
+
+	var wg sync.WaitGroup
+	for index := 0; index < 50; index++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			cache.SetPrice("chain", "denom", float64(index))
+			cache.GetPrice("chain", "denom")
+			cache.SetValidator("chain", "validator"+strconv.Itoa(index), &responses.Validator{})
+		}(index)
 	}
-	_, found := cache.Get("key")
+
+	wg.Wait()
+	require.LessOrEqual(t, cache.Len(), 10)
+}
+
+func TestCacheTypedGetWrongType(t *testing.T) {
+	t.Parallel()
+
+	cache := getCache()
+	cache.Set(constants.CacheEntryTypeValidator, "chain_address", "not a validator")
+
+	validator, found := cache.GetValidator("chain", "address")
+	require.False(t, found)
+	require.Nil(t, validator)
+}
+
+func TestCacheTypedGetters(t *testing.T) {
+	t.Parallel()
+
+	cache := getCache()
+
+	cache.SetValidator("chain", "address", &responses.Validator{OperatorAddress: "address"})
+	validator, found := cache.GetValidator("chain", "address")
+	require.True(t, found)
+	require.Equal(t, "address", validator.OperatorAddress)
+
+	cache.SetProposal("chain", "1", &responses.Proposal{ProposalID: "1"})
+	proposal, found := cache.GetProposal("chain", "1")
+	require.True(t, found)
+	require.Equal(t, "1", proposal.ProposalID)
+
+	cache.SetPrice("chain-id", "uatom", 6.7)
+	price, found := cache.GetPrice("chain-id", "uatom")
+	require.True(t, found)
+	require.InDelta(t, 6.7, price, 0.001)
+
+	cache.SetHistoricalPrice("chain-id", "uatom", 1672574400, 10.2)
+	historicalPrice, found := cache.GetHistoricalPrice("chain-id", "uatom", 1672574400)
+	require.True(t, found)
+	require.InDelta(t, 10.2, historicalPrice, 0.001)
+
+	_, found = cache.GetHistoricalPrice("chain-id", "uatom", 1672574460)
+	require.False(t, found)
+
+	cache.SetDenomTrace("chain", "hash", &transferTypes.DenomTrace{BaseDenom: "uatom"})
+	trace, found := cache.GetDenomTrace("chain", "hash")
+	require.True(t, found)
+	require.Equal(t, "uatom", trace.BaseDenom)
+
+	cache.SetIbcRemoteChainID("chain", "channel-0", "transfer", "osmosis-1")
+	remoteChainID, found := cache.GetIbcRemoteChainID("chain", "channel-0", "transfer")
+	require.True(t, found)
+	require.Equal(t, "osmosis-1", remoteChainID)
+
+	cache.SetStakingParams("chain", &responses.StakingParams{})
+	_, found = cache.GetStakingParams("chain")
+	require.True(t, found)
+
+	cache.SetRewards("chain", "delegator", "validator", 100, []responses.Reward{{Denom: "uatom"}})
+	rewards, found := cache.GetRewards("chain", "delegator", "validator", 100)
+	require.True(t, found)
+	require.Len(t, rewards, 1)
+
+	_, found = cache.GetRewards("chain", "delegator", "validator", 101)
+	require.False(t, found)
+
+	cache.SetCommission("chain", "validator", 100, []responses.Commission{{Denom: "uatom"}})
+	commission, found := cache.GetCommission("chain", "validator", 100)
+	require.True(t, found)
+	require.Len(t, commission, 1)
+
+	cache.SetCosmosDirectoryChains(responses.CosmosDirectoryChains{{ChainID: "chain-id"}})
+	chains, found := cache.GetCosmosDirectoryChains()
+	require.True(t, found)
+	require.Len(t, chains, 1)
+}
+
+func TestCachePersistent(t *testing.T) {
+	t.Parallel()
+
+	storage := getStorage(t)
+
+	cache := getCache()
+	cache.Storage = storage
+
+	cache.SetDenomTrace("chain", "hash", &transferTypes.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-0"})
+	cache.SetRewards("chain", "delegator", "validator", 100, []responses.Reward{{Denom: "uatom", Amount: "1"}})
+	cache.SetPrice("chain-id", "uatom", 6.7)
+	cache.Set(constants.CacheEntryTypeIbcChannel, "chain_channel-1_transfer", nil)
+
+	// as if the app was restarted
+	restartedCache := getCache()
+	restartedCache.Storage = storage
+
+	trace, found := restartedCache.GetDenomTrace("chain", "hash")
+	require.True(t, found)
+	require.Equal(t, "uatom", trace.BaseDenom)
+	require.Equal(t, "transfer/channel-0", trace.Path)
+	require.Equal(t, 1, restartedCache.Len())
+
+	rewards, found := restartedCache.GetRewards("chain", "delegator", "validator", 100)
+	require.True(t, found)
+	require.Equal(t, []responses.Reward{{Denom: "uatom", Amount: "1"}}, rewards)
+
+	// prices change, so they are not persisted, same as failed queries results
+	_, found = restartedCache.GetPrice("chain-id", "uatom")
+	require.False(t, found)
+
+	_, found = restartedCache.GetIbcRemoteChainID("chain", "channel-1", "transfer")
+	require.False(t, found)
+}
+
+func TestCachePersistentInvalid(t *testing.T) {
+	t.Parallel()
+
+	storage := getStorage(t)
+	require.NoError(t, storage.Set(constants.CacheEntryTypeDenomTrace, "chain_hash", []byte("invalid")))
+
+	cache := getCache()
+	cache.Storage = storage
+
+	_, found := cache.GetDenomTrace("chain", "hash")
+	require.False(t, found)
+}
+
+func TestCacheClose(t *testing.T) {
+	t.Parallel()
+
+	getCache().Close()
+
+	storage, err := cachePkg.NewBoltStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+
+	cache := getCache()
+	cache.Storage = storage
+	cache.Close()
+
+	require.Error(t, storage.Set(constants.CacheEntryTypeDenomTrace, "key", []byte("value")))
+
+	// data fetched after the storage is closed is only kept in memory
+	cache.SetDenomTrace("chain", "hash", &transferTypes.DenomTrace{BaseDenom: "uatom"})
+	trace, found := cache.GetDenomTrace("chain", "hash")
+	require.True(t, found)
+	require.Equal(t, "uatom", trace.BaseDenom)
+
+	_, found = cache.GetDenomTrace("chain", "other-hash")
 	require.False(t, found)
 }
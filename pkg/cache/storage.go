@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Storage persists cache entries between restarts.
+type Storage interface {
+	Get(entryType constants.CacheEntryType, key string) ([]byte, bool, error)
+	Set(entryType constants.CacheEntryType, key string, value []byte) error
+	Keys(entryType constants.CacheEntryType) ([]string, error)
+	Purge(entryType constants.CacheEntryType) error
+	Close() error
+}
+
+// IsPersistent returns true if the entries of a given type never change once fetched,
+// so they can be stored on disk and reused after a restart.
+func IsPersistent(entryType constants.CacheEntryType) bool {
+	switch entryType {
+	case constants.CacheEntryTypeDenomTrace,
+		constants.CacheEntryTypeIbcChannel,
+		constants.CacheEntryTypeRewards,
+		constants.CacheEntryTypeCommission,
+		constants.CacheEntryTypeHistoricalPrice:
+		return true
+	default:
+		return false
+	}
+}
+
+// BoltStorage is a Storage keeping entries in a bbolt database file, with a bucket per entry type.
+type BoltStorage struct {
+	db *bolt.DB
+}
+
+func NewBoltStorage(path string) (*BoltStorage, error) {
+	// bbolt locks the file, so if another process (like the running bot) has it open,
+	// it fails after a timeout instead of waiting forever
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("could not open cache at %s: %s", path, err)
+	}
+
+	return &BoltStorage{db: db}, nil
+}
+
+func (s *BoltStorage) Get(entryType constants.CacheEntryType, key string) ([]byte, bool, error) {
+	var value []byte
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(entryType))
+		if bucket == nil {
+			return nil
+		}
+
+		// bbolt values are only valid during the transaction
+		if stored := bucket.Get([]byte(key)); stored != nil {
+			value = append([]byte{}, stored...)
+		}
+
+		return nil
+	})
+
+	return value, value != nil, err
+}
+
+func (s *BoltStorage) Set(entryType constants.CacheEntryType, key string, value []byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(entryType))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(key), value)
+	})
+}
+
+func (s *BoltStorage) Keys(entryType constants.CacheEntryType) ([]string, error) {
+	keys := []string{}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(entryType))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(key, _ []byte) error {
+			keys = append(keys, string(key))
+			return nil
+		})
+	})
+
+	return keys, err
+}
+
+func (s *BoltStorage) Purge(entryType constants.CacheEntryType) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket([]byte(entryType)) == nil {
+			return nil
+		}
+
+		return tx.DeleteBucket([]byte(entryType))
+	})
+}
+
+func (s *BoltStorage) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,91 @@
+package cache_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	cachePkg "main/pkg/cache"
+	"main/pkg/constants"
+
+	"github.com/stretchr/testify/require"
+)
+
+func getStorage(t *testing.T) *cachePkg.BoltStorage {
+	t.Helper()
+
+	storage, err := cachePkg.NewBoltStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = storage.Close()
+	})
+
+	return storage
+}
+
+func TestBoltStorageOpenFail(t *testing.T) {
+	t.Parallel()
+
+	_, err := cachePkg.NewBoltStorage(filepath.Join(t.TempDir(), "not-existing", "cache.db"))
+	require.Error(t, err)
+}
+
+func TestBoltStorageSetGet(t *testing.T) {
+	t.Parallel()
+
+	storage := getStorage(t)
+
+	_, found, err := storage.Get(constants.CacheEntryTypeDenomTrace, "key")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, storage.Set(constants.CacheEntryTypeDenomTrace, "key", []byte("value")))
+
+	value, found, err := storage.Get(constants.CacheEntryTypeDenomTrace, "key")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("value"), value)
+
+	// same key, but different type
+	_, found, err = storage.Get(constants.CacheEntryTypeIbcChannel, "key")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestBoltStorageKeysPurge(t *testing.T) {
+	t.Parallel()
+
+	storage := getStorage(t)
+
+	keys, err := storage.Keys(constants.CacheEntryTypeRewards)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	require.NoError(t, storage.Set(constants.CacheEntryTypeRewards, "first", []byte("1")))
+	require.NoError(t, storage.Set(constants.CacheEntryTypeRewards, "second", []byte("2")))
+	require.NoError(t, storage.Set(constants.CacheEntryTypeCommission, "third", []byte("3")))
+
+	keys, err = storage.Keys(constants.CacheEntryTypeRewards)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, keys)
+
+	require.NoError(t, storage.Purge(constants.CacheEntryTypeRewards))
+	require.NoError(t, storage.Purge(constants.CacheEntryTypeDenomTrace))
+
+	keys, err = storage.Keys(constants.CacheEntryTypeRewards)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	keys, err = storage.Keys(constants.CacheEntryTypeCommission)
+	require.NoError(t, err)
+	require.Equal(t, []string{"third"}, keys)
+}
+
+func TestIsPersistent(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, cachePkg.IsPersistent(constants.CacheEntryTypeDenomTrace))
+	require.True(t, cachePkg.IsPersistent(constants.CacheEntryTypeIbcChannel))
+	require.True(t, cachePkg.IsPersistent(constants.CacheEntryTypeHistoricalPrice))
+	require.False(t, cachePkg.IsPersistent(constants.CacheEntryTypePrice))
+	require.False(t, cachePkg.IsPersistent(constants.CacheEntryTypeValidator))
+}
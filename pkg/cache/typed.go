@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types/amount"
+	"main/pkg/types/responses"
+	"strconv"
+
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+func (c *Cache) GetValidator(chain, address string) (*responses.Validator, bool) {
+	return get[*responses.Validator](c, constants.CacheEntryTypeValidator, chain+"_"+address)
+}
+
+func (c *Cache) SetValidator(chain, address string, validator *responses.Validator) {
+	c.Set(constants.CacheEntryTypeValidator, chain+"_"+address, validator)
+}
+
+func (c *Cache) GetProposal(chain, id string) (*responses.Proposal, bool) {
+	return get[*responses.Proposal](c, constants.CacheEntryTypeProposal, chain+"_"+id)
+}
+
+func (c *Cache) SetProposal(chain, id string, proposal *responses.Proposal) {
+	c.Set(constants.CacheEntryTypeProposal, chain+"_"+id, proposal)
+}
+
+func (c *Cache) GetPrice(chainID, denom string) (float64, bool) {
+	return get[float64](c, constants.CacheEntryTypePrice, chainID+"_"+denom)
+}
+
+func (c *Cache) SetPrice(chainID, denom string, price float64) {
+	c.Set(constants.CacheEntryTypePrice, chainID+"_"+denom, price)
+}
+
+// GetHistoricalPrice returns the denom price at the given time, as a Unix timestamp.
+func (c *Cache) GetHistoricalPrice(chainID, denom string, timestamp int64) (float64, bool) {
+	key := chainID + "_" + denom + "_" + strconv.FormatInt(timestamp, 10)
+	return get[float64](c, constants.CacheEntryTypeHistoricalPrice, key)
+}
+
+func (c *Cache) SetHistoricalPrice(chainID, denom string, timestamp int64, price float64) {
+	key := chainID + "_" + denom + "_" + strconv.FormatInt(timestamp, 10)
+	c.Set(constants.CacheEntryTypeHistoricalPrice, key, price)
+}
+
+// GetQuoteRate returns how much of the quote currency one USD is worth. These are prices too,
+// so they expire as fast as the denoms prices do.
+func (c *Cache) GetQuoteRate(currency string) (*amount.QuoteRate, bool) {
+	return get[*amount.QuoteRate](c, constants.CacheEntryTypePrice, "quote_"+currency)
+}
+
+func (c *Cache) SetQuoteRate(currency string, rate *amount.QuoteRate) {
+	c.Set(constants.CacheEntryTypePrice, "quote_"+currency, rate)
+}
+
+func (c *Cache) GetDenomTrace(chain, hash string) (*transferTypes.DenomTrace, bool) {
+	return get[*transferTypes.DenomTrace](c, constants.CacheEntryTypeDenomTrace, chain+"_"+hash)
+}
+
+func (c *Cache) SetDenomTrace(chain, hash string, trace *transferTypes.DenomTrace) {
+	c.Set(constants.CacheEntryTypeDenomTrace, chain+"_"+hash, trace)
+}
+
+// GetIbcRemoteChainID returns the chain ID of the chain on the other side of the IBC channel.
+func (c *Cache) GetIbcRemoteChainID(chain, channel, port string) (string, bool) {
+	return get[string](c, constants.CacheEntryTypeIbcChannel, chain+"_"+channel+"_"+port)
+}
+
+func (c *Cache) SetIbcRemoteChainID(chain, channel, port, remoteChainID string) {
+	c.Set(constants.CacheEntryTypeIbcChannel, chain+"_"+channel+"_"+port, remoteChainID)
+}
+
+func (c *Cache) GetStakingParams(chain string) (*responses.StakingParams, bool) {
+	return get[*responses.StakingParams](c, constants.CacheEntryTypeStakingParams, chain)
+}
+
+func (c *Cache) SetStakingParams(chain string, params *responses.StakingParams) {
+	c.Set(constants.CacheEntryTypeStakingParams, chain, params)
+}
+
+func (c *Cache) GetRewards(chain, delegator, validator string, block int64) ([]responses.Reward, bool) {
+	key := chain + "_" + delegator + "_" + validator + "_" + strconv.FormatInt(block, 10)
+	return get[[]responses.Reward](c, constants.CacheEntryTypeRewards, key)
+}
+
+func (c *Cache) SetRewards(chain, delegator, validator string, block int64, rewards []responses.Reward) {
+	key := chain + "_" + delegator + "_" + validator + "_" + strconv.FormatInt(block, 10)
+	c.Set(constants.CacheEntryTypeRewards, key, rewards)
+}
+
+func (c *Cache) GetCommission(chain, validator string, block int64) ([]responses.Commission, bool) {
+	key := chain + "_" + validator + "_" + strconv.FormatInt(block, 10)
+	return get[[]responses.Commission](c, constants.CacheEntryTypeCommission, key)
+}
+
+func (c *Cache) SetCommission(chain, validator string, block int64, commission []responses.Commission) {
+	key := chain + "_" + validator + "_" + strconv.FormatInt(block, 10)
+	c.Set(constants.CacheEntryTypeCommission, key, commission)
+}
+
+func (c *Cache) GetCosmosDirectoryChains() (responses.CosmosDirectoryChains, bool) {
+	return get[responses.CosmosDirectoryChains](c, constants.CacheEntryTypeCosmosDirectoryChains, "")
+}
+
+func (c *Cache) SetCosmosDirectoryChains(chains responses.CosmosDirectoryChains) {
+	c.Set(constants.CacheEntryTypeCosmosDirectoryChains, "", chains)
+}
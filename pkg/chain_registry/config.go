@@ -0,0 +1,228 @@
+package chain_registry
+
+import (
+	"bytes"
+	"fmt"
+	configTypes "main/pkg/config/types"
+	yamlConfig "main/pkg/config/yaml_config"
+	"main/pkg/constants"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ImportChains adds the chains with the given chain registry names to the config,
+// or updates them if the config already has them (matching by chain-registry-name or name).
+// If no names are passed, all the chains from the config are updated.
+// Only the fields that are taken from the chain registry are changed, and only
+// if they are not set yet, unless overwrite is true. The rest of the config,
+// including comments, is kept as is.
+func (i *Importer) ImportChains(configBytes []byte, names []string, overwrite bool) ([]byte, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(configBytes, &document); err != nil {
+		return nil, fmt.Errorf("error parsing config: %s", err)
+	}
+
+	if document.Kind == 0 {
+		document = yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}},
+		}
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("config is not a YAML mapping")
+	}
+
+	chainsNode := getMappingValue(root, "chains")
+	if chainsNode == nil {
+		chainsNode = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		root.Content = append(root.Content, newStringNode("chains"), chainsNode)
+	} else if chainsNode.Kind == yaml.ScalarNode && chainsNode.Tag == "!!null" {
+		// "chains:" without any chains
+		chainsNode.Kind = yaml.SequenceNode
+		chainsNode.Tag = "!!seq"
+		chainsNode.Value = ""
+	} else if chainsNode.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("chains is not a YAML list")
+	}
+
+	if len(names) == 0 {
+		for _, chainNode := range chainsNode.Content {
+			names = append(names, getChainRegistryName(chainNode))
+		}
+	}
+
+	for _, name := range names {
+		chainNode := findChainNode(chainsNode, name)
+		if chainNode == nil {
+			chainNode = &yaml.Node{
+				Kind:    yaml.MappingNode,
+				Tag:     "!!map",
+				Content: []*yaml.Node{newStringNode("name"), newStringNode(name)},
+			}
+			chainsNode.Content = append(chainsNode.Content, chainNode)
+		}
+
+		apiType := constants.ApiTypeRest
+		if apiTypeNode := getMappingValue(chainNode, "api-type"); apiTypeNode != nil {
+			apiType = apiTypeNode.Value
+		}
+
+		chain, err := i.GetAppConfigChain(name, apiType)
+		if err != nil {
+			return nil, fmt.Errorf("error importing chain %s: %s", name, err)
+		}
+
+		if err := updateChainNode(chainNode, chain, overwrite); err != nil {
+			return nil, fmt.Errorf("error updating chain %s: %s", name, err)
+		}
+
+		i.Logger.Info().
+			Str("chain", name).
+			Str("chain_id", chain.ChainID).
+			Int("denoms", len(chain.Denoms)).
+			Int("ibc_channels", len(chain.IbcChannels)).
+			Msg("Imported chain from chain registry")
+	}
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(&document); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func updateChainNode(node *yaml.Node, chain *configTypes.Chain, overwrite bool) error {
+	yamlChain := yamlConfig.FromAppConfigChain(chain)
+
+	values := []struct {
+		key   string
+		value interface{}
+		empty bool
+	}{
+		{"chain-id", yamlChain.ChainID, yamlChain.ChainID == ""},
+		{"pretty-name", yamlChain.PrettyName, yamlChain.PrettyName == ""},
+		{"tendermint-nodes", yamlChain.TendermintNodes, len(yamlChain.TendermintNodes) == 0},
+		{"api-nodes", yamlChain.APINodes, len(yamlChain.APINodes) == 0},
+		{"grpc-nodes", yamlChain.GrpcNodes, len(yamlChain.GrpcNodes) == 0},
+		{"denoms", yamlChain.Denoms, len(yamlChain.Denoms) == 0},
+		{"ibc-channels", yamlChain.IbcChannels, len(yamlChain.IbcChannels) == 0},
+		{"ibc-denoms", yamlChain.IbcDenoms, len(yamlChain.IbcDenoms) == 0},
+	}
+
+	for _, value := range values {
+		if value.empty {
+			continue
+		}
+
+		if err := setMappingValue(node, value.key, value.value, overwrite); err != nil {
+			return err
+		}
+	}
+
+	// explorer can be set in different ways, so if any of them is set, it's not replaced
+	explorerKeys := []string{"mintscan-prefix", "ping-prefix", "ping-base-url", "explorer"}
+	for _, key := range explorerKeys {
+		if getMappingValue(node, key) != nil && !overwrite {
+			return nil
+		}
+	}
+
+	for _, key := range explorerKeys {
+		deleteMappingValue(node, key)
+	}
+
+	switch {
+	case yamlChain.MintscanPrefix != "":
+		return setMappingValue(node, "mintscan-prefix", yamlChain.MintscanPrefix, true)
+	case yamlChain.PingPrefix != "":
+		if err := setMappingValue(node, "ping-prefix", yamlChain.PingPrefix, true); err != nil {
+			return err
+		}
+
+		return setMappingValue(node, "ping-base-url", yamlChain.PingBaseUrl, true)
+	case yamlChain.Explorer != nil:
+		return setMappingValue(node, "explorer", yamlChain.Explorer, true)
+	}
+
+	return nil
+}
+
+func findChainNode(chainsNode *yaml.Node, name string) *yaml.Node {
+	for _, chainNode := range chainsNode.Content {
+		if getChainRegistryName(chainNode) == name {
+			return chainNode
+		}
+	}
+
+	return nil
+}
+
+// getChainRegistryName returns chain-registry-name if it's set, or the chain name otherwise.
+func getChainRegistryName(chainNode *yaml.Node) string {
+	if node := getMappingValue(chainNode, "chain-registry-name"); node != nil && node.Value != "" {
+		return node.Value
+	}
+
+	if node := getMappingValue(chainNode, "name"); node != nil {
+		return node.Value
+	}
+
+	return ""
+}
+
+func getMappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for index := 0; index+1 < len(mapping.Content); index += 2 {
+		if mapping.Content[index].Value == key {
+			return mapping.Content[index+1]
+		}
+	}
+
+	return nil
+}
+
+func setMappingValue(mapping *yaml.Node, key string, value interface{}, overwrite bool) error {
+	valueNode := &yaml.Node{}
+	if err := valueNode.Encode(value); err != nil {
+		return err
+	}
+
+	for index := 0; index+1 < len(mapping.Content); index += 2 {
+		if mapping.Content[index].Value == key {
+			if overwrite {
+				mapping.Content[index+1] = valueNode
+			}
+
+			return nil
+		}
+	}
+
+	mapping.Content = append(mapping.Content, newStringNode(key), valueNode)
+	return nil
+}
+
+func deleteMappingValue(mapping *yaml.Node, key string) {
+	for index := 0; index+1 < len(mapping.Content); index += 2 {
+		if mapping.Content[index].Value == key {
+			mapping.Content = append(mapping.Content[:index], mapping.Content[index+2:]...)
+			return
+		}
+	}
+}
+
+func newStringNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
@@ -0,0 +1,137 @@
+package chain_registry_test
+
+import (
+	"main/pkg/config/yaml_config"
+	"testing"
+
+	"github.com/creasty/defaults"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func parseConfig(t *testing.T, configBytes []byte) *yaml_config.YamlConfig {
+	t.Helper()
+
+	config := &yaml_config.YamlConfig{}
+	require.NoError(t, yaml.Unmarshal(configBytes, config))
+	defaults.MustSet(config)
+	return config
+}
+
+func TestImportChainsNewConfig(t *testing.T) {
+	t.Parallel()
+
+	importer := getImporter(getRegistryFS())
+
+	configBytes, err := importer.ImportChains(nil, []string{"cosmoshub", "osmosis"}, false)
+	require.NoError(t, err)
+
+	config := parseConfig(t, configBytes)
+	require.Len(t, config.Chains, 2)
+
+	cosmos := config.Chains[0]
+	require.Equal(t, "cosmoshub", cosmos.Name)
+	require.Equal(t, "cosmoshub-4", cosmos.ChainID)
+	require.Equal(t, "Cosmos Hub", cosmos.PrettyName)
+	require.Equal(t, []string{
+		"https://cosmos-rpc.polkachu.com",
+		"https://rpc-cosmoshub.blockapsis.com",
+	}, cosmos.TendermintNodes)
+	require.Equal(t, []string{"https://cosmos-api.polkachu.com"}, cosmos.APINodes)
+	require.Empty(t, cosmos.GrpcNodes)
+	require.Equal(t, "cosmos", cosmos.MintscanPrefix)
+	require.Nil(t, cosmos.Explorer)
+	require.Len(t, cosmos.Denoms, 1)
+	require.Equal(t, map[string]string{"channel-141": "osmosis-1"}, cosmos.IbcChannels)
+
+	osmosis := config.Chains[1]
+	require.Equal(t, "osmosis", osmosis.Name)
+	require.Equal(t, "osmosis-1", osmosis.ChainID)
+	require.Empty(t, osmosis.MintscanPrefix)
+	require.NotNil(t, osmosis.Explorer)
+	require.Len(t, osmosis.Denoms, 2)
+	require.Equal(t, map[string]string{"channel-0": "cosmoshub-4"}, osmosis.IbcChannels)
+	require.Len(t, osmosis.IbcDenoms, 1)
+	require.Empty(t, cosmos.IbcDenoms)
+	require.NoError(t, cosmos.Validate())
+	require.NoError(t, osmosis.Validate())
+}
+
+func TestImportChainsUpdateConfig(t *testing.T) {
+	t.Parallel()
+
+	importer := getImporter(getRegistryFS())
+
+	configBytes := []byte(`
+# comment to keep
+aliases: aliases.yml
+chains:
+  - name: cosmos
+    # registry name
+    chain-registry-name: cosmoshub
+    tendermint-nodes:
+      - https://rpc.example.com
+    api-type: grpc
+    ping-prefix: cosmos
+    queries:
+      - tx.height > 1
+`)
+
+	updatedBytes, err := importer.ImportChains(configBytes, nil, false)
+	require.NoError(t, err)
+	require.Contains(t, string(updatedBytes), "# comment to keep")
+	require.Contains(t, string(updatedBytes), "# registry name")
+
+	config := parseConfig(t, updatedBytes)
+	require.Equal(t, "aliases.yml", config.AliasesPath)
+	require.Len(t, config.Chains, 1)
+
+	chain := config.Chains[0]
+	require.Equal(t, "cosmos", chain.Name)
+	require.Equal(t, "cosmoshub-4", chain.ChainID)
+	require.Equal(t, []string{"https://rpc.example.com"}, chain.TendermintNodes)
+	require.Equal(t, []string{"cosmos-grpc.polkachu.com:14990"}, chain.GrpcNodes)
+	require.Empty(t, chain.APINodes)
+	require.Equal(t, "cosmos", chain.PingPrefix)
+	require.Empty(t, chain.MintscanPrefix)
+	require.Equal(t, []string{"tx.height > 1"}, chain.Queries)
+
+	overwrittenBytes, err := importer.ImportChains(configBytes, nil, true)
+	require.NoError(t, err)
+
+	chain = parseConfig(t, overwrittenBytes).Chains[0]
+	require.Equal(t, []string{
+		"https://cosmos-rpc.polkachu.com",
+		"https://rpc-cosmoshub.blockapsis.com",
+	}, chain.TendermintNodes)
+	require.Empty(t, chain.PingPrefix)
+	require.Equal(t, "cosmos", chain.MintscanPrefix)
+}
+
+func TestImportChainsEmptyChains(t *testing.T) {
+	t.Parallel()
+
+	importer := getImporter(getRegistryFS())
+
+	configBytes, err := importer.ImportChains([]byte("chains:\n"), []string{"osmosis"}, false)
+	require.NoError(t, err)
+	require.Len(t, parseConfig(t, configBytes).Chains, 1)
+}
+
+func TestImportChainsErrors(t *testing.T) {
+	t.Parallel()
+
+	importer := getImporter(getRegistryFS())
+
+	_, err := importer.ImportChains([]byte("invalid: [yaml"), []string{"osmosis"}, false)
+	require.Error(t, err)
+
+	_, err = importer.ImportChains([]byte("- not a mapping"), []string{"osmosis"}, false)
+	require.Error(t, err)
+
+	_, err = importer.ImportChains([]byte("chains: not a list"), []string{"osmosis"}, false)
+	require.Error(t, err)
+
+	_, err = importer.ImportChains(nil, []string{"not-existing"}, false)
+	require.Error(t, err)
+}
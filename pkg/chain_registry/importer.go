@@ -0,0 +1,156 @@
+package chain_registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	configTypes "main/pkg/config/types"
+	"main/pkg/cosmos_directory"
+	"path"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+const ibcPathsFolder = "_IBC"
+
+// Importer reads chains info from a local checkout of the chain registry
+// (https://github.com/cosmos/chain-registry), so it can be used without network access.
+type Importer struct {
+	Logger     zerolog.Logger
+	Filesystem iofs.FS
+
+	chains map[string]*Chain
+}
+
+func NewImporter(logger *zerolog.Logger, filesystem iofs.FS) *Importer {
+	return &Importer{
+		Logger: logger.With().
+			Str("component", "chain_registry_importer").
+			Logger(),
+		Filesystem: filesystem,
+		chains:     map[string]*Chain{},
+	}
+}
+
+func (i *Importer) readJSON(filePath string, target interface{}) error {
+	bytes, err := iofs.ReadFile(i.Filesystem, filePath)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(bytes, target); err != nil {
+		return fmt.Errorf("error parsing %s: %s", filePath, err)
+	}
+
+	return nil
+}
+
+func (i *Importer) GetChain(name string) (*Chain, error) {
+	if chain, ok := i.chains[name]; ok {
+		return chain, nil
+	}
+
+	var chain *Chain
+	if err := i.readJSON(path.Join(name, "chain.json"), &chain); err != nil {
+		return nil, err
+	}
+
+	if chain == nil || chain.ChainID == "" {
+		return nil, fmt.Errorf("chain %s has no chain-id in the chain registry", name)
+	}
+
+	i.chains[name] = chain
+	return chain, nil
+}
+
+// GetAssetList returns the chain's assets, or nil if the chain has no assetlist.json.
+func (i *Importer) GetAssetList(name string) (*AssetList, error) {
+	var assetList *AssetList
+	err := i.readJSON(path.Join(name, "assetlist.json"), &assetList)
+
+	if errors.Is(err, iofs.ErrNotExist) {
+		return nil, nil
+	}
+
+	return assetList, err
+}
+
+// GetIbcChannels returns the map of the chain's IBC channels IDs to the counterparty chain IDs.
+func (i *Importer) GetIbcChannels(name string) (map[string]string, error) {
+	entries, err := iofs.ReadDir(i.Filesystem, ibcPathsFolder)
+	if errors.Is(err, iofs.ErrNotExist) {
+		return map[string]string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	channels := map[string]string{}
+
+	for _, entry := range entries {
+		// files are named like "cosmoshub-osmosis.json"
+		if entry.IsDir() ||
+			!strings.HasSuffix(entry.Name(), ".json") ||
+			!strings.Contains(entry.Name(), name) {
+			continue
+		}
+
+		var ibcPath IbcPath
+		if err := i.readJSON(path.Join(ibcPathsFolder, entry.Name()), &ibcPath); err != nil {
+			return nil, err
+		}
+
+		for channel, counterpartyName := range ibcPath.GetChannels(name) {
+			counterparty, err := i.GetChain(counterpartyName)
+			if err != nil {
+				i.Logger.Warn().
+					Err(err).
+					Str("chain", name).
+					Str("channel", channel).
+					Str("counterparty", counterpartyName).
+					Msg("Could not get IBC counterparty chain, skipping")
+				continue
+			}
+
+			channels[channel] = counterparty.ChainID
+		}
+	}
+
+	return channels, nil
+}
+
+// GetAppConfigChain returns the chain with nodes, denoms, explorer, IBC channels
+// and IBC denoms taken from the chain registry.
+func (i *Importer) GetAppConfigChain(name string, apiType string) (*configTypes.Chain, error) {
+	registryChain, err := i.GetChain(name)
+	if err != nil {
+		return nil, err
+	}
+
+	assetList, err := i.GetAssetList(name)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := i.GetIbcChannels(name)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := &configTypes.Chain{
+		Name:        name,
+		ApiType:     apiType,
+		IbcChannels: channels,
+		IbcDenoms:   assetList.GetIbcDenoms(),
+	}
+
+	if err := cosmos_directory.PopulateChain(
+		chain,
+		registryChain.ToCosmosDirectoryChain(assetList),
+	); err != nil {
+		return nil, err
+	}
+
+	return chain, nil
+}
@@ -0,0 +1,186 @@
+package chain_registry_test
+
+import (
+	"main/assets"
+	"main/pkg/chain_registry"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func getRegistryFS() fstest.MapFS {
+	return fstest.MapFS{
+		"cosmoshub/chain.json": {
+			Data: assets.GetBytesOrPanic("chain-registry/cosmoshub/chain.json"),
+		},
+		"cosmoshub/assetlist.json": {
+			Data: assets.GetBytesOrPanic("chain-registry/cosmoshub/assetlist.json"),
+		},
+		"osmosis/chain.json": {
+			Data: assets.GetBytesOrPanic("chain-registry/osmosis/chain.json"),
+		},
+		"osmosis/assetlist.json": {
+			Data: assets.GetBytesOrPanic("chain-registry/osmosis/assetlist.json"),
+		},
+		"_IBC/cosmoshub-osmosis.json": {
+			Data: assets.GetBytesOrPanic("chain-registry/ibc/cosmoshub-osmosis.json"),
+		},
+		"_IBC/osmosis-unknown.json": {
+			Data: assets.GetBytesOrPanic("chain-registry/ibc/osmosis-unknown.json"),
+		},
+		"invalid/chain.json":     {Data: []byte("invalid")},
+		"no-chain-id/chain.json": {Data: []byte("{}")},
+	}
+}
+
+func getImporter(filesystem fstest.MapFS) *chain_registry.Importer {
+	return chain_registry.NewImporter(loggerPkg.GetNopLogger(), filesystem)
+}
+
+func TestImporterGetChainErrors(t *testing.T) {
+	t.Parallel()
+
+	importer := getImporter(getRegistryFS())
+
+	_, err := importer.GetChain("not-existing")
+	require.Error(t, err)
+
+	_, err = importer.GetChain("invalid")
+	require.Error(t, err)
+
+	_, err = importer.GetChain("no-chain-id")
+	require.Error(t, err)
+}
+
+func TestImporterGetAssetList(t *testing.T) {
+	t.Parallel()
+
+	importer := getImporter(getRegistryFS())
+
+	assetList, err := importer.GetAssetList("osmosis")
+	require.NoError(t, err)
+	require.Len(t, assetList.Assets, 3)
+
+	assetList, err = importer.GetAssetList("invalid")
+	require.NoError(t, err)
+	require.Nil(t, assetList)
+}
+
+func TestImporterGetIbcChannels(t *testing.T) {
+	t.Parallel()
+
+	importer := getImporter(getRegistryFS())
+
+	channels, err := importer.GetIbcChannels("osmosis")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"channel-0": "cosmoshub-4"}, channels)
+
+	channels, err = importer.GetIbcChannels("cosmoshub")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"channel-141": "osmosis-1"}, channels)
+}
+
+func TestImporterGetIbcChannelsNoIbcFolder(t *testing.T) {
+	t.Parallel()
+
+	filesystem := getRegistryFS()
+	delete(filesystem, "_IBC/cosmoshub-osmosis.json")
+	delete(filesystem, "_IBC/osmosis-unknown.json")
+
+	channels, err := getImporter(filesystem).GetIbcChannels("osmosis")
+	require.NoError(t, err)
+	require.Empty(t, channels)
+}
+
+func TestImporterGetIbcChannelsInvalid(t *testing.T) {
+	t.Parallel()
+
+	filesystem := getRegistryFS()
+	filesystem["_IBC/osmosis-invalid.json"] = &fstest.MapFile{Data: []byte("invalid")}
+
+	_, err := getImporter(filesystem).GetIbcChannels("osmosis")
+	require.Error(t, err)
+}
+
+func TestImporterGetAppConfigChain(t *testing.T) {
+	t.Parallel()
+
+	importer := getImporter(getRegistryFS())
+
+	chain, err := importer.GetAppConfigChain("osmosis", constants.ApiTypeGrpc)
+	require.NoError(t, err)
+	require.Equal(t, "osmosis", chain.Name)
+	require.Equal(t, "osmosis-1", chain.ChainID)
+	require.Equal(t, "Osmosis", chain.PrettyName)
+	require.Equal(t, []string{"https://osmosis-rpc.polkachu.com"}, chain.TendermintNodes)
+	require.Equal(t, []string{"https://osmosis-grpc.polkachu.com:443"}, chain.GrpcNodes)
+	require.Empty(t, chain.APINodes)
+	require.Equal(t, map[string]string{"channel-0": "cosmoshub-4"}, chain.IbcChannels)
+	require.Equal(t, map[string]string{
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2": "transfer/channel-0/uatom",
+	}, chain.IbcDenoms)
+
+	// uion has no display denom unit
+	require.Len(t, chain.Denoms, 2)
+	require.Equal(t, "uosmo", chain.Denoms[0].Denom)
+	require.Equal(t, "osmo", chain.Denoms[0].DisplayDenom)
+	require.Equal(t, 6, chain.Denoms[0].DenomExponent)
+	require.Equal(t, "osmosis", chain.Denoms[0].CoingeckoCurrency)
+	require.Equal(t, "atom", chain.Denoms[1].DisplayDenom)
+
+	require.Nil(t, chain.SupportedExplorer)
+	require.NotNil(t, chain.Explorer)
+	require.Equal(t, "https://ezstaking.app/osmosis/txs/%s", chain.Explorer.TransactionLinkPattern)
+}
+
+func TestImporterGetAppConfigChainErrors(t *testing.T) {
+	t.Parallel()
+
+	filesystem := getRegistryFS()
+	filesystem["no-nodes/chain.json"] = &fstest.MapFile{Data: []byte(`{"chain_id":"chain"}`)}
+	filesystem["invalid-assets/chain.json"] = filesystem["osmosis/chain.json"]
+	filesystem["invalid-assets/assetlist.json"] = &fstest.MapFile{Data: []byte("invalid")}
+	filesystem["_IBC/invalid-ibc-osmosis.json"] = &fstest.MapFile{Data: []byte("invalid")}
+	filesystem["invalid-ibc/chain.json"] = filesystem["osmosis/chain.json"]
+
+	importer := getImporter(filesystem)
+
+	_, err := importer.GetAppConfigChain("not-existing", constants.ApiTypeRest)
+	require.Error(t, err)
+
+	_, err = importer.GetAppConfigChain("no-nodes", constants.ApiTypeRest)
+	require.Error(t, err)
+
+	_, err = importer.GetAppConfigChain("invalid-assets", constants.ApiTypeRest)
+	require.Error(t, err)
+
+	_, err = importer.GetAppConfigChain("invalid-ibc", constants.ApiTypeRest)
+	require.Error(t, err)
+}
+
+func TestAssetGetIbcPath(t *testing.T) {
+	t.Parallel()
+
+	denom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	trace := chain_registry.AssetTrace{
+		Type:  "ibc",
+		Chain: chain_registry.AssetTraceChain{Path: "transfer/channel-0/uatom"},
+	}
+
+	require.Empty(t, chain_registry.Asset{Base: "uatom"}.GetIbcPath())
+	require.Empty(t, chain_registry.Asset{Base: denom}.GetIbcPath())
+	require.Empty(t, chain_registry.Asset{
+		Base: denom,
+		Traces: []chain_registry.AssetTrace{{
+			Type:  "ibc",
+			Chain: chain_registry.AssetTraceChain{Path: "transfer/channel-1/uatom"},
+		}},
+	}.GetIbcPath())
+	require.Equal(t, "transfer/channel-0/uatom", chain_registry.Asset{
+		Base:   denom,
+		Traces: []chain_registry.AssetTrace{trace, {Type: "bridge"}},
+	}.GetIbcPath())
+}
@@ -0,0 +1,172 @@
+package chain_registry
+
+import (
+	"main/pkg/types/responses"
+	"strings"
+
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// Chain is a chain.json file from the chain registry. It has the same fields as
+// the cosmos.directory chain, except for the assets, which are stored separately.
+type Chain struct {
+	ChainName  string                              `json:"chain_name"`
+	ChainID    string                              `json:"chain_id"`
+	PrettyName string                              `json:"pretty_name"`
+	Apis       responses.CosmosDirectoryApis       `json:"apis"`
+	Explorers  []responses.CosmosDirectoryExplorer `json:"explorers"`
+}
+
+func (c Chain) ToCosmosDirectoryChain(assetList *AssetList) *responses.CosmosDirectoryChain {
+	chain := &responses.CosmosDirectoryChain{
+		Name:       c.ChainName,
+		PrettyName: c.PrettyName,
+		ChainID:    c.ChainID,
+		Apis:       c.Apis,
+		Explorers:  c.Explorers,
+	}
+
+	if assetList != nil {
+		chain.Assets = make([]responses.CosmosDirectoryAsset, len(assetList.Assets))
+		for index, asset := range assetList.Assets {
+			chain.Assets[index] = asset.ToCosmosDirectoryAsset()
+		}
+	}
+
+	return chain
+}
+
+// AssetList is an assetlist.json file from the chain registry.
+type AssetList struct {
+	ChainName string  `json:"chain_name"`
+	Assets    []Asset `json:"assets"`
+}
+
+// GetIbcDenoms returns the map of the IBC assets denoms to their full paths,
+// like "ibc/27394FB0..." => "transfer/channel-0/uatom".
+func (l *AssetList) GetIbcDenoms() map[string]string {
+	denoms := map[string]string{}
+	if l == nil {
+		return denoms
+	}
+
+	for _, asset := range l.Assets {
+		if path := asset.GetIbcPath(); path != "" {
+			denoms[asset.Base] = path
+		}
+	}
+
+	return denoms
+}
+
+type Asset struct {
+	Base        string       `json:"base"`
+	Display     string       `json:"display"`
+	DenomUnits  []DenomUnit  `json:"denom_units"`
+	CoingeckoID string       `json:"coingecko_id"`
+	Traces      []AssetTrace `json:"traces"`
+}
+
+// GetIbcPath returns the asset's full IBC path if it's an IBC denom,
+// or an empty string otherwise.
+func (a Asset) GetIbcPath() string {
+	if !strings.HasPrefix(a.Base, transferTypes.DenomPrefix+"/") {
+		return ""
+	}
+
+	// traces go from the origin, so the last one is the one that got the asset to this chain
+	for index := len(a.Traces) - 1; index >= 0; index-- {
+		trace := a.Traces[index]
+		if trace.Type != "ibc" || trace.Chain.Path == "" {
+			continue
+		}
+
+		// skipping the paths that do not match the denom, as they'd fail the config validation
+		if transferTypes.ParseDenomTrace(trace.Chain.Path).IBCDenom() != a.Base {
+			return ""
+		}
+
+		return trace.Chain.Path
+	}
+
+	return ""
+}
+
+func (a Asset) ToCosmosDirectoryAsset() responses.CosmosDirectoryAsset {
+	asset := responses.CosmosDirectoryAsset{
+		Denom:       a.Base,
+		CoingeckoID: a.CoingeckoID,
+	}
+
+	// denom units list the base and the display denoms along with their exponents,
+	// if either of them is missing, the asset is skipped when converting it to a denom
+	for _, unit := range a.DenomUnits {
+		if unit.Denom == a.Base {
+			asset.Base = responses.CosmosDirectoryAssetDenomInfo{Denom: unit.Denom, Exponent: unit.Exponent}
+		}
+
+		if unit.Denom == a.Display {
+			asset.Display = responses.CosmosDirectoryAssetDenomInfo{Denom: unit.Denom, Exponent: unit.Exponent}
+		}
+	}
+
+	return asset
+}
+
+type AssetTrace struct {
+	Type  string          `json:"type"`
+	Chain AssetTraceChain `json:"chain"`
+}
+
+type AssetTraceChain struct {
+	ChannelID string `json:"channel_id"`
+	Path      string `json:"path"`
+}
+
+type DenomUnit struct {
+	Denom    string `json:"denom"`
+	Exponent int    `json:"exponent"`
+}
+
+// IbcPath is a file from the chain registry _IBC folder, describing
+// the IBC connection between two chains and its channels.
+type IbcPath struct {
+	Chain1   IbcPathChain     `json:"chain_1"`
+	Chain2   IbcPathChain     `json:"chain_2"`
+	Channels []IbcPathChannel `json:"channels"`
+}
+
+type IbcPathChain struct {
+	ChainName string `json:"chain_name"`
+}
+
+type IbcPathChannel struct {
+	Chain1 IbcPathChannelEnd `json:"chain_1"`
+	Chain2 IbcPathChannelEnd `json:"chain_2"`
+}
+
+type IbcPathChannelEnd struct {
+	ChannelID string `json:"channel_id"`
+	PortID    string `json:"port_id"`
+}
+
+// GetChannels returns the channels of the given chain as a map of
+// the channel ID to the counterparty chain name, or nil if the path is not for this chain.
+func (p IbcPath) GetChannels(chainName string) map[string]string {
+	var channels map[string]string
+
+	switch chainName {
+	case p.Chain1.ChainName:
+		channels = make(map[string]string, len(p.Channels))
+		for _, channel := range p.Channels {
+			channels[channel.Chain1.ChannelID] = p.Chain2.ChainName
+		}
+	case p.Chain2.ChainName:
+		channels = make(map[string]string, len(p.Channels))
+		for _, channel := range p.Channels {
+			channels[channel.Chain2.ChannelID] = p.Chain1.ChainName
+		}
+	}
+
+	return channels
+}
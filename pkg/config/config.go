@@ -1,7 +1,10 @@
 package config
 
 import (
+	"main/pkg/constants"
 	"main/pkg/fs"
+	"main/pkg/types/query_info"
+	"time"
 
 	"gopkg.in/guregu/null.v4"
 
@@ -15,11 +18,18 @@ import (
 
 type AppConfig struct {
 	AliasesPath   string
+	StatePath     string
+	CachePath     string
+	OutboxPath    string
 	LogConfig     LogConfig
 	Chains        types.Chains
 	Subscriptions types.Subscriptions
 	Reporters     types.Reporters
+	AddressLists  types.AddressLists
 	Metrics       MetricsConfig
+
+	HistoricalPrices HistoricalPricesConfig
+	Timeouts         TimeoutsConfig
 }
 
 type LogConfig struct {
@@ -32,6 +42,37 @@ type MetricsConfig struct {
 	ListenAddr string
 }
 
+// HistoricalPricesConfig is about showing the value of amounts at the time of the transaction
+// alongside the current one, for amounts worth at least MinUSDValue in txs older than MinAge.
+type HistoricalPricesConfig struct {
+	Enabled     bool
+	MinUSDValue float64
+	MinAge      time.Duration
+}
+
+// TimeoutsConfig is about how long to wait for queries to nodes and price sources
+// (Query by default, or the one in Queries for a query type), and for all the additional
+// data for a report to be fetched (Enrichment).
+type TimeoutsConfig struct {
+	Query      time.Duration
+	Enrichment time.Duration
+	Queries    map[query_info.QueryType]time.Duration
+}
+
+// GetQueryTimeout returns the timeout for a query of the given type.
+// If no timeouts are set, it falls back to the default one.
+func (c TimeoutsConfig) GetQueryTimeout(queryType query_info.QueryType) time.Duration {
+	if timeout, ok := c.Queries[queryType]; ok {
+		return timeout
+	}
+
+	if c.Query == 0 {
+		return constants.DefaultQueryTimeout
+	}
+
+	return c.Query
+}
+
 type ReadFileFs interface {
 	ReadFile(name string) ([]byte, error)
 }
@@ -57,8 +98,16 @@ func GetConfig(path string, filesystem fs.FS) (*AppConfig, error) {
 }
 
 func FromYamlConfig(c *yamlConfig.YamlConfig) *AppConfig {
+	queryTimeouts := make(map[query_info.QueryType]time.Duration, len(c.TimeoutsConfig.Queries))
+	for queryType, timeout := range c.TimeoutsConfig.Queries {
+		queryTimeouts[query_info.QueryType(queryType)] = time.Duration(timeout) * time.Second
+	}
+
 	return &AppConfig{
 		AliasesPath: c.AliasesPath,
+		StatePath:   c.StatePath,
+		CachePath:   c.CachePath,
+		OutboxPath:  c.OutboxPath,
 		LogConfig: LogConfig{
 			LogLevel:   c.LogConfig.LogLevel,
 			JSONOutput: c.LogConfig.JSONOutput.Bool,
@@ -67,6 +116,16 @@ func FromYamlConfig(c *yamlConfig.YamlConfig) *AppConfig {
 			ListenAddr: c.MetricsConfig.ListenAddr,
 			Enabled:    c.MetricsConfig.Enabled.Bool,
 		},
+		HistoricalPrices: HistoricalPricesConfig{
+			Enabled:     c.HistoricalPricesConfig.Enabled.Bool,
+			MinUSDValue: c.HistoricalPricesConfig.MinUSDValue.Float64,
+			MinAge:      time.Duration(c.HistoricalPricesConfig.MinAge.Int64) * time.Second,
+		},
+		Timeouts: TimeoutsConfig{
+			Query:      time.Duration(c.TimeoutsConfig.Query.Int64) * time.Second,
+			Enrichment: time.Duration(c.TimeoutsConfig.Enrichment.Int64) * time.Second,
+			Queries:    queryTimeouts,
+		},
 		Chains: utils.Map(c.Chains, func(c *yamlConfig.Chain) *types.Chain {
 			return c.ToAppConfigChain()
 		}),
@@ -76,12 +135,23 @@ func FromYamlConfig(c *yamlConfig.YamlConfig) *AppConfig {
 		Subscriptions: utils.Map(c.Subscriptions, func(s *yamlConfig.Subscription) *types.Subscription {
 			return s.ToAppConfigSubscription()
 		}),
+		AddressLists: utils.Map(c.AddressLists, func(l *yamlConfig.AddressList) *types.AddressList {
+			return l.ToAppConfigAddressList()
+		}),
 	}
 }
 
 func (c *AppConfig) ToYamlConfig() *yamlConfig.YamlConfig {
+	queryTimeouts := make(map[string]int64, len(c.Timeouts.Queries))
+	for queryType, timeout := range c.Timeouts.Queries {
+		queryTimeouts[string(queryType)] = int64(timeout / time.Second)
+	}
+
 	return &yamlConfig.YamlConfig{
 		AliasesPath: c.AliasesPath,
+		StatePath:   c.StatePath,
+		CachePath:   c.CachePath,
+		OutboxPath:  c.OutboxPath,
 		LogConfig: yamlConfig.LogConfig{
 			LogLevel:   c.LogConfig.LogLevel,
 			JSONOutput: null.BoolFrom(c.LogConfig.JSONOutput),
@@ -90,9 +160,20 @@ func (c *AppConfig) ToYamlConfig() *yamlConfig.YamlConfig {
 			ListenAddr: c.Metrics.ListenAddr,
 			Enabled:    null.BoolFrom(c.Metrics.Enabled),
 		},
+		HistoricalPricesConfig: yamlConfig.HistoricalPricesConfig{
+			Enabled:     null.BoolFrom(c.HistoricalPrices.Enabled),
+			MinUSDValue: null.FloatFrom(c.HistoricalPrices.MinUSDValue),
+			MinAge:      null.IntFrom(int64(c.HistoricalPrices.MinAge / time.Second)),
+		},
+		TimeoutsConfig: yamlConfig.TimeoutsConfig{
+			Query:      null.IntFrom(int64(c.Timeouts.Query / time.Second)),
+			Enrichment: null.IntFrom(int64(c.Timeouts.Enrichment / time.Second)),
+			Queries:    queryTimeouts,
+		},
 		Chains:        utils.Map(c.Chains, yamlConfig.FromAppConfigChain),
 		Reporters:     utils.Map(c.Reporters, yamlConfig.FromAppConfigReporter),
 		Subscriptions: utils.Map(c.Subscriptions, yamlConfig.FromAppConfigSubscription),
+		AddressLists:  utils.Map(c.AddressLists, yamlConfig.FromAppConfigAddressList),
 	}
 }
 
@@ -4,8 +4,11 @@ import (
 	"errors"
 	"main/assets"
 	configPkg "main/pkg/config"
+	"main/pkg/constants"
 	"main/pkg/fs"
+	"main/pkg/types/query_info"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +24,10 @@ func (filesystem *TmpFSInterface) Create(path string) (fs.File, error) {
 	return nil, errors.New("not yet supported")
 }
 
+func (filesystem *TmpFSInterface) Rename(oldPath, newPath string) error {
+	return errors.New("not yet supported")
+}
+
 func TestLoadConfigErrorReading(t *testing.T) {
 	t.Parallel()
 
@@ -80,7 +87,12 @@ func TestConfigDisplayAsYaml(t *testing.T) {
 
 	require.EqualValues(t, config.LogConfig, configAgain.LogConfig)
 	require.EqualValues(t, config.AliasesPath, configAgain.AliasesPath)
+	require.EqualValues(t, config.StatePath, configAgain.StatePath)
+	require.EqualValues(t, config.CachePath, configAgain.CachePath)
+	require.EqualValues(t, config.OutboxPath, configAgain.OutboxPath)
 	require.EqualValues(t, config.Metrics, configAgain.Metrics)
+	require.EqualValues(t, config.HistoricalPrices, configAgain.HistoricalPrices)
+	require.EqualValues(t, config.Timeouts, configAgain.Timeouts)
 
 	require.Equal(t, len(config.Chains), len(configAgain.Chains))
 	for index := range config.Chains {
@@ -153,6 +165,23 @@ func TestConfigDisplayAsYaml(t *testing.T) {
 	}
 }
 
+func TestConfigGetQueryTimeout(t *testing.T) {
+	t.Parallel()
+
+	config, err := configPkg.GetConfig("valid.yml", &TmpFSInterface{})
+	require.NoError(t, err)
+
+	require.Equal(t, 30*time.Second, config.Timeouts.GetQueryTimeout(query_info.QueryTypePrices))
+	require.Equal(t, 60*time.Second, config.Timeouts.GetQueryTimeout(query_info.QueryTypeValidator))
+	require.Equal(t, 120*time.Second, config.Timeouts.Enrichment)
+
+	require.Equal(
+		t,
+		constants.DefaultQueryTimeout,
+		configPkg.TimeoutsConfig{}.GetQueryTimeout(query_info.QueryTypeValidator),
+	)
+}
+
 func TestConfigDisplayWarningsWithUnusedReporter(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,11 @@
+package types
+
+type AddressLists []*AddressList
+
+// AddressList is a named list of addresses that filters can reference as "@name".
+// Addresses can be set inline, loaded from a file, or both.
+type AddressList struct {
+	Name      string
+	Path      string
+	Addresses []string
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"main/pkg/constants"
 	"strconv"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 )
@@ -43,12 +45,24 @@ type Chain struct {
 	Name              string
 	PrettyName        string
 	ChainID           string
+	ChainRegistryName string
 	TendermintNodes   []string
 	APINodes          []string
+	GrpcNodes         []string
+	ApiType           string
 	Queries           []query.Query
 	Explorer          *Explorer
 	SupportedExplorer SupportedExplorer
 	Denoms            DenomInfos
+	IbcChannels       map[string]string
+	IbcDenoms         map[string]string
+	Parsers           ParsersConfig
+	MaxBackfillBlocks int64
+	Mode              string
+	PollInterval      time.Duration
+	ActiveNodes       int
+	StallTimeout      time.Duration
+	MaxBlocksBehind   int64
 }
 
 func (c *Chain) GetName() string {
@@ -59,6 +73,24 @@ func (c *Chain) GetName() string {
 	return c.Name
 }
 
+// IsPolling returns true if the chain's nodes should be polled via RPC
+// instead of being subscribed to via websocket.
+func (c *Chain) IsPolling() bool {
+	return c.Mode == constants.ChainModePoll
+}
+
+// HasStandbyNodes returns true if only some of the chain's nodes should be listened to
+// at once, with the rest of them promoted when an active one stalls.
+func (c *Chain) HasStandbyNodes() bool {
+	return c.ActiveNodes > 0 && c.ActiveNodes < len(c.TendermintNodes)
+}
+
+// IsUsingGrpc returns true if chain data should be fetched from gRPC nodes
+// instead of LCD REST ones.
+func (c *Chain) IsUsingGrpc() bool {
+	return c.ApiType == constants.ApiTypeGrpc
+}
+
 func (c *Chain) GetWalletLink(address string) *Link {
 	if c.Explorer == nil {
 		return &Link{Value: address}
@@ -5,12 +5,13 @@ type DenomInfo struct {
 	DenomExponent     int
 	DisplayDenom      string
 	CoingeckoCurrency string
+	PriceSources      PriceSources
 }
 
 func (d *DenomInfo) DisplayWarnings(chain *Chain) []DisplayWarning {
 	var warnings []DisplayWarning
 
-	if d.CoingeckoCurrency == "" {
+	if len(d.GetPriceSources()) == 0 {
 		warnings = append(warnings, DisplayWarning{
 			Keys: map[string]string{
 				"chain": chain.Name,
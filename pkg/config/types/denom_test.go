@@ -17,3 +17,21 @@ func TestDenomsFind(t *testing.T) {
 	require.NotNil(t, denoms.Find("denom"))
 	require.Nil(t, denoms.Find("denom-2"))
 }
+
+func TestDenomGetPriceSources(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, (&types.DenomInfo{Denom: "denom"}).GetPriceSources())
+
+	coingeckoSources := (&types.DenomInfo{CoingeckoCurrency: "cosmos"}).GetPriceSources()
+	require.Len(t, coingeckoSources, 1)
+	require.Equal(t, "coingecko", coingeckoSources[0].Type)
+
+	denom := &types.DenomInfo{
+		CoingeckoCurrency: "cosmos",
+		PriceSources:      types.PriceSources{{Type: "static", Price: 1}, {Type: "coingecko"}},
+	}
+	require.Len(t, denom.GetPriceSources(), 2)
+	require.NotNil(t, denom.GetPriceSources().Find("coingecko"))
+	require.Nil(t, denom.GetPriceSources().Find("json"))
+}
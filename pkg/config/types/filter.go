@@ -1,13 +1,12 @@
 package types
 
 import (
+	"main/pkg/filter"
 	"main/pkg/types/event"
 	"strings"
-
-	"github.com/cometbft/cometbft/libs/pubsub/query"
 )
 
-type Filters []query.Query
+type Filters []filter.Expression
 
 func (f Filters) String() string {
 	outStrings := make([]string, len(f))
@@ -20,12 +19,18 @@ func (f Filters) String() string {
 }
 
 func (f Filters) Matches(values event.EventValues) (bool, error) {
+	return f.MatchesMap(values.ToMap())
+}
+
+// MatchesMap is the same as Matches, but takes values already converted with ToMap,
+// so they are not converted again for each filter.
+func (f Filters) MatchesMap(values map[string][]string) (bool, error) {
 	if len(f) == 0 {
 		return true, nil
 	}
 
 	for _, filter := range f {
-		if matches, err := filter.Matches(values.ToMap()); err != nil {
+		if matches, err := filter.Matches(values); err != nil {
 			return false, err
 		} else if matches {
 			return true, nil
@@ -2,18 +2,18 @@ package types_test
 
 import (
 	configTypes "main/pkg/config/types"
+	"main/pkg/filter"
 	"main/pkg/types/event"
 	"testing"
 
-	queryPkg "github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/stretchr/testify/require"
 )
 
 func TestFiltersString(t *testing.T) {
 	t.Parallel()
 
-	query1 := queryPkg.MustParse("event1.key1 = 'value1'")
-	query2 := queryPkg.MustParse("event2.key2 = 'value2'")
+	query1 := filter.MustParse("event1.key1 = 'value1'")
+	query2 := filter.MustParse("event2.key2 = 'value2'")
 
 	filters := configTypes.Filters{*query1, *query2}
 
@@ -36,7 +36,7 @@ func TestFiltersMatchesEmpty(t *testing.T) {
 func TestFiltersMatches(t *testing.T) {
 	t.Parallel()
 
-	query := queryPkg.MustParse("event.key = 'value'")
+	query := filter.MustParse("event.key = 'value'")
 
 	filters := configTypes.Filters{*query}
 	eventValues := event.EventValues{
@@ -46,12 +46,16 @@ func TestFiltersMatches(t *testing.T) {
 	matches, err := filters.Matches(eventValues)
 	require.True(t, matches)
 	require.NoError(t, err)
+
+	matches, err = filters.MatchesMap(eventValues.ToMap())
+	require.True(t, matches)
+	require.NoError(t, err)
 }
 
 func TestFiltersNotMatches(t *testing.T) {
 	t.Parallel()
 
-	query := queryPkg.MustParse("event.key = 'value'")
+	query := filter.MustParse("event.key = 'value'")
 
 	filters := configTypes.Filters{*query}
 	eventValues := event.EventValues{
@@ -66,7 +70,7 @@ func TestFiltersNotMatches(t *testing.T) {
 func TestFiltersError(t *testing.T) {
 	t.Parallel()
 
-	query := queryPkg.MustParse("event.key > 100")
+	query := filter.MustParse("event.key > 100")
 
 	filters := configTypes.Filters{*query}
 	eventValues := event.EventValues{
@@ -77,3 +81,25 @@ func TestFiltersError(t *testing.T) {
 	require.False(t, matches)
 	require.Error(t, err)
 }
+
+func TestFiltersMatchesExpression(t *testing.T) {
+	t.Parallel()
+
+	filters := configTypes.Filters{
+		*filter.MustParse("event.key = 'value' AND NOT event.key2 IN ('value2', 'value3')"),
+	}
+
+	matches, err := filters.Matches(event.EventValues{
+		{Key: "event.key", Value: "value"},
+		{Key: "event.key2", Value: "value4"},
+	})
+	require.True(t, matches)
+	require.NoError(t, err)
+
+	matches, err = filters.Matches(event.EventValues{
+		{Key: "event.key", Value: "value"},
+		{Key: "event.key2", Value: "value3"},
+	})
+	require.False(t, matches)
+	require.NoError(t, err)
+}
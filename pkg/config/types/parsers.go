@@ -0,0 +1,20 @@
+package types
+
+import "main/pkg/utils"
+
+type ParsersConfig struct {
+	Enabled   []string
+	Disabled  []string
+	Overrides map[string]string
+}
+
+// IsEnabled returns whether the parser for the given type URL should be used for this chain.
+// If Enabled is not empty, only the parsers listed there are used, and Disabled
+// is applied on top of it.
+func (c ParsersConfig) IsEnabled(typeURL string) bool {
+	if len(c.Enabled) > 0 && !utils.Contains(c.Enabled, typeURL) {
+		return false
+	}
+
+	return !utils.Contains(c.Disabled, typeURL)
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"main/pkg/config/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsersConfigIsEnabledEmpty(t *testing.T) {
+	t.Parallel()
+
+	config := types.ParsersConfig{}
+	require.True(t, config.IsEnabled("/cosmos.bank.v1beta1.MsgSend"))
+}
+
+func TestParsersConfigIsEnabledDisabled(t *testing.T) {
+	t.Parallel()
+
+	config := types.ParsersConfig{Disabled: []string{"/cosmos.bank.v1beta1.MsgSend"}}
+	require.False(t, config.IsEnabled("/cosmos.bank.v1beta1.MsgSend"))
+	require.True(t, config.IsEnabled("/cosmos.gov.v1beta1.MsgVote"))
+}
+
+func TestParsersConfigIsEnabledWhitelist(t *testing.T) {
+	t.Parallel()
+
+	config := types.ParsersConfig{
+		Enabled:  []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1beta1.MsgVote"},
+		Disabled: []string{"/cosmos.gov.v1beta1.MsgVote"},
+	}
+	require.True(t, config.IsEnabled("/cosmos.bank.v1beta1.MsgSend"))
+	require.False(t, config.IsEnabled("/cosmos.gov.v1beta1.MsgVote"))
+	require.False(t, config.IsEnabled("/cosmos.staking.v1beta1.MsgDelegate"))
+}
@@ -0,0 +1,49 @@
+package types
+
+import "main/pkg/constants"
+
+// PriceSource is where a denom price in USD is taken from. Only the fields
+// related to the source type are set.
+type PriceSource struct {
+	Type string
+
+	// static
+	Price float64
+
+	// osmosis
+	LCD           string
+	PoolID        uint64
+	BaseDenom     string
+	QuoteDenom    string
+	QuoteExponent int
+
+	// json
+	URL  string
+	Path string
+}
+
+type PriceSources []*PriceSource
+
+func (s PriceSources) Find(sourceType string) *PriceSource {
+	for _, source := range s {
+		if source.Type == sourceType {
+			return source
+		}
+	}
+
+	return nil
+}
+
+// GetPriceSources returns the price sources to try in order. If none are set
+// explicitly, but the CoinGecko currency is, the price is taken from CoinGecko.
+func (d *DenomInfo) GetPriceSources() PriceSources {
+	if len(d.PriceSources) > 0 {
+		return d.PriceSources
+	}
+
+	if d.CoingeckoCurrency != "" {
+		return PriceSources{{Type: constants.PriceSourceTypeCoingecko}}
+	}
+
+	return PriceSources{}
+}
@@ -1,9 +1,22 @@
 package types
 
-import "time"
+import (
+	"main/pkg/constants"
+	"time"
+)
 
 type Reporters []*Reporter
 
+func (r Reporters) FindByName(name string) *Reporter {
+	for _, reporter := range r {
+		if reporter.Name == name {
+			return reporter
+		}
+	}
+
+	return nil
+}
+
 type TelegramConfig struct {
 	Chat   int64
 	Token  string
@@ -14,6 +27,16 @@ type Reporter struct {
 	Name string
 	Type string
 
-	Timezone       *time.Location
-	TelegramConfig *TelegramConfig
+	Timezone        *time.Location
+	QuoteCurrencies []string
+	TelegramConfig  *TelegramConfig
+}
+
+// GetQuoteCurrencies returns the currencies amounts values are displayed in, USD by default.
+func (r *Reporter) GetQuoteCurrencies() []string {
+	if len(r.QuoteCurrencies) == 0 {
+		return []string{constants.QuoteCurrencyUSD}
+	}
+
+	return r.QuoteCurrencies
 }
@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	configTypes "main/pkg/config/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportersFindByName(t *testing.T) {
+	t.Parallel()
+
+	reporters := configTypes.Reporters{{Name: "first"}, {Name: "second"}}
+	require.Equal(t, "second", reporters.FindByName("second").Name)
+	require.Nil(t, reporters.FindByName("third"))
+}
+
+func TestReporterGetQuoteCurrencies(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"usd"}, (&configTypes.Reporter{}).GetQuoteCurrencies())
+	require.Equal(t, []string{"eur", "atom"}, (&configTypes.Reporter{
+		QuoteCurrencies: []string{"eur", "atom"},
+	}).GetQuoteCurrencies())
+}
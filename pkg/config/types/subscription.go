@@ -1,5 +1,7 @@
 package types
 
+import "math/big"
+
 type Subscriptions []*Subscription
 
 type Subscription struct {
@@ -11,12 +13,23 @@ type Subscription struct {
 type ChainSubscriptions []*ChainSubscription
 
 type ChainSubscription struct {
-	Chain   string
-	Filters Filters
+	Chain     string
+	Filters   Filters
+	TxFilters Filters
 
 	LogUnknownMessages     bool
 	LogUnparsedMessages    bool
 	LogFailedTransactions  bool
 	LogNodeErrors          bool
 	FilterInternalMessages bool
+
+	// Amount filters are checked after the data is fetched and amounts are converted,
+	// so USD values are known. MinAmounts is denom -> amount, both as displayed in reports.
+	MinUSDValue *big.Float
+	MaxUSDValue *big.Float
+	MinAmounts  map[string]*big.Float
+}
+
+func (s *ChainSubscription) HasAmountFilters() bool {
+	return s.MinUSDValue != nil || s.MaxUSDValue != nil || len(s.MinAmounts) > 0
 }
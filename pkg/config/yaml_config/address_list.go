@@ -0,0 +1,88 @@
+package yaml_config
+
+import (
+	"errors"
+	"fmt"
+	"main/pkg/config/types"
+	"path/filepath"
+	"strings"
+)
+
+type AddressList struct {
+	Name      string   `yaml:"name"`
+	Path      string   `yaml:"path"`
+	Addresses []string `yaml:"addresses"`
+}
+
+func (list *AddressList) Validate() error {
+	if list.Name == "" {
+		return errors.New("address list name not provided")
+	}
+
+	if strings.ContainsAny(list.Name, " \t\n()'\",=<>!@") {
+		return fmt.Errorf("address list name '%s' contains invalid characters", list.Name)
+	}
+
+	if list.Path == "" && len(list.Addresses) == 0 {
+		return errors.New("neither path nor addresses are provided")
+	}
+
+	if list.Path != "" {
+		switch strings.ToLower(filepath.Ext(list.Path)) {
+		case ".csv", ".yml", ".yaml":
+		default:
+			return fmt.Errorf("unsupported address list file '%s', expected .csv, .yml or .yaml", list.Path)
+		}
+	}
+
+	return nil
+}
+
+type AddressLists []*AddressList
+
+func (lists AddressLists) Validate() error {
+	for index, list := range lists {
+		if err := list.Validate(); err != nil {
+			return fmt.Errorf("error in address list %d: %s", index, err)
+		}
+	}
+
+	// checking names uniqueness
+	names := map[string]bool{}
+
+	for _, list := range lists {
+		if _, ok := names[list.Name]; ok {
+			return fmt.Errorf("duplicate address list name: %s", list.Name)
+		}
+
+		names[list.Name] = true
+	}
+
+	return nil
+}
+
+func (lists AddressLists) HasAddressListByName(name string) bool {
+	for _, list := range lists {
+		if list.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func FromAppConfigAddressList(list *types.AddressList) *AddressList {
+	return &AddressList{
+		Name:      list.Name,
+		Path:      list.Path,
+		Addresses: list.Addresses,
+	}
+}
+
+func (list *AddressList) ToAppConfigAddressList() *types.AddressList {
+	return &types.AddressList{
+		Name:      list.Name,
+		Path:      list.Path,
+		Addresses: list.Addresses,
+	}
+}
@@ -0,0 +1,52 @@
+package yaml_config_test
+
+import (
+	"main/pkg/config/types"
+	yamlConfig "main/pkg/config/yaml_config"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressListsInvalid(t *testing.T) {
+	t.Parallel()
+
+	invalid := []yamlConfig.AddressLists{
+		{{Addresses: []string{"address"}}},
+		{{Name: "list name", Addresses: []string{"address"}}},
+		{{Name: "@list", Addresses: []string{"address"}}},
+		{{Name: "list"}},
+		{{Name: "list", Path: "list.txt"}},
+		{
+			{Name: "list", Addresses: []string{"address"}},
+			{Name: "list", Path: "list.csv"},
+		},
+	}
+
+	for _, lists := range invalid {
+		require.Error(t, lists.Validate())
+	}
+}
+
+func TestAddressListsValid(t *testing.T) {
+	t.Parallel()
+
+	lists := yamlConfig.AddressLists{
+		{Name: "inline", Addresses: []string{"address"}},
+		{Name: "csv", Path: "list.CSV"},
+		{Name: "yaml", Path: "list.yaml", Addresses: []string{"address"}},
+	}
+
+	require.NoError(t, lists.Validate())
+	require.True(t, lists.HasAddressListByName("csv"))
+	require.False(t, lists.HasAddressListByName("other"))
+}
+
+func TestAddressListToAppConfigAndBack(t *testing.T) {
+	t.Parallel()
+
+	list := &yamlConfig.AddressList{Name: "list", Path: "list.csv", Addresses: []string{"address"}}
+	appConfigList := list.ToAppConfigAddressList()
+	require.Equal(t, &types.AddressList{Name: "list", Path: "list.csv", Addresses: []string{"address"}}, appConfigList)
+	require.Equal(t, list, yamlConfig.FromAppConfigAddressList(appConfigList))
+}
@@ -3,22 +3,41 @@ package yaml_config
 import (
 	"fmt"
 	"main/pkg/config/types"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"gopkg.in/guregu/null.v4"
 )
 
 type Chain struct {
-	Name            string     `yaml:"name"`
-	PrettyName      string     `yaml:"pretty-name"`
-	ChainID         string     `yaml:"chain-id"`
-	TendermintNodes []string   `yaml:"tendermint-nodes"`
-	APINodes        []string   `yaml:"api-nodes"`
-	Queries         []string   `default:"[\"tx.height > 1\"]" yaml:"queries"`
-	MintscanPrefix  string     `yaml:"mintscan-prefix"`
-	PingPrefix      string     `yaml:"ping-prefix"`
-	PingBaseUrl     string     `default:"https://ping.pub"    yaml:"ping-base-url"`
-	Explorer        *Explorer  `yaml:"explorer"`
-	Denoms          DenomInfos `yaml:"denoms"`
+	Name              string            `yaml:"name"`
+	PrettyName        string            `yaml:"pretty-name"`
+	ChainID           string            `yaml:"chain-id"`
+	ChainRegistryName string            `yaml:"chain-registry-name"`
+	TendermintNodes   []string          `yaml:"tendermint-nodes"`
+	APINodes          []string          `yaml:"api-nodes"`
+	GrpcNodes         []string          `yaml:"grpc-nodes"`
+	ApiType           string            `default:"rest"                yaml:"api-type"`
+	Queries           []string          `default:"[\"tx.height > 1\"]" yaml:"queries"`
+	MintscanPrefix    string            `yaml:"mintscan-prefix"`
+	PingPrefix        string            `yaml:"ping-prefix"`
+	PingBaseUrl       string            `default:"https://ping.pub"    yaml:"ping-base-url"`
+	Explorer          *Explorer         `yaml:"explorer"`
+	Denoms            DenomInfos        `yaml:"denoms"`
+	IbcChannels       map[string]string `yaml:"ibc-channels"`
+	IbcDenoms         map[string]string `yaml:"ibc-denoms"`
+	Parsers           ParsersConfig     `yaml:"parsers"`
+
+	MaxBackfillBlocks null.Int `default:"100"       yaml:"max-backfill-blocks"`
+	Mode              string   `default:"websocket" yaml:"mode"`
+	PollInterval      null.Int `default:"5"         yaml:"poll-interval"`
+	ActiveNodes       null.Int `default:"0"         yaml:"active-nodes"`
+	StallTimeout      null.Int `default:"60"        yaml:"stall-timeout"`
+	MaxBlocksBehind   null.Int `default:"10"        yaml:"max-blocks-behind"`
 }
 
 func (c *Chain) Validate() error {
@@ -26,15 +45,29 @@ func (c *Chain) Validate() error {
 		return fmt.Errorf("empty chain name")
 	}
 
-	if c.ChainID == "" {
+	// these can be taken from the chain registry later
+	if c.ChainID == "" && c.ChainRegistryName == "" {
 		return fmt.Errorf("empty chain ID")
 	}
 
-	if len(c.TendermintNodes) == 0 {
+	if len(c.TendermintNodes) == 0 && c.ChainRegistryName == "" {
 		return fmt.Errorf("no Tendermint nodes provided")
 	}
 
-	if len(c.APINodes) == 0 {
+	apiTypes := constants.GetApiTypes()
+	if !utils.Contains(apiTypes, c.ApiType) {
+		return fmt.Errorf(
+			"expected api-type to be one of %s, but got %s",
+			strings.Join(apiTypes, ", "),
+			c.ApiType,
+		)
+	}
+
+	if c.ApiType == constants.ApiTypeGrpc && len(c.GrpcNodes) == 0 && c.ChainRegistryName == "" {
+		return fmt.Errorf("no gRPC nodes provided")
+	}
+
+	if c.ApiType != constants.ApiTypeGrpc && len(c.APINodes) == 0 && c.ChainRegistryName == "" {
 		return fmt.Errorf("no API nodes provided")
 	}
 
@@ -48,12 +81,66 @@ func (c *Chain) Validate() error {
 		}
 	}
 
+	if c.MaxBackfillBlocks.Int64 < 0 {
+		return fmt.Errorf("max-backfill-blocks should not be negative")
+	}
+
+	chainModes := constants.GetChainModes()
+	if !utils.Contains(chainModes, c.Mode) {
+		return fmt.Errorf(
+			"expected mode to be one of %s, but got %s",
+			strings.Join(chainModes, ", "),
+			c.Mode,
+		)
+	}
+
+	if c.Mode == constants.ChainModePoll && c.PollInterval.Int64 <= 0 {
+		return fmt.Errorf("poll-interval should be positive")
+	}
+
+	if c.ActiveNodes.Int64 < 0 {
+		return fmt.Errorf("active-nodes should not be negative")
+	}
+
+	if c.ActiveNodes.Int64 > 0 && c.Mode != constants.ChainModeWebsocket {
+		return fmt.Errorf("active-nodes is only supported in websocket mode")
+	}
+
+	if c.StallTimeout.Int64 < 0 {
+		return fmt.Errorf("stall-timeout should not be negative")
+	}
+
+	if c.ActiveNodes.Int64 > 0 && c.StallTimeout.Int64 == 0 {
+		return fmt.Errorf("stall-timeout should be positive if active-nodes is set")
+	}
+
+	if c.MaxBlocksBehind.Int64 < 0 {
+		return fmt.Errorf("max-blocks-behind should not be negative")
+	}
+
 	for index, denom := range c.Denoms {
 		if err := denom.Validate(); err != nil {
 			return fmt.Errorf("error in denom %d: %s", index, err)
 		}
 	}
 
+	for channel, chainID := range c.IbcChannels {
+		if chainID == "" {
+			return fmt.Errorf("empty chain ID for IBC channel %s", channel)
+		}
+	}
+
+	for denom, path := range c.IbcDenoms {
+		trace := transferTypes.ParseDenomTrace(path)
+		if trace.Path == "" {
+			return fmt.Errorf("IBC denom %s path %s has no channels", denom, path)
+		}
+
+		if trace.IBCDenom() != denom {
+			return fmt.Errorf("IBC denom %s does not match its path %s, expected %s", denom, path, trace.IBCDenom())
+		}
+	}
+
 	return nil
 }
 
@@ -82,23 +169,48 @@ func (c *Chain) ToAppConfigChain() *types.Chain {
 		Name:              c.Name,
 		PrettyName:        c.PrettyName,
 		ChainID:           c.ChainID,
+		ChainRegistryName: c.ChainRegistryName,
 		TendermintNodes:   c.TendermintNodes,
 		APINodes:          c.APINodes,
+		GrpcNodes:         c.GrpcNodes,
+		ApiType:           c.ApiType,
 		Queries:           queries,
 		Explorer:          explorer,
 		SupportedExplorer: supportedExplorer,
 		Denoms:            c.Denoms.ToAppConfigDenomInfos(),
+		IbcChannels:       c.IbcChannels,
+		IbcDenoms:         c.IbcDenoms,
+		Parsers:           c.Parsers.ToAppConfigParsersConfig(),
+		MaxBackfillBlocks: c.MaxBackfillBlocks.Int64,
+		Mode:              c.Mode,
+		PollInterval:      time.Duration(c.PollInterval.Int64) * time.Second,
+		ActiveNodes:       int(c.ActiveNodes.Int64),
+		StallTimeout:      time.Duration(c.StallTimeout.Int64) * time.Second,
+		MaxBlocksBehind:   c.MaxBlocksBehind.Int64,
 	}
 }
 
 func FromAppConfigChain(c *types.Chain) *Chain {
 	chain := &Chain{
-		Name:            c.Name,
-		PrettyName:      c.PrettyName,
-		ChainID:         c.ChainID,
-		TendermintNodes: c.TendermintNodes,
-		APINodes:        c.APINodes,
-		Denoms:          YamlConfigDenomsFrom(c.Denoms),
+		Name:              c.Name,
+		PrettyName:        c.PrettyName,
+		ChainID:           c.ChainID,
+		ChainRegistryName: c.ChainRegistryName,
+		TendermintNodes:   c.TendermintNodes,
+		APINodes:          c.APINodes,
+		GrpcNodes:         c.GrpcNodes,
+		ApiType:           c.ApiType,
+		Denoms:            YamlConfigDenomsFrom(c.Denoms),
+		IbcChannels:       c.IbcChannels,
+		IbcDenoms:         c.IbcDenoms,
+		Parsers:           YamlConfigParsersFrom(c.Parsers),
+
+		MaxBackfillBlocks: null.IntFrom(c.MaxBackfillBlocks),
+		Mode:              c.Mode,
+		PollInterval:      null.IntFrom(int64(c.PollInterval / time.Second)),
+		ActiveNodes:       null.IntFrom(int64(c.ActiveNodes)),
+		StallTimeout:      null.IntFrom(int64(c.StallTimeout / time.Second)),
+		MaxBlocksBehind:   null.IntFrom(c.MaxBlocksBehind),
 	}
 
 	if c.SupportedExplorer == nil && c.Explorer != nil {
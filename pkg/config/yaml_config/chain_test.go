@@ -4,9 +4,11 @@ import (
 	"main/pkg/config/types"
 	yamlConfig "main/pkg/config/yaml_config"
 	"testing"
+	"time"
 
 	queryPkg "github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestChainEmptyName(t *testing.T) {
@@ -86,7 +88,36 @@ func TestChainInvalidDenom(t *testing.T) {
 	require.Error(t, chain.Validate())
 }
 
-func TestChainValid(t *testing.T) {
+func TestChainInvalidMaxBackfillBlocks(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:              "chain",
+		ChainID:           "chain-id",
+		TendermintNodes:   []string{"node"},
+		APINodes:          []string{"node"},
+		ApiType:           "rest",
+		Queries:           []string{"event.key = 'value'"},
+		MaxBackfillBlocks: null.IntFrom(-1),
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainInvalidApiType(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		Queries:         []string{"event.key = 'value'"},
+		ApiType:         "unknown",
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainEmptyGrpcNodes(t *testing.T) {
 	t.Parallel()
 
 	chain := yamlConfig.Chain{
@@ -95,24 +126,224 @@ func TestChainValid(t *testing.T) {
 		TendermintNodes: []string{"node"},
 		APINodes:        []string{"node"},
 		Queries:         []string{"event.key = 'value'"},
+		ApiType:         "grpc",
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainValidGrpc(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		GrpcNodes:       []string{"node:9090"},
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		ApiType:         "grpc",
 	}
 	require.NoError(t, chain.Validate())
+	require.True(t, chain.ToAppConfigChain().IsUsingGrpc())
 }
 
-func TestChainToAppConfigChainBasic(t *testing.T) {
+func TestChainInvalidMode(t *testing.T) {
 	t.Parallel()
 
 	chain := yamlConfig.Chain{
 		Name:            "chain",
-		PrettyName:      "Chain",
 		ChainID:         "chain-id",
-		TendermintNodes: []string{"tendermint-node"},
-		APINodes:        []string{"api-node"},
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		ApiType:         "rest",
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "unknown",
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainInvalidPollInterval(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		ApiType:         "rest",
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "poll",
+		PollInterval:    null.IntFrom(0),
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainInvalidActiveNodes(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		ApiType:         "rest",
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		ActiveNodes:     null.IntFrom(-1),
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainActiveNodesInPollMode(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		ApiType:         "rest",
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "poll",
+		PollInterval:    null.IntFrom(5),
+		ActiveNodes:     null.IntFrom(1),
+		StallTimeout:    null.IntFrom(60),
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainInvalidStallTimeout(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		ApiType:         "rest",
 		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		ActiveNodes:     null.IntFrom(1),
+		StallTimeout:    null.IntFrom(0),
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainInvalidMaxBlocksBehind(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		ApiType:         "rest",
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		MaxBlocksBehind: null.IntFrom(-1),
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainInvalidIbcChannels(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		ApiType:         "rest",
+		IbcChannels:     map[string]string{"channel-0": ""},
+	}
+	require.Error(t, chain.Validate())
+}
+
+func TestChainInvalidIbcDenoms(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		ApiType:         "rest",
+		IbcDenoms:       map[string]string{"ibc/denom": "uatom"},
+	}
+	require.Error(t, chain.Validate())
+
+	chain.IbcDenoms = map[string]string{"ibc/denom": "transfer/channel-0/uatom"}
+	require.Error(t, chain.Validate())
+
+	chain.IbcDenoms = map[string]string{
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2": "transfer/channel-0/uatom",
+	}
+	require.NoError(t, chain.Validate())
+}
+
+func TestChainValidWithChainRegistryName(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:              "chain",
+		ChainRegistryName: "cosmoshub",
+		Queries:           []string{"event.key = 'value'"},
+		Mode:              "websocket",
+		ApiType:           "grpc",
+	}
+	require.NoError(t, chain.Validate())
+}
+
+func TestChainValid(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:            "chain",
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"node"},
+		APINodes:        []string{"node"},
+		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		ApiType:         "rest",
+	}
+	require.NoError(t, chain.Validate())
+}
+
+func TestChainToAppConfigChainBasic(t *testing.T) {
+	t.Parallel()
+
+	chain := yamlConfig.Chain{
+		Name:              "chain",
+		PrettyName:        "Chain",
+		ChainID:           "chain-id",
+		TendermintNodes:   []string{"tendermint-node"},
+		APINodes:          []string{"api-node"},
+		Queries:           []string{"event.key = 'value'"},
+		ChainRegistryName: "cosmoshub",
+		IbcChannels:       map[string]string{"channel-0": "osmosis-1"},
+		IbcDenoms:         map[string]string{"ibc/denom": "transfer/channel-0/uatom"},
+		Mode:              "poll",
+		PollInterval:      null.IntFrom(10),
+		ActiveNodes:       null.IntFrom(1),
+		StallTimeout:      null.IntFrom(30),
+		MaxBlocksBehind:   null.IntFrom(5),
 	}
 	appConfigChain := chain.ToAppConfigChain()
 
 	require.Equal(t, "chain", appConfigChain.Name)
+	require.True(t, appConfigChain.IsPolling())
+	require.Equal(t, 10*time.Second, appConfigChain.PollInterval)
+	require.Equal(t, 1, appConfigChain.ActiveNodes)
+	require.Equal(t, 30*time.Second, appConfigChain.StallTimeout)
+	require.Equal(t, int64(5), appConfigChain.MaxBlocksBehind)
+	require.Equal(t, "cosmoshub", appConfigChain.ChainRegistryName)
+	require.Equal(t, map[string]string{"channel-0": "osmosis-1"}, appConfigChain.IbcChannels)
+	require.Equal(t, map[string]string{"ibc/denom": "transfer/channel-0/uatom"}, appConfigChain.IbcDenoms)
+	require.False(t, appConfigChain.HasStandbyNodes())
 	require.Equal(t, "Chain", appConfigChain.PrettyName)
 	require.Equal(t, "chain-id", appConfigChain.ChainID)
 	require.Len(t, appConfigChain.TendermintNodes, 1)
@@ -186,17 +417,33 @@ func TestChainToYamlConfigChainBasic(t *testing.T) {
 	query := queryPkg.MustParse("event.key = 'value'")
 
 	chain := &types.Chain{
-		Name:            "chain",
-		PrettyName:      "Chain",
-		ChainID:         "chain-id",
-		TendermintNodes: []string{"tendermint-node"},
-		APINodes:        []string{"api-node"},
-		Queries:         []queryPkg.Query{*query},
+		Name:              "chain",
+		PrettyName:        "Chain",
+		ChainID:           "chain-id",
+		TendermintNodes:   []string{"tendermint-node"},
+		APINodes:          []string{"api-node"},
+		Queries:           []queryPkg.Query{*query},
+		ChainRegistryName: "cosmoshub",
+		IbcChannels:       map[string]string{"channel-0": "osmosis-1"},
+		IbcDenoms:         map[string]string{"ibc/denom": "transfer/channel-0/uatom"},
+		Mode:              "poll",
+		PollInterval:      10 * time.Second,
+		ActiveNodes:       1,
+		StallTimeout:      30 * time.Second,
+		MaxBlocksBehind:   5,
 	}
 
 	yamlConfigChain := yamlConfig.FromAppConfigChain(chain)
 
 	require.Equal(t, "chain", yamlConfigChain.Name)
+	require.Equal(t, "poll", yamlConfigChain.Mode)
+	require.Equal(t, int64(10), yamlConfigChain.PollInterval.Int64)
+	require.Equal(t, int64(1), yamlConfigChain.ActiveNodes.Int64)
+	require.Equal(t, int64(30), yamlConfigChain.StallTimeout.Int64)
+	require.Equal(t, int64(5), yamlConfigChain.MaxBlocksBehind.Int64)
+	require.Equal(t, "cosmoshub", yamlConfigChain.ChainRegistryName)
+	require.Equal(t, map[string]string{"channel-0": "osmosis-1"}, yamlConfigChain.IbcChannels)
+	require.Equal(t, map[string]string{"ibc/denom": "transfer/channel-0/uatom"}, yamlConfigChain.IbcDenoms)
 	require.Equal(t, "Chain", yamlConfigChain.PrettyName)
 	require.Equal(t, "chain-id", yamlConfigChain.ChainID)
 	require.Len(t, yamlConfigChain.TendermintNodes, 1)
@@ -308,6 +555,8 @@ func TestChainsValid(t *testing.T) {
 		TendermintNodes: []string{"node"},
 		APINodes:        []string{"node"},
 		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		ApiType:         "rest",
 	}
 	chain2 := &yamlConfig.Chain{
 		Name:            "chain2",
@@ -315,6 +564,8 @@ func TestChainsValid(t *testing.T) {
 		TendermintNodes: []string{"node"},
 		APINodes:        []string{"node"},
 		Queries:         []string{"event.key = 'value'"},
+		Mode:            "websocket",
+		ApiType:         "rest",
 	}
 	chains := yamlConfig.Chains{chain1, chain2}
 
@@ -3,13 +3,16 @@ package yaml_config
 import (
 	"fmt"
 	"main/pkg/config/types"
+	"main/pkg/constants"
+	"main/pkg/utils"
 )
 
 type DenomInfo struct {
-	Denom             string `yaml:"denom"`
-	DisplayDenom      string `default:""                yaml:"display-denom"`
-	DenomExponent     int    `default:"6"               yaml:"denom-exponent"`
-	CoingeckoCurrency string `yaml:"coingecko-currency"`
+	Denom             string       `yaml:"denom"`
+	DisplayDenom      string       `default:""                yaml:"display-denom"`
+	DenomExponent     int          `default:"6"               yaml:"denom-exponent"`
+	CoingeckoCurrency string       `yaml:"coingecko-currency,omitempty"`
+	PriceSources      PriceSources `yaml:"price-sources,omitempty"`
 }
 
 func (d *DenomInfo) Validate() error {
@@ -21,6 +24,14 @@ func (d *DenomInfo) Validate() error {
 		return fmt.Errorf("display denom is not set")
 	}
 
+	if err := d.PriceSources.Validate(); err != nil {
+		return err
+	}
+
+	if d.CoingeckoCurrency == "" && d.PriceSources.Find(constants.PriceSourceTypeCoingecko) != nil {
+		return fmt.Errorf("coingecko price source is set, but coingecko-currency is not")
+	}
+
 	return nil
 }
 
@@ -34,6 +45,9 @@ func (d DenomInfos) ToAppConfigDenomInfos() types.DenomInfos {
 			DisplayDenom:      info.DisplayDenom,
 			DenomExponent:     info.DenomExponent,
 			CoingeckoCurrency: info.CoingeckoCurrency,
+			PriceSources: utils.Map(info.PriceSources, func(s *PriceSource) *types.PriceSource {
+				return s.ToAppConfigPriceSource()
+			}),
 		}
 	}
 
@@ -48,6 +62,7 @@ func YamlConfigDenomsFrom(d types.DenomInfos) DenomInfos {
 			DisplayDenom:      info.DisplayDenom,
 			DenomExponent:     info.DenomExponent,
 			CoingeckoCurrency: info.CoingeckoCurrency,
+			PriceSources:      utils.Map(info.PriceSources, FromAppConfigPriceSource),
 		}
 	}
 
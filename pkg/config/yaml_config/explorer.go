@@ -3,11 +3,11 @@ package yaml_config
 import "main/pkg/config/types"
 
 type Explorer struct {
-	ProposalLinkPattern    string `yaml:"proposal-link-pattern"`
-	WalletLinkPattern      string `yaml:"wallet-link-pattern"`
-	ValidatorLinkPattern   string `yaml:"validator-link-pattern"`
-	TransactionLinkPattern string `yaml:"transaction-link-pattern"`
-	BlockLinkPattern       string `yaml:"block-link-pattern"`
+	ProposalLinkPattern    string `yaml:"proposal-link-pattern,omitempty"`
+	WalletLinkPattern      string `yaml:"wallet-link-pattern,omitempty"`
+	ValidatorLinkPattern   string `yaml:"validator-link-pattern,omitempty"`
+	TransactionLinkPattern string `yaml:"transaction-link-pattern,omitempty"`
+	BlockLinkPattern       string `yaml:"block-link-pattern,omitempty"`
 }
 
 func (e *Explorer) ToAppConfigExplorer() *types.Explorer {
@@ -0,0 +1,25 @@
+package yaml_config
+
+import (
+	"errors"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type HistoricalPricesConfig struct {
+	Enabled     null.Bool  `default:"false" yaml:"enabled"`
+	MinUSDValue null.Float `default:"1000"  yaml:"min-usd-value"`
+	MinAge      null.Int   `default:"600"   yaml:"min-age"`
+}
+
+func (c *HistoricalPricesConfig) Validate() error {
+	if c.MinUSDValue.Float64 < 0 {
+		return errors.New("min-usd-value should not be negative")
+	}
+
+	if c.MinAge.Int64 < 0 {
+		return errors.New("min-age should not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package yaml_config
+
+import (
+	"main/pkg/config/types"
+)
+
+type ParsersConfig struct {
+	Enabled   []string          `yaml:"enabled"`
+	Disabled  []string          `yaml:"disabled"`
+	Overrides map[string]string `yaml:"overrides"`
+}
+
+func (c *ParsersConfig) ToAppConfigParsersConfig() types.ParsersConfig {
+	return types.ParsersConfig{
+		Enabled:   c.Enabled,
+		Disabled:  c.Disabled,
+		Overrides: c.Overrides,
+	}
+}
+
+func YamlConfigParsersFrom(c types.ParsersConfig) ParsersConfig {
+	return ParsersConfig{
+		Enabled:   c.Enabled,
+		Disabled:  c.Disabled,
+		Overrides: c.Overrides,
+	}
+}
@@ -0,0 +1,39 @@
+package yaml_config_test
+
+import (
+	"main/pkg/config/types"
+	yamlConfig "main/pkg/config/yaml_config"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsersConfigToAppConfig(t *testing.T) {
+	t.Parallel()
+
+	parsers := yamlConfig.ParsersConfig{
+		Enabled:   []string{"enabled"},
+		Disabled:  []string{"disabled"},
+		Overrides: map[string]string{"from": "to"},
+	}
+	appConfigParsers := parsers.ToAppConfigParsersConfig()
+
+	require.Equal(t, []string{"enabled"}, appConfigParsers.Enabled)
+	require.Equal(t, []string{"disabled"}, appConfigParsers.Disabled)
+	require.Equal(t, map[string]string{"from": "to"}, appConfigParsers.Overrides)
+}
+
+func TestParsersConfigToYamlConfig(t *testing.T) {
+	t.Parallel()
+
+	parsers := types.ParsersConfig{
+		Enabled:   []string{"enabled"},
+		Disabled:  []string{"disabled"},
+		Overrides: map[string]string{"from": "to"},
+	}
+	yamlConfigParsers := yamlConfig.YamlConfigParsersFrom(parsers)
+
+	require.Equal(t, []string{"enabled"}, yamlConfigParsers.Enabled)
+	require.Equal(t, []string{"disabled"}, yamlConfigParsers.Disabled)
+	require.Equal(t, map[string]string{"from": "to"}, yamlConfigParsers.Overrides)
+}
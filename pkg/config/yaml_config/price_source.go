@@ -0,0 +1,132 @@
+package yaml_config
+
+import (
+	"errors"
+	"fmt"
+	"main/pkg/config/types"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strings"
+)
+
+type PriceSource struct {
+	Type string `yaml:"type"`
+
+	Price float64 `yaml:"price,omitempty"`
+
+	LCD           string `yaml:"lcd,omitempty"`
+	PoolID        uint64 `yaml:"pool-id,omitempty"`
+	BaseDenom     string `yaml:"base-denom,omitempty"`
+	QuoteDenom    string `yaml:"quote-denom,omitempty"`
+	QuoteExponent int    `yaml:"quote-exponent,omitempty"`
+
+	URL  string `yaml:"url,omitempty"`
+	Path string `yaml:"path,omitempty"`
+}
+
+func (s *PriceSource) Validate() error {
+	sourceTypes := constants.GetPriceSourceTypes()
+	if !utils.Contains(sourceTypes, s.Type) {
+		return fmt.Errorf(
+			"expected type to be one of %s, but got %s",
+			strings.Join(sourceTypes, ", "),
+			s.Type,
+		)
+	}
+
+	switch s.Type {
+	case constants.PriceSourceTypeStatic:
+		if s.Price <= 0 {
+			return errors.New("price should be positive for static price source")
+		}
+	case constants.PriceSourceTypeOsmosis:
+		if s.PoolID == 0 {
+			return errors.New("pool-id is not set for osmosis price source")
+		}
+
+		if s.BaseDenom == "" || s.QuoteDenom == "" {
+			return errors.New("base-denom and quote-denom should be set for osmosis price source")
+		}
+	case constants.PriceSourceTypeJSON:
+		if s.URL == "" {
+			return errors.New("url is not set for json price source")
+		}
+
+		if _, err := utils.ParseJSONPath(s.Path); err != nil {
+			return fmt.Errorf("invalid path for json price source: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *PriceSource) ToAppConfigPriceSource() *types.PriceSource {
+	priceSource := &types.PriceSource{
+		Type:          s.Type,
+		Price:         s.Price,
+		LCD:           s.LCD,
+		PoolID:        s.PoolID,
+		BaseDenom:     s.BaseDenom,
+		QuoteDenom:    s.QuoteDenom,
+		QuoteExponent: s.QuoteExponent,
+		URL:           s.URL,
+		Path:          s.Path,
+	}
+
+	if priceSource.Type == constants.PriceSourceTypeOsmosis {
+		if priceSource.LCD == "" {
+			priceSource.LCD = constants.OsmosisDefaultLCD
+		}
+
+		if priceSource.QuoteExponent == 0 {
+			priceSource.QuoteExponent = constants.OsmosisDefaultQuoteExponent
+		}
+	}
+
+	return priceSource
+}
+
+func FromAppConfigPriceSource(s *types.PriceSource) *PriceSource {
+	return &PriceSource{
+		Type:          s.Type,
+		Price:         s.Price,
+		LCD:           s.LCD,
+		PoolID:        s.PoolID,
+		BaseDenom:     s.BaseDenom,
+		QuoteDenom:    s.QuoteDenom,
+		QuoteExponent: s.QuoteExponent,
+		URL:           s.URL,
+		Path:          s.Path,
+	}
+}
+
+type PriceSources []*PriceSource
+
+func (s PriceSources) Validate() error {
+	sourceTypes := map[string]bool{}
+
+	for index, source := range s {
+		if err := source.Validate(); err != nil {
+			return fmt.Errorf("error in price source %d: %s", index, err)
+		}
+
+		// price fetchers find the source by type, so each can be used once per denom
+		if _, ok := sourceTypes[source.Type]; ok {
+			return fmt.Errorf("duplicate price source type: %s", source.Type)
+		}
+
+		sourceTypes[source.Type] = true
+	}
+
+	return nil
+}
+
+func (s PriceSources) Find(sourceType string) *PriceSource {
+	for _, source := range s {
+		if source.Type == sourceType {
+			return source
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package yaml_config_test
+
+import (
+	"main/pkg/config/types"
+	yamlConfig "main/pkg/config/yaml_config"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceSourceInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, source := range []yamlConfig.PriceSource{
+		{Type: "unknown"},
+		{Type: "static"},
+		{Type: "static", Price: -1},
+		{Type: "osmosis", BaseDenom: "uosmo", QuoteDenom: "uusdc"},
+		{Type: "osmosis", PoolID: 1, BaseDenom: "uosmo"},
+		{Type: "json", Path: "$.price"},
+		{Type: "json", URL: "https://example.com", Path: "price"},
+	} {
+		require.Error(t, source.Validate(), source.Type)
+	}
+}
+
+func TestPriceSourceValid(t *testing.T) {
+	t.Parallel()
+
+	for _, source := range []yamlConfig.PriceSource{
+		{Type: "coingecko"},
+		{Type: "static", Price: 1},
+		{Type: "osmosis", PoolID: 1, BaseDenom: "uosmo", QuoteDenom: "uusdc"},
+		{Type: "json", URL: "https://example.com", Path: "$.price"},
+	} {
+		require.NoError(t, source.Validate(), source.Type)
+	}
+}
+
+func TestPriceSourcesDuplicate(t *testing.T) {
+	t.Parallel()
+
+	sources := yamlConfig.PriceSources{
+		{Type: "static", Price: 1},
+		{Type: "static", Price: 2},
+	}
+	require.Error(t, sources.Validate())
+}
+
+func TestDenomCoingeckoSourceWithoutCurrency(t *testing.T) {
+	t.Parallel()
+
+	denom := yamlConfig.DenomInfo{
+		Denom:        "udenom",
+		DisplayDenom: "denom",
+		PriceSources: yamlConfig.PriceSources{{Type: "coingecko"}},
+	}
+	require.Error(t, denom.Validate())
+
+	denom.CoingeckoCurrency = "denom"
+	require.NoError(t, denom.Validate())
+
+	denom.PriceSources = yamlConfig.PriceSources{{Type: "static"}}
+	require.Error(t, denom.Validate())
+}
+
+func TestPriceSourceToAppConfig(t *testing.T) {
+	t.Parallel()
+
+	source := &yamlConfig.PriceSource{Type: "osmosis", PoolID: 1, BaseDenom: "uosmo", QuoteDenom: "uusdc"}
+	appConfigSource := source.ToAppConfigPriceSource()
+	require.Equal(t, "https://lcd.osmosis.zone", appConfigSource.LCD)
+	require.Equal(t, 6, appConfigSource.QuoteExponent)
+	require.Equal(t, uint64(1), appConfigSource.PoolID)
+
+	staticSource := (&yamlConfig.PriceSource{Type: "static", Price: 1}).ToAppConfigPriceSource()
+	require.Empty(t, staticSource.LCD)
+	require.Zero(t, staticSource.QuoteExponent)
+
+	yamlSource := yamlConfig.FromAppConfigPriceSource(&types.PriceSource{Type: "json", URL: "url", Path: "$.price"})
+	require.Equal(t, "json", yamlSource.Type)
+	require.Equal(t, "url", yamlSource.URL)
+	require.Equal(t, "$.price", yamlSource.Path)
+}
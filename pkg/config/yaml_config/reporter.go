@@ -21,6 +21,8 @@ type Reporter struct {
 	Type     string `default:"telegram" yaml:"type"`
 	Timezone string `default:"Etc/GMT"  yaml:"timezone"`
 
+	QuoteCurrencies []string `default:"[\"usd\"]" yaml:"quote-currencies"`
+
 	TelegramConfig *TelegramConfig `yaml:"telegram-config"`
 }
 
@@ -42,6 +44,12 @@ func (reporter *Reporter) Validate() error {
 		)
 	}
 
+	for index, currency := range reporter.QuoteCurrencies {
+		if currency == "" {
+			return fmt.Errorf("empty quote currency at index %d", index)
+		}
+	}
+
 	if reporter.Type == constants.ReporterTypeTelegram && reporter.TelegramConfig == nil {
 		return errors.New("missing telegram-config for Telegram reporter")
 	}
@@ -94,10 +102,11 @@ func FromAppConfigReporter(reporter *types.Reporter) *Reporter {
 	}
 
 	return &Reporter{
-		Name:           reporter.Name,
-		Type:           reporter.Type,
-		Timezone:       reporter.Timezone.String(),
-		TelegramConfig: telegramConfig,
+		Name:            reporter.Name,
+		Type:            reporter.Type,
+		Timezone:        reporter.Timezone.String(),
+		QuoteCurrencies: reporter.QuoteCurrencies,
+		TelegramConfig:  telegramConfig,
 	}
 }
 
@@ -115,9 +124,11 @@ func (reporter *Reporter) ToAppConfigReporter() *types.Reporter {
 	timezone, _ := time.LoadLocation(reporter.Timezone)
 
 	return &types.Reporter{
-		Name:           reporter.Name,
-		Type:           reporter.Type,
-		Timezone:       timezone,
-		TelegramConfig: telegramConfig,
+		Name:     reporter.Name,
+		Type:     reporter.Type,
+		Timezone: timezone,
+		// CoinGecko currencies are lowercase, and display denoms are compared case-insensitively
+		QuoteCurrencies: utils.Map(reporter.QuoteCurrencies, strings.ToLower),
+		TelegramConfig:  telegramConfig,
 	}
 }
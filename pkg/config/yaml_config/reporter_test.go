@@ -138,7 +138,8 @@ func TestReporterToAppConfigReporter(t *testing.T) {
 			Token:  "xxx:yyy",
 			Admins: []int64{123},
 		},
-		Timezone: "Etc/GMT",
+		Timezone:        "Etc/GMT",
+		QuoteCurrencies: []string{"USD", "eur"},
 	}
 	appConfigReporter := reporter.ToAppConfigReporter()
 
@@ -148,6 +149,20 @@ func TestReporterToAppConfigReporter(t *testing.T) {
 	require.Equal(t, "xxx:yyy", appConfigReporter.TelegramConfig.Token)
 	require.Equal(t, []int64{123}, appConfigReporter.TelegramConfig.Admins)
 	require.Equal(t, "Etc/GMT", appConfigReporter.Timezone.String())
+	require.Equal(t, []string{"usd", "eur"}, appConfigReporter.QuoteCurrencies)
+}
+
+func TestReporterEmptyQuoteCurrency(t *testing.T) {
+	t.Parallel()
+
+	reporter := yamlConfig.Reporter{
+		Name:            "test",
+		Type:            "telegram",
+		Timezone:        "Etc/GMT",
+		QuoteCurrencies: []string{"usd", ""},
+		TelegramConfig:  &yamlConfig.TelegramConfig{},
+	}
+	require.Error(t, reporter.Validate())
 }
 
 func TestReporterToYamlConfigReporter(t *testing.T) {
@@ -3,8 +3,9 @@ package yaml_config
 import (
 	"fmt"
 	"main/pkg/config/types"
+	"main/pkg/filter"
+	"math/big"
 
-	"github.com/cometbft/cometbft/libs/pubsub/query"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -21,11 +22,16 @@ type ChainSubscriptions []*ChainSubscription
 type ChainSubscription struct {
 	Chain                  string    `yaml:"name"`
 	Filters                []string  `yaml:"filters"`
+	TxFilters              []string  `yaml:"tx-filters"`
 	LogUnknownMessages     null.Bool `default:"false" yaml:"log-unknown-messages"`
 	LogUnparsedMessages    null.Bool `default:"true"  yaml:"log-unparsed-messages"`
 	LogFailedTransactions  null.Bool `default:"true"  yaml:"log-failed-transactions"`
 	LogNodeErrors          null.Bool `default:"true"  yaml:"log-node-errors"`
 	FilterInternalMessages null.Bool `default:"true"  yaml:"filter-internal-messages"`
+
+	MinUSDValue null.Float         `yaml:"min-usd-value"`
+	MaxUSDValue null.Float         `yaml:"max-usd-value"`
+	MinAmounts  map[string]float64 `yaml:"min-amounts"`
 }
 
 func (subscriptions Subscriptions) Validate() error {
@@ -72,29 +78,84 @@ func (s *ChainSubscription) Validate() error {
 		return fmt.Errorf("empty chain name")
 	}
 
-	for index, filter := range s.Filters {
-		if _, err := query.New(filter); err != nil {
+	for index, source := range s.Filters {
+		if _, err := filter.Parse(source); err != nil {
 			return fmt.Errorf("error in filter %d: %s", index, err)
 		}
 	}
 
+	for index, source := range s.TxFilters {
+		if _, err := filter.Parse(source); err != nil {
+			return fmt.Errorf("error in tx filter %d: %s", index, err)
+		}
+	}
+
+	if s.MinUSDValue.Valid && s.MinUSDValue.Float64 < 0 {
+		return fmt.Errorf("min-usd-value should not be negative")
+	}
+
+	if s.MaxUSDValue.Valid && s.MaxUSDValue.Float64 < 0 {
+		return fmt.Errorf("max-usd-value should not be negative")
+	}
+
+	if s.MinUSDValue.Valid && s.MaxUSDValue.Valid && s.MinUSDValue.Float64 > s.MaxUSDValue.Float64 {
+		return fmt.Errorf("min-usd-value should not be greater than max-usd-value")
+	}
+
+	for denom, minAmount := range s.MinAmounts {
+		if minAmount < 0 {
+			return fmt.Errorf("min-amount for denom %s should not be negative", denom)
+		}
+	}
+
 	return nil
 }
 
+// GetAddressListNames returns the names of all address lists referenced in filters,
+// so they can be checked against the ones declared in config.
+func (s *ChainSubscription) GetAddressListNames() []string {
+	names := make([]string, 0)
+
+	for _, source := range append(append([]string{}, s.Filters...), s.TxFilters...) {
+		if expression, err := filter.Parse(source); err == nil {
+			names = append(names, expression.GetAddressListNames()...)
+		}
+	}
+
+	return names
+}
+
 func (s *ChainSubscription) ToAppConfigChainSubscription() *types.ChainSubscription {
-	filters := make([]query.Query, len(s.Filters))
-	for index, filter := range s.Filters {
-		filters[index] = *query.MustParse(filter)
+	filters := make(types.Filters, len(s.Filters))
+	for index, source := range s.Filters {
+		filters[index] = *filter.MustParse(source)
+	}
+
+	txFilters := make(types.Filters, len(s.TxFilters))
+	for index, source := range s.TxFilters {
+		txFilters[index] = *filter.MustParse(source)
+	}
+
+	var minAmounts map[string]*big.Float
+	if len(s.MinAmounts) > 0 {
+		minAmounts = make(map[string]*big.Float, len(s.MinAmounts))
+		for denom, minAmount := range s.MinAmounts {
+			minAmounts[denom] = big.NewFloat(minAmount)
+		}
 	}
 
 	return &types.ChainSubscription{
 		Chain:                  s.Chain,
 		Filters:                filters,
+		TxFilters:              txFilters,
 		LogUnknownMessages:     s.LogUnknownMessages.Bool,
 		LogUnparsedMessages:    s.LogUnparsedMessages.Bool,
 		LogFailedTransactions:  s.LogFailedTransactions.Bool,
 		LogNodeErrors:          s.LogNodeErrors.Bool,
 		FilterInternalMessages: s.FilterInternalMessages.Bool,
+		MinUSDValue:            nullFloatToBigFloat(s.MinUSDValue),
+		MaxUSDValue:            nullFloatToBigFloat(s.MaxUSDValue),
+		MinAmounts:             minAmounts,
 	}
 }
 
@@ -122,8 +183,23 @@ func FromAppConfigChainSubscription(s *types.ChainSubscription) *ChainSubscripti
 	}
 
 	subscription.Filters = make([]string, len(s.Filters))
-	for index, filter := range s.Filters {
-		subscription.Filters[index] = filter.String()
+	for index, expression := range s.Filters {
+		subscription.Filters[index] = expression.String()
+	}
+
+	subscription.TxFilters = make([]string, len(s.TxFilters))
+	for index, expression := range s.TxFilters {
+		subscription.TxFilters[index] = expression.String()
+	}
+
+	subscription.MinUSDValue = bigFloatToNullFloat(s.MinUSDValue)
+	subscription.MaxUSDValue = bigFloatToNullFloat(s.MaxUSDValue)
+
+	if len(s.MinAmounts) > 0 {
+		subscription.MinAmounts = make(map[string]float64, len(s.MinAmounts))
+		for denom, minAmount := range s.MinAmounts {
+			subscription.MinAmounts[denom], _ = minAmount.Float64()
+		}
 	}
 
 	return subscription
@@ -142,3 +218,20 @@ func FromAppConfigSubscription(s *types.Subscription) *Subscription {
 
 	return subscription
 }
+
+func nullFloatToBigFloat(value null.Float) *big.Float {
+	if !value.Valid {
+		return nil
+	}
+
+	return big.NewFloat(value.Float64)
+}
+
+func bigFloatToNullFloat(value *big.Float) null.Float {
+	if value == nil {
+		return null.Float{}
+	}
+
+	valueFloat, _ := value.Float64()
+	return null.FloatFrom(valueFloat)
+}
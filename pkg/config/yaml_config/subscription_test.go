@@ -3,9 +3,10 @@ package yaml_config_test
 import (
 	"main/pkg/config/types"
 	yamlConfig "main/pkg/config/yaml_config"
+	"main/pkg/filter"
+	"math/big"
 	"testing"
 
-	queryPkg "github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 )
@@ -69,6 +70,38 @@ func TestChainSubscriptionInvalidFilter(t *testing.T) {
 	require.Error(t, subscription.Validate())
 }
 
+func TestChainSubscriptionInvalidTxFilter(t *testing.T) {
+	t.Parallel()
+
+	subscription := yamlConfig.ChainSubscription{
+		Chain:     "chain",
+		TxFilters: []string{"invalid"},
+	}
+	require.Error(t, subscription.Validate())
+}
+
+func TestChainSubscriptionInvalidAmountFilters(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, (&yamlConfig.ChainSubscription{
+		Chain:       "chain",
+		MinUSDValue: null.FloatFrom(-1),
+	}).Validate())
+	require.Error(t, (&yamlConfig.ChainSubscription{
+		Chain:       "chain",
+		MaxUSDValue: null.FloatFrom(-1),
+	}).Validate())
+	require.Error(t, (&yamlConfig.ChainSubscription{
+		Chain:       "chain",
+		MinUSDValue: null.FloatFrom(100),
+		MaxUSDValue: null.FloatFrom(10),
+	}).Validate())
+	require.Error(t, (&yamlConfig.ChainSubscription{
+		Chain:      "chain",
+		MinAmounts: map[string]float64{"atom": -1},
+	}).Validate())
+}
+
 func TestChainSubscriptionValid(t *testing.T) {
 	t.Parallel()
 
@@ -166,6 +199,9 @@ func TestChainSubscriptionToAppConfigChainSubscription(t *testing.T) {
 	subscription := &yamlConfig.ChainSubscription{
 		Chain:                  "chain",
 		Filters:                []string{"event.key = 'value'"},
+		TxFilters:              []string{"tx.memo = 'memo'"},
+		MinUSDValue:            null.FloatFrom(100),
+		MinAmounts:             map[string]float64{"atom": 10},
 		LogUnknownMessages:     null.BoolFrom(true),
 		LogUnparsedMessages:    null.BoolFrom(true),
 		LogFailedTransactions:  null.BoolFrom(true),
@@ -182,16 +218,25 @@ func TestChainSubscriptionToAppConfigChainSubscription(t *testing.T) {
 	require.True(t, appConfigSubscription.FilterInternalMessages)
 	require.Len(t, appConfigSubscription.Filters, 1)
 	require.Equal(t, "event.key = 'value'", appConfigSubscription.Filters[0].String())
+	require.Len(t, appConfigSubscription.TxFilters, 1)
+	require.Equal(t, "tx.memo = 'memo'", appConfigSubscription.TxFilters[0].String())
+	require.NotNil(t, appConfigSubscription.MinUSDValue)
+	require.Nil(t, appConfigSubscription.MaxUSDValue)
+	require.Len(t, appConfigSubscription.MinAmounts, 1)
+	require.Equal(t, "10", appConfigSubscription.MinAmounts["atom"].String())
 }
 
 func TestChainSubscriptionToYamlConfigChainSubscription(t *testing.T) {
 	t.Parallel()
 
-	query := queryPkg.MustParse("event.key = 'value'")
+	query := filter.MustParse("event.key = 'value'")
 
 	subscription := &types.ChainSubscription{
 		Chain:                  "chain",
-		Filters:                []queryPkg.Query{*query},
+		Filters:                types.Filters{*query},
+		TxFilters:              types.Filters{*query},
+		MaxUSDValue:            big.NewFloat(100),
+		MinAmounts:             map[string]*big.Float{"atom": big.NewFloat(10)},
 		LogUnknownMessages:     true,
 		LogUnparsedMessages:    true,
 		LogFailedTransactions:  true,
@@ -208,4 +253,9 @@ func TestChainSubscriptionToYamlConfigChainSubscription(t *testing.T) {
 	require.True(t, yamlConfigSubscription.FilterInternalMessages.Bool)
 	require.Len(t, yamlConfigSubscription.Filters, 1)
 	require.Equal(t, "event.key = 'value'", yamlConfigSubscription.Filters[0])
+	require.Len(t, yamlConfigSubscription.TxFilters, 1)
+	require.Equal(t, "event.key = 'value'", yamlConfigSubscription.TxFilters[0])
+	require.False(t, yamlConfigSubscription.MinUSDValue.Valid)
+	require.InDelta(t, 100, yamlConfigSubscription.MaxUSDValue.Float64, 0.001)
+	require.Equal(t, map[string]float64{"atom": 10}, yamlConfigSubscription.MinAmounts)
 }
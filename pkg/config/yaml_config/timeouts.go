@@ -0,0 +1,42 @@
+package yaml_config
+
+import (
+	"errors"
+	"fmt"
+	"main/pkg/types/query_info"
+	"main/pkg/utils"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type TimeoutsConfig struct {
+	Query      null.Int         `default:"60"  yaml:"query"`
+	Enrichment null.Int         `default:"120" yaml:"enrichment"`
+	Queries    map[string]int64 `yaml:"queries"`
+}
+
+func (c *TimeoutsConfig) Validate() error {
+	if c.Query.Int64 < 0 {
+		return errors.New("query timeout should not be negative")
+	}
+
+	if c.Enrichment.Int64 < 0 {
+		return errors.New("enrichment timeout should not be negative")
+	}
+
+	queryTypes := utils.Map(query_info.GetQueryTypes(), func(queryType query_info.QueryType) string {
+		return string(queryType)
+	})
+
+	for queryType, timeout := range c.Queries {
+		if !utils.Contains(queryTypes, queryType) {
+			return fmt.Errorf("unknown query type '%s', expected one of %v", queryType, queryTypes)
+		}
+
+		if timeout <= 0 {
+			return fmt.Errorf("timeout for query type '%s' should be positive", queryType)
+		}
+	}
+
+	return nil
+}
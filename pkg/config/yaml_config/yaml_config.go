@@ -8,10 +8,18 @@ import (
 
 type YamlConfig struct {
 	AliasesPath   string        `yaml:"aliases"`
+	StatePath     string        `yaml:"state"`
+	CachePath     string        `yaml:"cache"`
+	OutboxPath    string        `yaml:"outbox"`
 	LogConfig     LogConfig     `yaml:"log"`
 	MetricsConfig MetricsConfig `yaml:"metrics"`
+
+	HistoricalPricesConfig HistoricalPricesConfig `yaml:"historical-prices"`
+	TimeoutsConfig         TimeoutsConfig         `yaml:"timeouts"`
+
 	Chains        Chains        `yaml:"chains"`
 	Subscriptions Subscriptions `yaml:"subscriptions"`
+	AddressLists  AddressLists  `yaml:"address-lists"`
 
 	Reporters Reporters `yaml:"reporters"`
 }
@@ -30,10 +38,22 @@ func (c *YamlConfig) Validate() error {
 		return fmt.Errorf("error in chains: %s", err)
 	}
 
+	if err := c.HistoricalPricesConfig.Validate(); err != nil {
+		return fmt.Errorf("error in historical prices config: %s", err)
+	}
+
+	if err := c.TimeoutsConfig.Validate(); err != nil {
+		return fmt.Errorf("error in timeouts config: %s", err)
+	}
+
 	if err := c.Reporters.Validate(); err != nil {
 		return fmt.Errorf("error in reporters: %s", err)
 	}
 
+	if err := c.AddressLists.Validate(); err != nil {
+		return fmt.Errorf("error in address lists: %s", err)
+	}
+
 	if err := c.Subscriptions.Validate(); err != nil {
 		return fmt.Errorf("error in subscriptions: %s", err)
 	}
@@ -48,6 +68,17 @@ func (c *YamlConfig) Validate() error {
 					chainSubscription.Chain,
 				)
 			}
+
+			for _, listName := range chainSubscription.GetAddressListNames() {
+				if !c.AddressLists.HasAddressListByName(listName) {
+					return fmt.Errorf(
+						"error in subscription %d: error in chain %d: no such address list '%s'",
+						index,
+						chainSubscriptionIndex,
+						listName,
+					)
+				}
+			}
 		}
 
 		if !c.Reporters.HasReporterByName(subscription.Reporter) {
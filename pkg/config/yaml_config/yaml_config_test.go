@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestYamlConfigNoChains(t *testing.T) {
@@ -45,6 +46,54 @@ func TestYamlConfigInvalidReporter(t *testing.T) {
 	require.Error(t, config.Validate())
 }
 
+func TestYamlConfigInvalidHistoricalPrices(t *testing.T) {
+	t.Parallel()
+
+	for _, historicalPricesConfig := range []yamlConfig.HistoricalPricesConfig{
+		{MinUSDValue: null.FloatFrom(-1)},
+		{MinAge: null.IntFrom(-1)},
+	} {
+		config := yamlConfig.YamlConfig{
+			Chains: yamlConfig.Chains{
+				{
+					Name:            "chain",
+					ChainID:         "chain-id",
+					TendermintNodes: []string{"node"},
+					APINodes:        []string{"node"},
+					Queries:         []string{"event.key = 'value'"},
+				},
+			},
+			HistoricalPricesConfig: historicalPricesConfig,
+		}
+		require.Error(t, config.Validate())
+	}
+}
+
+func TestYamlConfigInvalidTimeouts(t *testing.T) {
+	t.Parallel()
+
+	for _, timeoutsConfig := range []yamlConfig.TimeoutsConfig{
+		{Query: null.IntFrom(-1), Enrichment: null.IntFrom(120)},
+		{Query: null.IntFrom(60), Enrichment: null.IntFrom(-1)},
+		{Query: null.IntFrom(60), Enrichment: null.IntFrom(120), Queries: map[string]int64{"unknown": 10}},
+		{Query: null.IntFrom(60), Enrichment: null.IntFrom(120), Queries: map[string]int64{"validator": 0}},
+	} {
+		config := yamlConfig.YamlConfig{
+			Chains: yamlConfig.Chains{
+				{
+					Name:            "chain",
+					ChainID:         "chain-id",
+					TendermintNodes: []string{"node"},
+					APINodes:        []string{"node"},
+					Queries:         []string{"event.key = 'value'"},
+				},
+			},
+			TimeoutsConfig: timeoutsConfig,
+		}
+		require.Error(t, config.Validate())
+	}
+}
+
 func TestYamlConfigInvalidSubscription(t *testing.T) {
 	t.Parallel()
 
@@ -161,6 +210,8 @@ func TestYamlConfigValid(t *testing.T) {
 				TendermintNodes: []string{"node"},
 				APINodes:        []string{"node"},
 				Queries:         []string{"event.key = 'value'"},
+				Mode:            "websocket",
+				ApiType:         "rest",
 			},
 		},
 		Reporters: yamlConfig.Reporters{
@@ -186,3 +237,120 @@ func TestYamlConfigValid(t *testing.T) {
 	}
 	require.NoError(t, config.Validate())
 }
+
+func TestYamlConfigAddressListNotFound(t *testing.T) {
+	t.Parallel()
+
+	config := yamlConfig.YamlConfig{
+		Chains: yamlConfig.Chains{
+			{
+				Name:            "chain",
+				ChainID:         "chain-id",
+				TendermintNodes: []string{"node"},
+				APINodes:        []string{"node"},
+				Queries:         []string{"event.key = 'value'"},
+			},
+		},
+		Reporters: yamlConfig.Reporters{
+			{
+				Name: "test",
+				Type: "telegram",
+				TelegramConfig: &yamlConfig.TelegramConfig{
+					Chat:   1,
+					Token:  "xxx:yyy",
+					Admins: []int64{123},
+				},
+			},
+		},
+		Subscriptions: yamlConfig.Subscriptions{
+			{
+				Name:     "name",
+				Reporter: "test",
+				ChainSubscriptions: yamlConfig.ChainSubscriptions{
+					{Chain: "chain", Filters: []string{"transfer.recipient IN @exchanges"}},
+				},
+			},
+		},
+	}
+	require.Error(t, config.Validate())
+}
+
+func TestYamlConfigInvalidAddressList(t *testing.T) {
+	t.Parallel()
+
+	config := yamlConfig.YamlConfig{
+		Chains: yamlConfig.Chains{
+			{
+				Name:            "chain",
+				ChainID:         "chain-id",
+				TendermintNodes: []string{"node"},
+				APINodes:        []string{"node"},
+				Queries:         []string{"event.key = 'value'"},
+			},
+		},
+		Reporters: yamlConfig.Reporters{
+			{
+				Name: "test",
+				Type: "telegram",
+				TelegramConfig: &yamlConfig.TelegramConfig{
+					Chat:   1,
+					Token:  "xxx:yyy",
+					Admins: []int64{123},
+				},
+			},
+		},
+		AddressLists: yamlConfig.AddressLists{{Name: "exchanges"}},
+		Subscriptions: yamlConfig.Subscriptions{
+			{
+				Name:     "name",
+				Reporter: "test",
+				ChainSubscriptions: yamlConfig.ChainSubscriptions{
+					{Chain: "chain"},
+				},
+			},
+		},
+	}
+	require.Error(t, config.Validate())
+}
+
+func TestYamlConfigValidAddressList(t *testing.T) {
+	t.Parallel()
+
+	config := yamlConfig.YamlConfig{
+		Chains: yamlConfig.Chains{
+			{
+				Name:            "chain",
+				ChainID:         "chain-id",
+				TendermintNodes: []string{"node"},
+				APINodes:        []string{"node"},
+				Queries:         []string{"event.key = 'value'"},
+				Mode:            "websocket",
+				ApiType:         "rest",
+			},
+		},
+		Reporters: yamlConfig.Reporters{
+			{
+				Name: "test",
+				Type: "telegram",
+				TelegramConfig: &yamlConfig.TelegramConfig{
+					Chat:   1,
+					Token:  "xxx:yyy",
+					Admins: []int64{123},
+				},
+			},
+		},
+		AddressLists: yamlConfig.AddressLists{
+			{Name: "exchanges", Addresses: []string{"address"}},
+		},
+		Subscriptions: yamlConfig.Subscriptions{
+			{
+				Name:     "name",
+				Reporter: "test",
+				ChainSubscriptions: yamlConfig.ChainSubscriptions{
+					{Chain: "chain", TxFilters: []string{"tx.signer IN @exchanges"}},
+				},
+			},
+		},
+	}
+	require.NoError(t, config.Validate())
+}
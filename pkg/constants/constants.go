@@ -1,28 +1,151 @@
 package constants
 
+import "time"
+
 type EventFilterReason string
 
 type ReporterQuery string
 
+type CacheEntryType string
+
 const (
 	PrometheusMetricsPrefix string = "cosmos_transactions_bot_"
 
 	ReporterTypeTelegram string = "telegram"
 
+	ChainModeWebsocket string = "websocket"
+	ChainModePoll      string = "poll"
+
+	ApiTypeRest string = "rest"
+	ApiTypeGrpc string = "grpc"
+
+	PriceSourceTypeCoingecko string = "coingecko"
+	PriceSourceTypeOsmosis   string = "osmosis"
+	PriceSourceTypeStatic    string = "static"
+	PriceSourceTypeJSON      string = "json"
+
+	// All prices are fetched in USD, and converted to other quote currencies if needed.
+	QuoteCurrencyUSD string = "usd"
+
+	// Historical prices are fetched for the tx time rounded to this, so the ones for txs
+	// that happened close to each other are fetched once.
+	HistoricalPriceGranularity = 5 * time.Minute
+
+	OsmosisDefaultLCD           string = "https://lcd.osmosis.zone"
+	OsmosisDefaultQuoteExponent        = 6
+
+	AddressListsReloadInterval = 30 * time.Second
+	StateSaveInterval          = 10 * time.Second
+	DeliveredHashesCount       = 100
+
+	// An API node is skipped for ApiNodeCircuitBreakerTimeout
+	// after ApiNodeFailuresThreshold failed queries in a row.
+	ApiNodeFailuresThreshold     = 3
+	ApiNodeCircuitBreakerTimeout = 1 * time.Minute
+	ApiNodeHealthSmoothingFactor = 0.2
+
+	// How many nodes of each type to take from the chain registry if they are not set in config.
+	ChainRegistryMaxNodes = 5
+
+	// How often nodes are checked for whether they have stalled.
+	NodeStallCheckInterval = 5 * time.Second
+
+	// How many reports get their additional data fetched in parallel,
+	// and how many reports can wait in each queue before receiving new ones is blocked.
+	ReportWorkersCount = 8
+	ReportQueueSize    = 100
+
+	// How many validators of a transaction are fetched in parallel when it is enriched.
+	ValidatorsPrefetchConcurrency = 5
+
+	// Messages that reporters failed to send are retried with exponential backoff,
+	// starting from OutboxRetryMinDelay and up to OutboxRetryMaxDelay between attempts,
+	// and are dropped if not sent within OutboxMaxAge.
+	OutboxRetryMinDelay   = 5 * time.Second
+	OutboxRetryMaxDelay   = 10 * time.Minute
+	OutboxMaxAge          = 24 * time.Hour
+	OutboxRetryInterval   = 1 * time.Second
+	OutboxSaveInterval    = 10 * time.Second
+	OutboxMaxShownEntries = 10
+
+	// How long to wait for a query to a node or a price source if no timeout is set for it.
+	DefaultQueryTimeout = 60 * time.Second
+
+	// How long to wait on shutdown for the reports already received to be sent,
+	// and then, after fetching data for them is cancelled, for them to be sent with what was fetched.
+	ShutdownTimeout       = 30 * time.Second
+	ShutdownCancelTimeout = 5 * time.Second
+
+	// How many entries the cache holds before the least recently used ones are evicted.
+	CacheMaxEntries = 10000
+
+	CacheEntryTypeValidator             CacheEntryType = "validator"
+	CacheEntryTypeProposal              CacheEntryType = "proposal"
+	CacheEntryTypePrice                 CacheEntryType = "price"
+	CacheEntryTypeDenomTrace            CacheEntryType = "denom_trace"
+	CacheEntryTypeIbcChannel            CacheEntryType = "ibc_channel"
+	CacheEntryTypeStakingParams         CacheEntryType = "staking_params"
+	CacheEntryTypeRewards               CacheEntryType = "rewards"
+	CacheEntryTypeCommission            CacheEntryType = "commission"
+	CacheEntryTypeCosmosDirectoryChains CacheEntryType = "cosmos_directory_chains"
+	CacheEntryTypeHistoricalPrice       CacheEntryType = "historical_price"
+
 	EventFilterReasonTxErrorNotLogged            EventFilterReason = "tx_error_not_logged"
 	EventFilterReasonNodeErrorNotLogged          EventFilterReason = "node_error_not_logged"
 	EventFilterReasonUnsupportedMsgTypeNotLogged EventFilterReason = "unsupported_msg_type_not_logged"
 	EventFilterReasonFailedTxNotLogged           EventFilterReason = "failed_tx_not_logged"
 	EventFilterReasonEmptyTxNotLogged            EventFilterReason = "empty_tx_not_logged"
+	EventFilterReasonTxFiltersNotMatched         EventFilterReason = "tx_filters_not_matched"
+	EventFilterReasonAmountFiltersNotMatched     EventFilterReason = "amount_filters_not_matched"
 
 	ReporterQueryHelp        ReporterQuery = "help"
 	ReporterQueryGetAliases  ReporterQuery = "get_aliases"
 	ReporterQuerySetAlias    ReporterQuery = "set_alias"
 	ReporterQueryNodesStatus ReporterQuery = "nodes_status"
+	ReporterQueryOutbox      ReporterQuery = "outbox"
+	ReporterQueryFlushOutbox ReporterQuery = "flush_outbox"
 )
 
+func GetChainModes() []string {
+	return []string{
+		ChainModeWebsocket,
+		ChainModePoll,
+	}
+}
+
+func GetApiTypes() []string {
+	return []string{
+		ApiTypeRest,
+		ApiTypeGrpc,
+	}
+}
+
+func GetPriceSourceTypes() []string {
+	return []string{
+		PriceSourceTypeCoingecko,
+		PriceSourceTypeOsmosis,
+		PriceSourceTypeStatic,
+		PriceSourceTypeJSON,
+	}
+}
+
 func GetReporterTypes() []string {
 	return []string{
 		ReporterTypeTelegram,
 	}
 }
+
+func GetCacheEntryTypes() []CacheEntryType {
+	return []CacheEntryType{
+		CacheEntryTypeValidator,
+		CacheEntryTypeProposal,
+		CacheEntryTypePrice,
+		CacheEntryTypeDenomTrace,
+		CacheEntryTypeIbcChannel,
+		CacheEntryTypeStakingParams,
+		CacheEntryTypeRewards,
+		CacheEntryTypeCommission,
+		CacheEntryTypeCosmosDirectoryChains,
+		CacheEntryTypeHistoricalPrice,
+	}
+}
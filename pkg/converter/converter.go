@@ -4,8 +4,13 @@ import (
 	"fmt"
 	configTypes "main/pkg/config/types"
 	"main/pkg/messages"
+	"main/pkg/registry"
 	"main/pkg/types"
+	"main/pkg/types/amount"
+	"main/pkg/types/event"
+	"main/pkg/utils"
 	"strings"
+	"time"
 
 	abciTypes "github.com/cometbft/cometbft/abci/types"
 
@@ -15,6 +20,7 @@ import (
 	jsonRpcTypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	tendermintTypes "github.com/cometbft/cometbft/types"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/gogo/protobuf/proto"
 	"github.com/rs/zerolog"
@@ -27,24 +33,23 @@ type Converter struct {
 }
 
 func NewConverter(logger *zerolog.Logger, chain *configTypes.Chain) *Converter {
-	parsers := map[string]types.MessageParser{
-		"/cosmos.authz.v1beta1.MsgExec":                               messages.ParseMsgExec,
-		"/cosmos.authz.v1beta1.MsgGrant":                              messages.ParseMsgGrant,
-		"/cosmos.authz.v1beta1.MsgRevoke":                             messages.ParseMsgRevoke,
-		"/cosmos.bank.v1beta1.MsgSend":                                messages.ParseMsgSend,
-		"/cosmos.bank.v1beta1.MsgMultiSend":                           messages.ParseMsgMultiSend,
-		"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress":          messages.ParseMsgSetWithdrawAddress,
-		"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":     messages.ParseMsgWithdrawDelegatorReward,
-		"/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission": messages.ParseMsgWithdrawValidatorCommission,
-		"/cosmos.gov.v1beta1.MsgVote":                                 messages.ParseMsgVote,
-		"/cosmos.staking.v1beta1.MsgDelegate":                         messages.ParseMsgDelegate,
-		"/cosmos.staking.v1beta1.MsgBeginRedelegate":                  messages.ParseMsgBeginRedelegate,
-		"/cosmos.staking.v1beta1.MsgUndelegate":                       messages.ParseMsgUndelegate,
-		"/ibc.applications.transfer.v1.MsgTransfer":                   messages.ParseMsgTransfer,
-		"/ibc.core.channel.v1.MsgAcknowledgement":                     messages.ParseMsgAcknowledgement,
-		"/ibc.core.channel.v1.MsgRecvPacket":                          messages.ParseMsgRecvPacket,
-		"/ibc.core.channel.v1.MsgTimeout":                             messages.ParseMsgTimeout,
-		"/ibc.core.client.v1.MsgUpdateClient":                         messages.ParseMsgUpdateClient,
+	parsers := make(map[string]types.MessageParser)
+
+	for _, typeURL := range registry.GetTypeURLs() {
+		if !chain.Parsers.IsEnabled(typeURL) {
+			continue
+		}
+
+		parser, _ := registry.GetParser(typeURL)
+		parsers[typeURL] = parser
+	}
+
+	// Overrides are applied after enabling/disabling parsers, so it is possible
+	// to use a parser registered for one type URL to parse messages of the other one.
+	for typeURL, parserName := range chain.Parsers.Overrides {
+		if parser, ok := registry.GetParser(parserName); ok {
+			parsers[typeURL] = parser
+		}
 	}
 
 	return &Converter{
@@ -89,6 +94,10 @@ func (c *Converter) ParseEvent(event jsonRpcTypes.RPCResponse, nodeURL string) t
 		Str("values", fmt.Sprintf("%+v", resultEvent.Events)).
 		Msg("Event values")
 
+	if header, ok := resultEvent.Data.(tendermintTypes.EventDataNewBlockHeader); ok {
+		return &types.NewBlock{Height: header.Header.Height}
+	}
+
 	eventDataTx, ok := resultEvent.Data.(tendermintTypes.EventDataTx)
 	if !ok {
 		c.Logger.Debug().Msg("Could not convert tx result to EventDataTx.")
@@ -113,7 +122,41 @@ func (c *Converter) ParseEvent(event jsonRpcTypes.RPCResponse, nodeURL string) t
 		Str("node", nodeURL).
 		Msg("Got transaction")
 
-	return c.ParseTx(txProto, txResult, txHash)
+	parsedTx := c.ParseTx(txProto, txResult, txHash)
+	if parsedTx == nil {
+		return nil
+	}
+
+	// txs are sent via websocket as soon as their block is committed,
+	// so the block time is the current time
+	parsedTx.Time = time.Now()
+	return parsedTx
+}
+
+// ParseResultTx parses a transaction returned by /tx_search, which is used
+// to backfill transactions missed while the websocket was disconnected.
+// Its Time is not known from the response, so it's left empty for callers to set.
+func (c *Converter) ParseResultTx(resultTx *coreTypes.ResultTx) *types.Tx {
+	var txProto tx.Tx
+
+	if err := proto.Unmarshal(resultTx.Tx, &txProto); err != nil {
+		c.Logger.Error().Err(err).Str("hash", resultTx.Hash.String()).Msg("Could not parse tx")
+		return nil
+	}
+
+	txResult := abciTypes.TxResult{
+		Height: resultTx.Height,
+		Index:  resultTx.Index,
+		Tx:     resultTx.Tx,
+		Result: resultTx.TxResult,
+	}
+
+	parsedTx := c.ParseTx(txProto, txResult, resultTx.Hash.String())
+	if parsedTx != nil {
+		parsedTx.Backfilled = true
+	}
+
+	return parsedTx
 }
 
 func (c *Converter) ParseTx(txProto tx.Tx, txResult abciTypes.TxResult, txHash string) *types.Tx {
@@ -136,14 +179,44 @@ func (c *Converter) ParseTx(txProto tx.Tx, txResult abciTypes.TxResult, txHash s
 	return &types.Tx{
 		Hash:          c.Chain.GetTransactionLink(txHash),
 		Height:        c.Chain.GetBlockLink(txResult.Height),
+		Chain:         c.Chain,
 		Memo:          txProto.GetBody().GetMemo(),
 		Messages:      txMessages,
 		MessagesCount: len(txProto.GetBody().GetMessages()),
 		Code:          txResult.Result.Code,
 		Log:           txResult.Result.Log,
+		Fee:           utils.Map(txProto.GetAuthInfo().GetFee().GetAmount(), amount.AmountFrom),
+		GasWanted:     txResult.Result.GasWanted,
+		GasUsed:       txResult.Result.GasUsed,
+		Signers:       c.GetSigners(txResult.Result.Events),
+		Events:        event.FromAbciEvents(txResult.Result.Events),
 	}
 }
 
+// GetSigners returns tx signers' addresses. They are taken from events emitted by the ante handler,
+// as we cannot get addresses from signers' pubkeys without knowing the bech32 prefix.
+func (c *Converter) GetSigners(events []abciTypes.Event) []string {
+	signers := make([]string, 0)
+
+	for _, abciEvent := range events {
+		if abciEvent.Type != cosmosTypes.EventTypeTx {
+			continue
+		}
+
+		for _, attribute := range abciEvent.Attributes {
+			if attribute.Key != cosmosTypes.AttributeKeyAccountSequence {
+				continue
+			}
+
+			// acc_seq is formatted as "<address>/<sequence>"
+			signer, _, _ := strings.Cut(attribute.Value, "/")
+			signers = append(signers, signer)
+		}
+	}
+
+	return signers
+}
+
 func (c *Converter) ParseMessage(
 	message *codecTypes.Any,
 	height int64,
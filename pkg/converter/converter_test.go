@@ -82,6 +82,22 @@ func TestConverterErrorConvert(t *testing.T) {
 	require.Nil(t, result)
 }
 
+func TestConverterNewBlockHeader(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	chain := &configTypes.Chain{Name: "chain"}
+	converter := converterPkg.NewConverter(logger, chain)
+
+	event := jsonRpcTypes.RPCResponse{
+		Result: []byte("{\"data\":{\"type\":\"tendermint/event/NewBlockHeader\",\"value\":{\"header\":{\"height\":\"123\"}}}}"),
+	}
+	result := converter.ParseEvent(event, "example")
+	require.NotNil(t, result)
+	require.IsType(t, &types.NewBlock{}, result)
+	require.Equal(t, int64(123), result.(*types.NewBlock).Height)
+}
+
 func TestConverterErrorUnmarshal(t *testing.T) {
 	t.Parallel()
 
@@ -215,3 +231,79 @@ func TestConverterAllMessageSkipped(t *testing.T) {
 	result := converter.ParseTx(txProto, txResult, "hash")
 	require.Nil(t, result)
 }
+
+func TestConverterDisabledParser(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	chain := &configTypes.Chain{
+		Name:    "chain",
+		Parsers: configTypes.ParsersConfig{Disabled: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+	}
+	converter := converterPkg.NewConverter(logger, chain)
+
+	msgSend := &cosmosBankTypes.MsgSend{}
+	bytes, err := msgSend.Marshal()
+	require.NoError(t, err)
+
+	message := &codecTypes.Any{
+		TypeUrl: "/cosmos.bank.v1beta1.MsgSend",
+		Value:   bytes,
+	}
+	result := converter.ParseMessage(message, 123)
+	require.NotNil(t, result)
+	require.IsType(t, &messages.MsgUnsupportedMessage{}, result)
+}
+
+func TestConverterOverriddenParser(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	chain := &configTypes.Chain{
+		Name: "chain",
+		Parsers: configTypes.ParsersConfig{
+			Overrides: map[string]string{"/custom.bank.v1.MsgSend": "/cosmos.bank.v1beta1.MsgSend"},
+		},
+	}
+	converter := converterPkg.NewConverter(logger, chain)
+
+	msgSend := &cosmosBankTypes.MsgSend{}
+	bytes, err := msgSend.Marshal()
+	require.NoError(t, err)
+
+	message := &codecTypes.Any{
+		TypeUrl: "/custom.bank.v1.MsgSend",
+		Value:   bytes,
+	}
+	result := converter.ParseMessage(message, 123)
+	require.NotNil(t, result)
+	require.IsType(t, &messages.MsgSend{}, result)
+}
+
+func TestConverterGetSigners(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	chain := &configTypes.Chain{Name: "chain"}
+	converter := converterPkg.NewConverter(logger, chain)
+
+	signers := converter.GetSigners([]abciTypes.Event{
+		{
+			Type:       "transfer",
+			Attributes: []abciTypes.EventAttribute{{Key: "acc_seq", Value: "wrong/1"}},
+		},
+		{
+			Type: "tx",
+			Attributes: []abciTypes.EventAttribute{
+				{Key: "fee", Value: "100uatom"},
+				{Key: "acc_seq", Value: "signer1/1"},
+			},
+		},
+		{
+			Type:       "tx",
+			Attributes: []abciTypes.EventAttribute{{Key: "acc_seq", Value: "signer2/2"}},
+		},
+	})
+
+	require.Equal(t, []string{"signer1", "signer2"}, signers)
+}
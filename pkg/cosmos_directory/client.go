@@ -1,6 +1,9 @@
 package cosmos_directory
 
 import (
+	"context"
+	"fmt"
+	"main/pkg/config"
 	"main/pkg/http"
 	"main/pkg/metrics"
 	"main/pkg/types/query_info"
@@ -15,19 +18,23 @@ type Client struct {
 	MetricsManager *metrics.Manager
 }
 
-func NewClient(logger *zerolog.Logger, metricsManager *metrics.Manager) *Client {
+func NewClient(
+	logger *zerolog.Logger,
+	metricsManager *metrics.Manager,
+	timeouts config.TimeoutsConfig,
+) *Client {
 	return &Client{
 		Logger: logger.With().
 			Str("component", "cosmos_directory_client").
 			Logger(),
-		Client:         http.NewClient(logger, "https://chains.cosmos.directory", "cosmos.directory"),
+		Client:         http.NewClient(logger, "https://chains.cosmos.directory", "cosmos.directory", timeouts),
 		MetricsManager: metricsManager,
 	}
 }
 
-func (c *Client) GetAllChains() (responses.CosmosDirectoryChains, error) {
+func (c *Client) GetAllChains(ctx context.Context) (responses.CosmosDirectoryChains, error) {
 	var response *responses.CosmosDirectoryChainsResponse
-	err, queryInfo := c.Client.Get("/", &response)
+	err, queryInfo := c.Client.Get(ctx, "/", &response, query_info.QueryTypeChainsList)
 	c.MetricsManager.LogQuery("cosmos.directory", queryInfo, query_info.QueryTypeChainsList)
 
 	if err != nil {
@@ -36,3 +43,19 @@ func (c *Client) GetAllChains() (responses.CosmosDirectoryChains, error) {
 
 	return response.Chains, nil
 }
+
+func (c *Client) GetChain(ctx context.Context, name string) (*responses.CosmosDirectoryChain, error) {
+	var response *responses.CosmosDirectoryChainResponse
+	err, queryInfo := c.Client.Get(ctx, "/"+name, &response, query_info.QueryTypeChainInfo)
+	c.MetricsManager.LogQuery("cosmos.directory", queryInfo, query_info.QueryTypeChainInfo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || response.Chain.ChainID == "" {
+		return nil, fmt.Errorf("chain %s is not found in the chain registry", name)
+	}
+
+	return &response.Chain, nil
+}
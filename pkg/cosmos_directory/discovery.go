@@ -0,0 +1,155 @@
+package cosmos_directory
+
+import (
+	"context"
+	"fmt"
+	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
+	"main/pkg/types/responses"
+	"strings"
+)
+
+// DiscoverChains fills nodes, denoms and explorer links omitted in the config with the ones
+// from the chain registry, for all chains that have chain-registry-name set.
+// If the chain registry cannot be reached, the chains are used as they are in the config,
+// and it only fails for the chains that cannot work without the data from it.
+func (c *Client) DiscoverChains(ctx context.Context, chains configTypes.Chains) error {
+	for _, chain := range chains {
+		if chain.ChainRegistryName == "" {
+			continue
+		}
+
+		registryChain, err := c.GetChain(ctx, chain.ChainRegistryName)
+		if err != nil {
+			if checkErr := CheckChain(chain); checkErr != nil {
+				return fmt.Errorf(
+					"error fetching chain %s from chain registry: %s, and it cannot be used without it: %s",
+					chain.Name,
+					err,
+					checkErr,
+				)
+			}
+
+			c.Logger.Warn().
+				Err(err).
+				Str("chain", chain.Name).
+				Msg("Could not fetch chain from chain registry, using the config only")
+			continue
+		}
+
+		if err := PopulateChain(chain, registryChain); err != nil {
+			return fmt.Errorf("error in chain %s: %s", chain.Name, err)
+		}
+
+		c.Logger.Info().
+			Str("chain", chain.Name).
+			Str("chain_id", chain.ChainID).
+			Int("tendermint_nodes", len(chain.TendermintNodes)).
+			Int("api_nodes", len(chain.APINodes)).
+			Int("grpc_nodes", len(chain.GrpcNodes)).
+			Int("denoms", len(chain.Denoms)).
+			Msg("Loaded chain info from chain registry")
+	}
+
+	return nil
+}
+
+// PopulateChain sets the chain's fields that are not set in config from the chain registry.
+// The values from config always take precedence.
+func PopulateChain(chain *configTypes.Chain, registryChain *responses.CosmosDirectoryChain) error {
+	if chain.ChainID == "" {
+		if registryChain.ChainID == "" {
+			return fmt.Errorf("no chain-id provided and none found in the chain registry")
+		}
+
+		chain.ChainID = registryChain.ChainID
+	} else if chain.ChainID != registryChain.ChainID {
+		return fmt.Errorf(
+			"chain-id %s does not match the chain registry one, %s",
+			chain.ChainID,
+			registryChain.ChainID,
+		)
+	}
+
+	if chain.PrettyName == "" {
+		chain.PrettyName = registryChain.PrettyName
+	}
+
+	if len(chain.TendermintNodes) == 0 {
+		chain.TendermintNodes = limitNodes(registryChain.GetRPCNodes())
+	}
+
+	if chain.IsUsingGrpc() && len(chain.GrpcNodes) == 0 {
+		grpcNodes := limitNodes(registryChain.GetGrpcNodes())
+		chain.GrpcNodes = make([]string, len(grpcNodes))
+
+		for index, node := range grpcNodes {
+			// chain registry gRPC addresses usually have no scheme, but the ones on 443 port use TLS
+			if strings.HasSuffix(node, ":443") && !strings.Contains(node, "://") {
+				node = "https://" + node
+			}
+
+			chain.GrpcNodes[index] = node
+		}
+	}
+
+	if !chain.IsUsingGrpc() && len(chain.APINodes) == 0 {
+		chain.APINodes = limitNodes(registryChain.GetRestNodes())
+	}
+
+	if err := CheckChainNodes(chain); err != nil {
+		return fmt.Errorf("%s and none found in the chain registry", err)
+	}
+
+	if len(chain.Denoms) == 0 {
+		chain.Denoms = registryChain.GetDenomInfos()
+	}
+
+	if chain.Explorer == nil && chain.SupportedExplorer == nil {
+		supportedExplorer, explorer := registryChain.GetExplorer()
+		if supportedExplorer != nil {
+			chain.SupportedExplorer = supportedExplorer
+			chain.Explorer = supportedExplorer.ToExplorer()
+		} else {
+			chain.Explorer = explorer
+		}
+	}
+
+	return nil
+}
+
+// CheckChain returns an error if the chain does not have the data it needs from the chain registry:
+// the chain-id and the nodes.
+func CheckChain(chain *configTypes.Chain) error {
+	if chain.ChainID == "" {
+		return fmt.Errorf("no chain-id provided")
+	}
+
+	return CheckChainNodes(chain)
+}
+
+// CheckChainNodes returns an error if the chain does not have the nodes it needs to work:
+// Tendermint nodes, and either gRPC or API nodes, depending on the API type.
+func CheckChainNodes(chain *configTypes.Chain) error {
+	if len(chain.TendermintNodes) == 0 {
+		return fmt.Errorf("no Tendermint nodes provided")
+	}
+
+	if chain.IsUsingGrpc() && len(chain.GrpcNodes) == 0 {
+		return fmt.Errorf("no gRPC nodes provided")
+	}
+
+	if !chain.IsUsingGrpc() && len(chain.APINodes) == 0 {
+		return fmt.Errorf("no API nodes provided")
+	}
+
+	return nil
+}
+
+func limitNodes(nodes []string) []string {
+	if len(nodes) > constants.ChainRegistryMaxNodes {
+		return nodes[:constants.ChainRegistryMaxNodes]
+	}
+
+	return nodes
+}
@@ -0,0 +1,259 @@
+package cosmos_directory_test
+
+import (
+	"context"
+	"main/assets"
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	"main/pkg/cosmos_directory"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types/responses"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func getClient() *cosmos_directory.Client {
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	return cosmos_directory.NewClient(logger, metricsManager, configPkg.TimeoutsConfig{})
+}
+
+func getRegistryChain() *responses.CosmosDirectoryChain {
+	return &responses.CosmosDirectoryChain{
+		ChainID:    "cosmoshub-4",
+		PrettyName: "Cosmos Hub",
+		BestApis: responses.CosmosDirectoryApis{
+			RPC:  responses.CosmosDirectoryApiList{{Address: "https://rpc.com"}},
+			Rest: responses.CosmosDirectoryApiList{{Address: "https://rest.com"}},
+		},
+		Apis: responses.CosmosDirectoryApis{
+			Grpc: responses.CosmosDirectoryApiList{{Address: "grpc.com:443"}, {Address: "grpc.com:9090"}},
+		},
+		Explorers: []responses.CosmosDirectoryExplorer{
+			{Kind: "mintscan", URL: "https://www.mintscan.io/cosmos"},
+		},
+		Assets: []responses.CosmosDirectoryAsset{
+			{
+				Denom:       "uatom",
+				CoingeckoID: "cosmos",
+				Base:        responses.CosmosDirectoryAssetDenomInfo{Denom: "uatom"},
+				Display:     responses.CosmosDirectoryAssetDenomInfo{Denom: "atom", Exponent: 6},
+			},
+		},
+	}
+}
+
+func TestPopulateChainEmpty(t *testing.T) {
+	t.Parallel()
+
+	chain := &configTypes.Chain{Name: "chain", ChainRegistryName: "cosmoshub"}
+	err := cosmos_directory.PopulateChain(chain, getRegistryChain())
+	require.NoError(t, err)
+
+	require.Equal(t, "cosmoshub-4", chain.ChainID)
+	require.Equal(t, "Cosmos Hub", chain.PrettyName)
+	require.Equal(t, []string{"https://rpc.com"}, chain.TendermintNodes)
+	require.Equal(t, []string{"https://rest.com"}, chain.APINodes)
+	require.Empty(t, chain.GrpcNodes)
+	require.Len(t, chain.Denoms, 1)
+	require.Equal(t, "atom", chain.Denoms[0].DisplayDenom)
+	require.Equal(t, 6, chain.Denoms[0].DenomExponent)
+	require.Equal(t, "cosmos", chain.Denoms[0].CoingeckoCurrency)
+	require.IsType(t, &configTypes.MintscanExplorer{}, chain.SupportedExplorer)
+	require.Equal(t, "https://mintscan.io/cosmos/tx/%s", chain.Explorer.TransactionLinkPattern)
+}
+
+func TestPopulateChainGrpc(t *testing.T) {
+	t.Parallel()
+
+	chain := &configTypes.Chain{Name: "chain", ApiType: "grpc"}
+	err := cosmos_directory.PopulateChain(chain, getRegistryChain())
+	require.NoError(t, err)
+
+	require.Empty(t, chain.APINodes)
+	require.Equal(t, []string{"https://grpc.com:443", "grpc.com:9090"}, chain.GrpcNodes)
+}
+
+func TestPopulateChainConfigTakesPrecedence(t *testing.T) {
+	t.Parallel()
+
+	chain := &configTypes.Chain{
+		Name:            "chain",
+		ChainID:         "cosmoshub-4",
+		PrettyName:      "Hub",
+		TendermintNodes: []string{"https://my-rpc.com"},
+		APINodes:        []string{"https://my-rest.com"},
+		Denoms:          configTypes.DenomInfos{{Denom: "uatom", DisplayDenom: "ATOM"}},
+		Explorer:        &configTypes.Explorer{TransactionLinkPattern: "https://explorer.com/tx/%s"},
+	}
+	err := cosmos_directory.PopulateChain(chain, getRegistryChain())
+	require.NoError(t, err)
+
+	require.Equal(t, "Hub", chain.PrettyName)
+	require.Equal(t, []string{"https://my-rpc.com"}, chain.TendermintNodes)
+	require.Equal(t, []string{"https://my-rest.com"}, chain.APINodes)
+	require.Equal(t, "ATOM", chain.Denoms[0].DisplayDenom)
+	require.Nil(t, chain.SupportedExplorer)
+	require.Equal(t, "https://explorer.com/tx/%s", chain.Explorer.TransactionLinkPattern)
+}
+
+func TestPopulateChainErrors(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, cosmos_directory.PopulateChain(
+		&configTypes.Chain{Name: "chain", ChainID: "other-chain"},
+		getRegistryChain(),
+	))
+
+	require.Error(t, cosmos_directory.PopulateChain(
+		&configTypes.Chain{Name: "chain"},
+		&responses.CosmosDirectoryChain{ChainID: "chain"},
+	))
+
+	require.Error(t, cosmos_directory.PopulateChain(
+		&configTypes.Chain{Name: "chain"},
+		&responses.CosmosDirectoryChain{},
+	))
+
+	require.Error(t, cosmos_directory.PopulateChain(
+		&configTypes.Chain{Name: "chain", TendermintNodes: []string{"https://rpc.com"}},
+		&responses.CosmosDirectoryChain{ChainID: "chain"},
+	))
+
+	require.Error(t, cosmos_directory.PopulateChain(
+		&configTypes.Chain{Name: "chain", TendermintNodes: []string{"https://rpc.com"}, ApiType: "grpc"},
+		&responses.CosmosDirectoryChain{ChainID: "chain"},
+	))
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestDiscoverChainsOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://chains.cosmos.directory/cosmoshub",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("cosmos-directory-chain.json")),
+	)
+
+	chains := configTypes.Chains{
+		{Name: "chain", ChainRegistryName: "cosmoshub"},
+		{Name: "chain2", ChainID: "chain-id"},
+	}
+
+	err := getClient().DiscoverChains(context.Background(), chains)
+	require.NoError(t, err)
+
+	require.Equal(t, "cosmoshub-4", chains[0].ChainID)
+	require.Equal(t, []string{"https://rpc-cosmoshub.example.com"}, chains[0].TendermintNodes)
+	require.Equal(t, []string{"https://rest-cosmoshub.example.com"}, chains[0].APINodes)
+	require.Len(t, chains[0].Denoms, 1)
+	require.IsType(t, &configTypes.MintscanExplorer{}, chains[0].SupportedExplorer)
+	require.Empty(t, chains[1].TendermintNodes)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestDiscoverChainsNotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://chains.cosmos.directory/unknown",
+		httpmock.NewBytesResponder(200, []byte("{}")),
+	)
+
+	err := getClient().DiscoverChains(context.Background(), configTypes.Chains{{Name: "chain", ChainRegistryName: "unknown"}})
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestDiscoverChainsQueryError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	err := getClient().DiscoverChains(context.Background(), configTypes.Chains{{Name: "chain", ChainRegistryName: "cosmoshub"}})
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestDiscoverChainsQueryErrorConfigured(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	chains := configTypes.Chains{
+		{
+			Name:              "chain",
+			ChainID:           "cosmoshub-4",
+			ChainRegistryName: "cosmoshub",
+			TendermintNodes:   []string{"https://rpc.com"},
+			APINodes:          []string{"https://rest.com"},
+		},
+	}
+
+	// chain has everything it needs in the config, so it works without the chain registry
+	err := getClient().DiscoverChains(context.Background(), chains)
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://rpc.com"}, chains[0].TendermintNodes)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestDiscoverChainsQueryErrorNoChainID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	chains := configTypes.Chains{
+		{
+			Name:              "chain",
+			ChainRegistryName: "cosmoshub",
+			TendermintNodes:   []string{"https://rpc.com"},
+			APINodes:          []string{"https://rest.com"},
+		},
+	}
+
+	// chain-id could only be taken from the chain registry
+	err := getClient().DiscoverChains(context.Background(), chains)
+	require.ErrorContains(t, err, "no chain-id provided")
+}
+
+func TestCheckChain(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, cosmos_directory.CheckChain(&configTypes.Chain{
+		TendermintNodes: []string{"https://rpc.com"},
+		APINodes:        []string{"https://rest.com"},
+	}))
+	require.Error(t, cosmos_directory.CheckChain(&configTypes.Chain{ChainID: "chain-id"}))
+	require.NoError(t, cosmos_directory.CheckChain(&configTypes.Chain{
+		ChainID:         "chain-id",
+		TendermintNodes: []string{"https://rpc.com"},
+		APINodes:        []string{"https://rest.com"},
+	}))
+}
+
+func TestCheckChainNodes(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, cosmos_directory.CheckChainNodes(&configTypes.Chain{}))
+	require.Error(t, cosmos_directory.CheckChainNodes(&configTypes.Chain{
+		TendermintNodes: []string{"https://rpc.com"},
+	}))
+	require.Error(t, cosmos_directory.CheckChainNodes(&configTypes.Chain{
+		TendermintNodes: []string{"https://rpc.com"},
+		APINodes:        []string{"https://rest.com"},
+		ApiType:         "grpc",
+	}))
+	require.NoError(t, cosmos_directory.CheckChainNodes(&configTypes.Chain{
+		TendermintNodes: []string{"https://rpc.com"},
+		APINodes:        []string{"https://rest.com"},
+	}))
+	require.NoError(t, cosmos_directory.CheckChainNodes(&configTypes.Chain{
+		TendermintNodes: []string{"https://rpc.com"},
+		GrpcNodes:       []string{"grpc.com:9090"},
+		ApiType:         "grpc",
+	}))
+}
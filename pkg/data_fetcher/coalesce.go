@@ -0,0 +1,45 @@
+package data_fetcher
+
+import (
+	"context"
+	"errors"
+	"main/pkg/types/query_info"
+)
+
+var errNotFound = errors.New("not found")
+
+// coalesce runs fetch once for all the concurrent callers with the same key, so the same data
+// requested while enriching multiple reports at once is only fetched once. The fetch is not tied
+// to any of the callers, as they share it, and is limited by the query timeout instead, while
+// each caller stops waiting for it once its own context is done.
+func coalesce[T any](
+	ctx context.Context,
+	f *DataFetcher,
+	key string,
+	queryType query_info.QueryType,
+	fetch func(ctx context.Context) (T, bool),
+) (T, bool) {
+	resultChannel := f.Requests.DoChan(key, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(
+			context.Background(),
+			f.Config.Timeouts.GetQueryTimeout(queryType),
+		)
+		defer cancel()
+
+		value, found := fetch(fetchCtx)
+		if !found {
+			return value, errNotFound
+		}
+
+		return value, nil
+	})
+
+	select {
+	case result := <-resultChannel:
+		value, ok := result.Val.(T)
+		return value, ok && result.Err == nil
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	}
+}
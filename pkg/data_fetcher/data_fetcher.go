@@ -7,22 +7,30 @@ import (
 	configTypes "main/pkg/config/types"
 	cosmosDirectoryPkg "main/pkg/cosmos_directory"
 	"main/pkg/metrics"
+	"main/pkg/node_health"
 	"main/pkg/tendermint/api"
-	priceFetchers "main/pkg/types"
+	"main/pkg/tendermint/grpc"
+	"main/pkg/types"
+	"sync"
 
 	"github.com/rs/zerolog"
+	"golang.org/x/sync/singleflight"
 )
 
 type DataFetcher struct {
 	Logger                zerolog.Logger
 	Cache                 *cache.Cache
 	Config                *configPkg.AppConfig
-	PriceFetchers         map[string]priceFetchers.PriceFetcher
+	PriceFetchers         map[string]types.PriceFetcher
+	priceFetchersMutex    sync.Mutex
 	AliasManager          *alias_manager.AliasManager
 	MetricsManager        *metrics.Manager
 	CosmosDirectoryClient *cosmosDirectoryPkg.Client
 
-	TendermintApiClients map[string][]*api.TendermintApiClient
+	// Requests coalesces concurrent requests for the same data.
+	Requests singleflight.Group
+
+	TendermintApiClients map[string]node_health.TrackedApiClients
 }
 
 func NewDataFetcher(
@@ -31,14 +39,26 @@ func NewDataFetcher(
 	aliasManager *alias_manager.AliasManager,
 	metricsManager *metrics.Manager,
 ) *DataFetcher {
-	tendermintApiClients := make(map[string][]*api.TendermintApiClient, len(config.Chains))
+	tendermintApiClients := make(map[string]node_health.TrackedApiClients, len(config.Chains))
 	for _, chain := range config.Chains {
-		tendermintApiClients[chain.Name] = make([]*api.TendermintApiClient, len(chain.APINodes))
-		for index, node := range chain.APINodes {
-			tendermintApiClients[chain.Name][index] = api.NewTendermintApiClient(
-				logger,
+		nodes := chain.APINodes
+		if chain.IsUsingGrpc() {
+			nodes = chain.GrpcNodes
+		}
+
+		tendermintApiClients[chain.Name] = make(node_health.TrackedApiClients, len(nodes))
+		for index, node := range nodes {
+			var client types.ApiClient
+			if chain.IsUsingGrpc() {
+				client = grpc.NewTendermintGrpcClient(logger, node, chain, metricsManager, config.Timeouts)
+			} else {
+				client = api.NewTendermintApiClient(logger, node, chain, metricsManager, config.Timeouts)
+			}
+
+			tendermintApiClients[chain.Name][index] = node_health.NewTrackedApiClient(
+				client,
+				chain.Name,
 				node,
-				chain,
 				metricsManager,
 			)
 		}
@@ -48,16 +68,26 @@ func NewDataFetcher(
 		Logger: logger.With().
 			Str("component", "data_fetcher").
 			Logger(),
-		Cache:                 cache.NewCache(),
-		PriceFetchers:         map[string]priceFetchers.PriceFetcher{},
+		Cache:                 cache.NewCache(logger, metricsManager),
+		PriceFetchers:         map[string]types.PriceFetcher{},
 		Config:                config,
 		TendermintApiClients:  tendermintApiClients,
 		AliasManager:          aliasManager,
 		MetricsManager:        metricsManager,
-		CosmosDirectoryClient: cosmosDirectoryPkg.NewClient(logger, metricsManager),
+		CosmosDirectoryClient: cosmosDirectoryPkg.NewClient(logger, metricsManager, config.Timeouts),
 	}
 }
 
+// GetApiClients returns the chain API clients to query, the healthiest ones first,
+// skipping the ones that failed recently.
+func (f *DataFetcher) GetApiClients(chainName string) []types.ApiClient {
+	return f.TendermintApiClients[chainName].GetAvailable()
+}
+
+func (f *DataFetcher) GetApiNodesStatuses(chainName string) map[string]types.ApiNodeStatus {
+	return f.TendermintApiClients[chainName].GetStatuses()
+}
+
 func (f *DataFetcher) FindChainById(
 	chainID string,
 ) (*configTypes.Chain, bool) {
@@ -1,35 +1,33 @@
 package data_fetcher
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types/responses"
-	"strconv"
 )
 
 func (f *DataFetcher) GetCommissionAtBlock(
+	ctx context.Context,
 	chain *configTypes.Chain,
 	validator string,
 	block int64,
 ) ([]responses.Commission, bool) {
-	keyName := chain.Name + "_commission_" + validator + "_" + strconv.FormatInt(block, 10)
-
-	if cachedCommission, cachedCommissionPresent := f.Cache.Get(keyName); cachedCommissionPresent {
-		if cachedCommissionParsed, ok := cachedCommission.([]responses.Commission); ok {
-			return cachedCommissionParsed, true
-		}
-
-		f.Logger.Error().Msg("Could not convert cached commission to []responses.Commission")
-		return []responses.Commission{}, false
+	if cachedCommission, cachedCommissionPresent := f.Cache.GetCommission(chain.Name, validator, block); cachedCommissionPresent {
+		return cachedCommission, true
 	}
 
-	for _, node := range f.TendermintApiClients[chain.Name] {
-		notCachedEntry, err := node.GetValidatorCommissionAtBlock(validator, block-1)
+	for _, node := range f.GetApiClients(chain.Name) {
+		notCachedEntry, err := node.GetValidatorCommissionAtBlock(ctx, validator, block-1)
 		if err != nil {
 			f.Logger.Error().Err(err).Msg("Error fetching commission")
+			if ctx.Err() != nil {
+				break
+			}
+
 			continue
 		}
 
-		f.Cache.Set(keyName, notCachedEntry)
+		f.Cache.SetCommission(chain.Name, validator, block, notCachedEntry)
 		return notCachedEntry, true
 	}
 
@@ -1,10 +1,12 @@
 package data_fetcher
 
 import (
+	"context"
 	"main/assets"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -31,11 +33,11 @@ func TestDataFetcherFetchCosmosDirectoryChainsCachedOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("cosmos_directory_chains", responses.CosmosDirectoryChains{
+	dataFetcher.Cache.SetCosmosDirectoryChains(responses.CosmosDirectoryChains{
 		{ChainID: "chain"},
 	})
 
-	data, fetched := dataFetcher.GetCosmosDirectoryChains()
+	data, fetched := dataFetcher.GetCosmosDirectoryChains(context.Background())
 	require.True(t, fetched)
 	require.Len(t, data, 1)
 	require.Equal(t, "chain", data[0].ChainID)
@@ -57,9 +59,9 @@ func TestDataFetcherFetchCosmosDirectoryChainsCachedNotOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("cosmos_directory_chains", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeCosmosDirectoryChains, "", nil)
 
-	data, fetched := dataFetcher.GetCosmosDirectoryChains()
+	data, fetched := dataFetcher.GetCosmosDirectoryChains(context.Background())
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -82,7 +84,7 @@ func TestDataFetcherFetchCosmosDirectoryChainsAllQueriesFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetCosmosDirectoryChains()
+	data, fetched := dataFetcher.GetCosmosDirectoryChains(context.Background())
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -111,7 +113,7 @@ func TestDataFetcherFetchCosmosDirectoryChainsSuccessfullyFetched(t *testing.T)
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetCosmosDirectoryChains()
+	data, fetched := dataFetcher.GetCosmosDirectoryChains(context.Background())
 	require.True(t, fetched)
 	require.Len(t, data, 1)
 	require.Equal(t, "eightball-1", data[0].ChainID)
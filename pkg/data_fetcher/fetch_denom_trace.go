@@ -1,6 +1,7 @@
 package data_fetcher
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 )
 
 func (f *DataFetcher) GetDenomTrace(
+	ctx context.Context,
 	chain *configTypes.Chain,
 	denom string,
 ) (*transferTypes.DenomTrace, bool) {
@@ -17,28 +19,31 @@ func (f *DataFetcher) GetDenomTrace(
 		return nil, false
 	}
 
-	denomHash := denomSplit[1]
-
-	keyName := chain.Name + "_denom_trace_" + denomHash
+	// denom traces from config are used as is, so they would work even if nodes are down
+	if path, ok := chain.IbcDenoms[denom]; ok {
+		trace := transferTypes.ParseDenomTrace(path)
+		return &trace, true
+	}
 
-	if cachedEntry, cachedEntryPresent := f.Cache.Get(keyName); cachedEntryPresent {
-		if cachedEntryParsed, ok := cachedEntry.(*transferTypes.DenomTrace); ok {
-			return cachedEntryParsed, true
-		}
+	denomHash := denomSplit[1]
 
-		f.Logger.Error().Msg("Could not convert cached staking params to *types.DenomTrace")
-		return nil, false
+	if cachedEntry, cachedEntryPresent := f.Cache.GetDenomTrace(chain.Name, denomHash); cachedEntryPresent {
+		return cachedEntry, true
 	}
 
-	for _, node := range f.TendermintApiClients[chain.Name] {
-		notCachedEntry, err := node.GetIbcDenomTrace(denomHash)
+	for _, node := range f.GetApiClients(chain.Name) {
+		notCachedEntry, err := node.GetIbcDenomTrace(ctx, denomHash)
 
 		if err != nil {
 			f.Logger.Error().Err(err).Msg("Error fetching IBC denom trace")
+			if ctx.Err() != nil {
+				break
+			}
+
 			continue
 		}
 
-		f.Cache.Set(keyName, notCachedEntry)
+		f.Cache.SetDenomTrace(chain.Name, denomHash, notCachedEntry)
 		return notCachedEntry, true
 	}
 
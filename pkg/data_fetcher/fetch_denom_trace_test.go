@@ -1,10 +1,12 @@
 package data_fetcher
 
 import (
+	"context"
 	"main/assets"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -31,11 +33,36 @@ func TestDataFetcherFetchDenomTraceInvalidDenom(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetDenomTrace(config.Chains[0], "invalid")
+	data, fetched := dataFetcher.GetDenomTrace(context.Background(), config.Chains[0], "invalid")
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
 
+func TestDataFetcherFetchDenomTraceFromConfig(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{
+				Name:      "chain",
+				IbcDenoms: map[string]string{"ibc/denom": "transfer/channel-0/uatom"},
+			},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	data, fetched := dataFetcher.GetDenomTrace(context.Background(), config.Chains[0], "ibc/denom")
+	require.True(t, fetched)
+	require.Equal(t, "transfer/channel-0", data.Path)
+	require.Equal(t, "uatom", data.BaseDenom)
+}
+
 func TestDataFetcherFetchDenomTraceCachedOk(t *testing.T) {
 	t.Parallel()
 
@@ -52,11 +79,11 @@ func TestDataFetcherFetchDenomTraceCachedOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", &transferTypes.DenomTrace{
+	dataFetcher.Cache.SetDenomTrace("chain", "denom", &transferTypes.DenomTrace{
 		Path: "path",
 	})
 
-	data, fetched := dataFetcher.GetDenomTrace(config.Chains[0], "ibc/denom")
+	data, fetched := dataFetcher.GetDenomTrace(context.Background(), config.Chains[0], "ibc/denom")
 	require.True(t, fetched)
 	require.NotNil(t, data)
 	require.Equal(t, "path", data.Path)
@@ -78,9 +105,9 @@ func TestDataFetcherFetchDenomTraceCachedNotOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeDenomTrace, "chain_denom", nil)
 
-	data, fetched := dataFetcher.GetDenomTrace(config.Chains[0], "ibc/denom")
+	data, fetched := dataFetcher.GetDenomTrace(context.Background(), config.Chains[0], "ibc/denom")
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
@@ -103,7 +130,7 @@ func TestDataFetcherFetchDenomTraceAllQueriesFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetDenomTrace(config.Chains[0], "ibc/denom")
+	data, fetched := dataFetcher.GetDenomTrace(context.Background(), config.Chains[0], "ibc/denom")
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
@@ -132,7 +159,7 @@ func TestDataFetcherFetchDenomTraceSuccessfullyFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetDenomTrace(config.Chains[0], "ibc/denom")
+	data, fetched := dataFetcher.GetDenomTrace(context.Background(), config.Chains[0], "ibc/denom")
 	require.True(t, fetched)
 	require.NotNil(t, data)
 	require.Equal(t, "untrn", data.BaseDenom)
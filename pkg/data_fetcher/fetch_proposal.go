@@ -1,30 +1,28 @@
 package data_fetcher
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types/responses"
 )
 
-func (f *DataFetcher) GetProposal(chain *configTypes.Chain, id string) (*responses.Proposal, bool) {
-	keyName := chain.Name + "_proposal_" + id
-
-	if cachedEntry, cachedEntryPresent := f.Cache.Get(keyName); cachedEntryPresent {
-		if cachedEntryParsed, ok := cachedEntry.(*responses.Proposal); ok {
-			return cachedEntryParsed, true
-		}
-
-		f.Logger.Error().Msg("Could not convert cached proposal to responses.Proposal")
-		return nil, false
+func (f *DataFetcher) GetProposal(ctx context.Context, chain *configTypes.Chain, id string) (*responses.Proposal, bool) {
+	if cachedEntry, cachedEntryPresent := f.Cache.GetProposal(chain.Name, id); cachedEntryPresent {
+		return cachedEntry, true
 	}
 
-	for _, node := range f.TendermintApiClients[chain.Name] {
-		notCachedEntry, err := node.GetProposal(id)
+	for _, node := range f.GetApiClients(chain.Name) {
+		notCachedEntry, err := node.GetProposal(ctx, id)
 		if err != nil {
 			f.Logger.Error().Err(err).Msg("Error fetching proposal")
+			if ctx.Err() != nil {
+				break
+			}
+
 			continue
 		}
 
-		f.Cache.Set(keyName, notCachedEntry)
+		f.Cache.SetProposal(chain.Name, id, notCachedEntry)
 		return notCachedEntry, true
 	}
 
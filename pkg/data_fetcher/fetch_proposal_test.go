@@ -1,10 +1,12 @@
 package data_fetcher
 
 import (
+	"context"
 	"main/assets"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -31,11 +33,11 @@ func TestDataFetcherFetchProposalCachedOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_proposal_id", &responses.Proposal{
+	dataFetcher.Cache.SetProposal("chain", "id", &responses.Proposal{
 		ProposalID: "1",
 	})
 
-	data, fetched := dataFetcher.GetProposal(config.Chains[0], "id")
+	data, fetched := dataFetcher.GetProposal(context.Background(), config.Chains[0], "id")
 	require.True(t, fetched)
 	require.NotNil(t, data)
 	require.Equal(t, "1", data.ProposalID)
@@ -57,9 +59,9 @@ func TestDataFetcherFetchProposalCachedNotOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_proposal_id", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeProposal, "chain_id", nil)
 
-	data, fetched := dataFetcher.GetProposal(config.Chains[0], "id")
+	data, fetched := dataFetcher.GetProposal(context.Background(), config.Chains[0], "id")
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
@@ -82,7 +84,7 @@ func TestDataFetcherFetchProposalAllQueriesFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetProposal(config.Chains[0], "id")
+	data, fetched := dataFetcher.GetProposal(context.Background(), config.Chains[0], "id")
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
@@ -111,7 +113,7 @@ func TestDataFetcherFetchProposalSuccessfullyFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetProposal(config.Chains[0], "id")
+	data, fetched := dataFetcher.GetProposal(context.Background(), config.Chains[0], "id")
 	require.True(t, fetched)
 	require.NotNil(t, data)
 	require.Equal(t, "1", data.ProposalID)
@@ -0,0 +1,95 @@
+package data_fetcher
+
+import (
+	"context"
+	"main/pkg/constants"
+	priceFetchers "main/pkg/price_fetchers"
+	amountPkg "main/pkg/types/amount"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// GetQuoteRates returns how much of each quote currency one USD is worth, in the same order,
+// skipping the ones it could not get. A quote currency is either a denom from config, found
+// by its display denom (like ATOM), or a currency CoinGecko has exchange rates for (like EUR or BTC).
+func (f *DataFetcher) GetQuoteRates(ctx context.Context, currencies []string) []*amountPkg.QuoteRate {
+	rates := make([]*amountPkg.QuoteRate, 0, len(currencies))
+	var exchangeRates map[string]*amountPkg.QuoteRate
+
+	for _, currency := range currencies {
+		if currency == constants.QuoteCurrencyUSD {
+			rates = append(rates, &amountPkg.QuoteRate{Currency: currency, Rate: 1, Fiat: true})
+			continue
+		}
+
+		if cachedRate, cached := f.Cache.GetQuoteRate(currency); cached {
+			rates = append(rates, cachedRate)
+			continue
+		}
+
+		if rate, found := f.GetDenomQuoteRate(ctx, currency); found {
+			f.Cache.SetQuoteRate(currency, rate)
+			rates = append(rates, rate)
+			continue
+		}
+
+		// all the other currencies are taken with one query
+		if exchangeRates == nil {
+			exchangeRates = f.GetExchangeRates(ctx)
+		}
+
+		rate, found := exchangeRates[currency]
+		if !found {
+			f.Logger.Warn().Str("currency", currency).Msg("Could not get quote currency rate")
+			continue
+		}
+
+		f.Cache.SetQuoteRate(currency, rate)
+		rates = append(rates, rate)
+	}
+
+	return rates
+}
+
+// GetDenomQuoteRate returns the quote rate of a denom from config with this display denom.
+func (f *DataFetcher) GetDenomQuoteRate(ctx context.Context, currency string) (*amountPkg.QuoteRate, bool) {
+	for _, chain := range f.Config.Chains {
+		for _, denomInfo := range chain.Denoms {
+			if !strings.EqualFold(denomInfo.DisplayDenom, currency) {
+				continue
+			}
+
+			// price of 1 display denom
+			amount := &amountPkg.Amount{
+				Value:     new(big.Float).SetFloat64(math.Pow10(denomInfo.DenomExponent)),
+				Denom:     amountPkg.Denom(denomInfo.Denom),
+				BaseDenom: amountPkg.Denom(denomInfo.Denom),
+			}
+			f.PopulateAmount(ctx, chain.ChainID, amount)
+
+			if amount.PriceUSD == nil || amount.PriceUSD.Sign() <= 0 {
+				return nil, false
+			}
+
+			price, _ := amount.PriceUSD.Float64()
+			return &amountPkg.QuoteRate{Currency: currency, Rate: 1 / price}, true
+		}
+	}
+
+	return nil, false
+}
+
+func (f *DataFetcher) GetExchangeRates(ctx context.Context) map[string]*amountPkg.QuoteRate {
+	coingecko, ok := f.GetPriceFetcher(priceFetchers.CoingeckoPriceFetcherName).(*priceFetchers.CoingeckoPriceFetcher)
+	if !ok {
+		return map[string]*amountPkg.QuoteRate{}
+	}
+
+	exchangeRates, err := coingecko.GetExchangeRates(ctx)
+	if err != nil {
+		return map[string]*amountPkg.QuoteRate{}
+	}
+
+	return exchangeRates
+}
@@ -0,0 +1,98 @@
+package data_fetcher
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	aliasManagerPkg "main/pkg/alias_manager"
+	configPkg "main/pkg/config"
+	"main/pkg/config/types"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	amountPkg "main/pkg/types/amount"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func getQuoteRatesDataFetcher() *DataFetcher {
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{
+				Name:    "chain",
+				ChainID: "chain-id",
+				Denoms: types.DenomInfos{
+					{
+						Denom:         "uatom",
+						DisplayDenom:  "atom",
+						DenomExponent: 6,
+						PriceSources:  types.PriceSources{{Type: "static", Price: 10}},
+					},
+					{Denom: "unoprice", DisplayDenom: "noprice", DenomExponent: 6},
+				},
+			},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, &fs.MockFs{})
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	return NewDataFetcher(logger, config, aliasManager, metricsManager)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGetQuoteRatesFromDenomsAndExchangeRates(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/exchange_rates",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko-exchange-rates.json")),
+	)
+
+	dataFetcher := getQuoteRatesDataFetcher()
+
+	rates := dataFetcher.GetQuoteRates(context.Background(), []string{"usd", "atom", "eur", "btc", "noprice", "unknown"})
+	require.Len(t, rates, 4)
+
+	require.Equal(t, "usd", rates[0].Currency)
+	require.InDelta(t, 1, rates[0].Rate, 0.0001)
+
+	require.Equal(t, "atom", rates[1].Currency)
+	require.InDelta(t, 0.1, rates[1].Rate, 0.0001)
+	require.False(t, rates[1].Fiat)
+
+	require.Equal(t, "eur", rates[2].Currency)
+	require.InDelta(t, 0.92, rates[2].Rate, 0.0001)
+
+	require.Equal(t, "btc", rates[3].Currency)
+
+	// exchange rates are queried once for all the currencies
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	// and are cached
+	cachedRates := dataFetcher.GetQuoteRates(context.Background(), []string{"eur", "atom"})
+	require.Len(t, cachedRates, 2)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGetQuoteRatesExchangeRatesFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/exchange_rates",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	dataFetcher := getQuoteRatesDataFetcher()
+
+	rates := dataFetcher.GetQuoteRates(context.Background(), []string{"eur", "usd"})
+	require.Equal(t, []*amountPkg.QuoteRate{{Currency: "usd", Rate: 1, Fiat: true}}, rates)
+}
@@ -1,10 +1,12 @@
 package data_fetcher
 
 import (
+	"context"
 	"main/pkg/types/responses"
 )
 
 func (f *DataFetcher) GetIbcRemoteChainID(
+	ctx context.Context,
 	chainID string,
 	channel string,
 	port string,
@@ -14,15 +16,13 @@ func (f *DataFetcher) GetIbcRemoteChainID(
 		return "", false
 	}
 
-	keyName := chain.Name + "_channel_" + channel + "_port_" + port
-
-	if cachedEntry, cachedEntryPresent := f.Cache.Get(keyName); cachedEntryPresent {
-		if cachedEntryParsed, ok := cachedEntry.(string); ok {
-			return cachedEntryParsed, true
-		}
+	// channel IDs are unique within a chain, so the port does not matter here
+	if remoteChainID, ok := chain.IbcChannels[channel]; ok {
+		return remoteChainID, true
+	}
 
-		f.Logger.Error().Msg("Could not convert cached IBC channel to string")
-		return "", false
+	if cachedEntry, cachedEntryPresent := f.Cache.GetIbcRemoteChainID(chain.Name, channel, port); cachedEntryPresent {
+		return cachedEntry, true
 	}
 
 	var (
@@ -30,10 +30,14 @@ func (f *DataFetcher) GetIbcRemoteChainID(
 		ibcClientState *responses.IbcIdentifiedClientState
 	)
 
-	for _, node := range f.TendermintApiClients[chain.Name] {
-		ibcChannelResponse, err := node.GetIbcChannel(channel, port)
+	for _, node := range f.GetApiClients(chain.Name) {
+		ibcChannelResponse, err := node.GetIbcChannel(ctx, channel, port)
 		if err != nil {
 			f.Logger.Error().Err(err).Msg("Error fetching IBC channel")
+			if ctx.Err() != nil {
+				break
+			}
+
 			continue
 		}
 
@@ -53,10 +57,14 @@ func (f *DataFetcher) GetIbcRemoteChainID(
 		return "", false
 	}
 
-	for _, node := range f.TendermintApiClients[chain.Name] {
-		ibcChannelClientStateResponse, err := node.GetIbcConnectionClientState(ibcChannel.ConnectionHops[0])
+	for _, node := range f.GetApiClients(chain.Name) {
+		ibcChannelClientStateResponse, err := node.GetIbcConnectionClientState(ctx, ibcChannel.ConnectionHops[0])
 		if err != nil {
 			f.Logger.Error().Err(err).Msg("Error fetching IBC client state")
+			if ctx.Err() != nil {
+				break
+			}
+
 			continue
 		}
 
@@ -69,6 +77,6 @@ func (f *DataFetcher) GetIbcRemoteChainID(
 		return "", false
 	}
 
-	f.Cache.Set(keyName, ibcClientState.ClientState.ChainId)
+	f.Cache.SetIbcRemoteChainID(chain.Name, channel, port, ibcClientState.ClientState.ChainId)
 	return ibcClientState.ClientState.ChainId, true
 }
@@ -1,10 +1,12 @@
 package data_fetcher
 
 import (
+	"context"
 	"main/assets"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -30,7 +32,7 @@ func TestDataFetcherFetchRemoteChainIdChainNotFound(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetIbcRemoteChainID("chain-id", "channel", "port")
+	data, fetched := dataFetcher.GetIbcRemoteChainID(context.Background(), "chain-id", "channel", "port")
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -51,9 +53,34 @@ func TestDataFetcherFetchRemoteChainIdCachedOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "remote-chain")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "remote-chain")
 
-	data, fetched := dataFetcher.GetIbcRemoteChainID("chain-id", "channel", "port")
+	data, fetched := dataFetcher.GetIbcRemoteChainID(context.Background(), "chain-id", "channel", "port")
+	require.True(t, fetched)
+	require.Equal(t, "remote-chain", data)
+}
+
+func TestDataFetcherFetchRemoteChainIdFromConfig(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{
+				Name:        "chain",
+				ChainID:     "chain-id",
+				IbcChannels: map[string]string{"channel": "remote-chain"},
+			},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	data, fetched := dataFetcher.GetIbcRemoteChainID(context.Background(), "chain-id", "channel", "port")
 	require.True(t, fetched)
 	require.Equal(t, "remote-chain", data)
 }
@@ -74,9 +101,9 @@ func TestDataFetcherFetchRemoteChainIdCachedNotOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeIbcChannel, "chain_channel_port", nil)
 
-	data, fetched := dataFetcher.GetIbcRemoteChainID("chain-id", "channel", "port")
+	data, fetched := dataFetcher.GetIbcRemoteChainID(context.Background(), "chain-id", "channel", "port")
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -99,7 +126,7 @@ func TestDataFetcherFetchRemoteChainIdIbcChannelQueryFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetIbcRemoteChainID("chain-id", "channel", "port")
+	data, fetched := dataFetcher.GetIbcRemoteChainID(context.Background(), "chain-id", "channel", "port")
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -128,7 +155,7 @@ func TestDataFetcherFetchRemoteChainIdMultihop(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetIbcRemoteChainID("chain-id", "channel", "port")
+	data, fetched := dataFetcher.GetIbcRemoteChainID(context.Background(), "chain-id", "channel", "port")
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -157,7 +184,7 @@ func TestDataFetcherFetchRemoteChainIdIbcClientStateQueryFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetIbcRemoteChainID("chain-id", "channel", "port")
+	data, fetched := dataFetcher.GetIbcRemoteChainID(context.Background(), "chain-id", "channel", "port")
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -192,7 +219,7 @@ func TestDataFetcherFetchRemoteChainIdOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetIbcRemoteChainID("chain-id", "channel", "port")
+	data, fetched := dataFetcher.GetIbcRemoteChainID(context.Background(), "chain-id", "channel", "port")
 	require.True(t, fetched)
 	require.Equal(t, "denis-fadeev-chain", data)
 }
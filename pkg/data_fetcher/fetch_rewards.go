@@ -1,36 +1,34 @@
 package data_fetcher
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types/responses"
-	"strconv"
 )
 
 func (f *DataFetcher) GetRewardsAtBlock(
+	ctx context.Context,
 	chain *configTypes.Chain,
 	delegator string,
 	validator string,
 	block int64,
 ) ([]responses.Reward, bool) {
-	keyName := chain.Name + "_rewards_" + delegator + "_" + validator + "_" + strconv.FormatInt(block, 10)
-
-	if cachedRewards, cachedRewardsPresent := f.Cache.Get(keyName); cachedRewardsPresent {
-		if cachedRewardsParsed, ok := cachedRewards.([]responses.Reward); ok {
-			return cachedRewardsParsed, true
-		}
-
-		f.Logger.Error().Msg("Could not convert cached rewards to []responses.Reward")
-		return []responses.Reward{}, false
+	if cachedRewards, cachedRewardsPresent := f.Cache.GetRewards(chain.Name, delegator, validator, block); cachedRewardsPresent {
+		return cachedRewards, true
 	}
 
-	for _, node := range f.TendermintApiClients[chain.Name] {
-		notCachedValidator, err := node.GetDelegatorsRewardsAtBlock(delegator, validator, block-1)
+	for _, node := range f.GetApiClients(chain.Name) {
+		notCachedValidator, err := node.GetDelegatorsRewardsAtBlock(ctx, delegator, validator, block-1)
 		if err != nil {
 			f.Logger.Error().Err(err).Msg("Error fetching rewards")
+			if ctx.Err() != nil {
+				break
+			}
+
 			continue
 		}
 
-		f.Cache.Set(keyName, notCachedValidator)
+		f.Cache.SetRewards(chain.Name, delegator, validator, block, notCachedValidator)
 		return notCachedValidator, true
 	}
 
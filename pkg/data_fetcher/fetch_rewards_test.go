@@ -1,10 +1,12 @@
 package data_fetcher
 
 import (
+	"context"
 	"main/assets"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -31,11 +33,11 @@ func TestDataFetcherFetchRewardsCachedOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_rewards_delegator_validator_100", []responses.Reward{
+	dataFetcher.Cache.SetRewards("chain", "delegator", "validator", 100, []responses.Reward{
 		{Amount: "100", Denom: "ustake"},
 	})
 
-	data, fetched := dataFetcher.GetRewardsAtBlock(config.Chains[0], "delegator", "validator", 100)
+	data, fetched := dataFetcher.GetRewardsAtBlock(context.Background(), config.Chains[0], "delegator", "validator", 100)
 	require.True(t, fetched)
 	require.Len(t, data, 1)
 	require.Equal(t, "100", data[0].Amount)
@@ -58,9 +60,9 @@ func TestDataFetcherFetchRewardsCachedNotOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_rewards_delegator_validator_100", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeRewards, "chain_delegator_validator_100", nil)
 
-	data, fetched := dataFetcher.GetRewardsAtBlock(config.Chains[0], "delegator", "validator", 100)
+	data, fetched := dataFetcher.GetRewardsAtBlock(context.Background(), config.Chains[0], "delegator", "validator", 100)
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -83,7 +85,7 @@ func TestDataFetcherFetchRewardsAllQueriesFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetRewardsAtBlock(config.Chains[0], "delegator", "validator", 100)
+	data, fetched := dataFetcher.GetRewardsAtBlock(context.Background(), config.Chains[0], "delegator", "validator", 100)
 	require.False(t, fetched)
 	require.Empty(t, data)
 }
@@ -112,7 +114,7 @@ func TestDataFetcherFetchRewardsSuccessfullyFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetRewardsAtBlock(config.Chains[0], "delegator", "validator", 100)
+	data, fetched := dataFetcher.GetRewardsAtBlock(context.Background(), config.Chains[0], "delegator", "validator", 100)
 	require.True(t, fetched)
 	require.Len(t, data, 1)
 	require.Equal(t, "23456", data[0].Amount)
@@ -1,31 +1,29 @@
 package data_fetcher
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types/responses"
 )
 
-func (f *DataFetcher) GetStakingParams(chain *configTypes.Chain) (*responses.StakingParams, bool) {
-	keyName := chain.Name + "_staking_params"
-
-	if cachedEntry, cachedEntryPresent := f.Cache.Get(keyName); cachedEntryPresent {
-		if cachedEntryParsed, ok := cachedEntry.(*responses.StakingParams); ok {
-			return cachedEntryParsed, true
-		}
-
-		f.Logger.Error().Msg("Could not convert cached staking params to *responses.StakingParams")
-		return nil, false
+func (f *DataFetcher) GetStakingParams(ctx context.Context, chain *configTypes.Chain) (*responses.StakingParams, bool) {
+	if cachedEntry, cachedEntryPresent := f.Cache.GetStakingParams(chain.Name); cachedEntryPresent {
+		return cachedEntry, true
 	}
 
-	for _, node := range f.TendermintApiClients[chain.Name] {
-		notCachedEntry, err := node.GetStakingParams()
+	for _, node := range f.GetApiClients(chain.Name) {
+		notCachedEntry, err := node.GetStakingParams(ctx)
 
 		if err != nil {
 			f.Logger.Error().Err(err).Msg("Error fetching staking params")
+			if ctx.Err() != nil {
+				break
+			}
+
 			continue
 		}
 
-		f.Cache.Set(keyName, notCachedEntry)
+		f.Cache.SetStakingParams(chain.Name, notCachedEntry)
 		return notCachedEntry, true
 	}
 
@@ -1,10 +1,12 @@
 package data_fetcher
 
 import (
+	"context"
 	"main/assets"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -32,11 +34,11 @@ func TestDataFetcherFetchStakingParamsCachedOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_staking_params", &responses.StakingParams{
+	dataFetcher.Cache.SetStakingParams("chain", &responses.StakingParams{
 		UnbondingTime: responses.Duration{Duration: 15 * time.Second},
 	})
 
-	data, fetched := dataFetcher.GetStakingParams(config.Chains[0])
+	data, fetched := dataFetcher.GetStakingParams(context.Background(), config.Chains[0])
 	require.True(t, fetched)
 	require.NotNil(t, data)
 	require.Equal(t, "15s", data.UnbondingTime.Duration.String())
@@ -58,9 +60,9 @@ func TestDataFetcherFetchStakingParamsCachedNotOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_staking_params", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeStakingParams, "chain", nil)
 
-	data, fetched := dataFetcher.GetStakingParams(config.Chains[0])
+	data, fetched := dataFetcher.GetStakingParams(context.Background(), config.Chains[0])
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
@@ -83,7 +85,7 @@ func TestDataFetcherFetchStakingParamsAllQueriesFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetStakingParams(config.Chains[0])
+	data, fetched := dataFetcher.GetStakingParams(context.Background(), config.Chains[0])
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
@@ -112,7 +114,7 @@ func TestDataFetcherFetchStakingParamsSuccessfullyFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetStakingParams(config.Chains[0])
+	data, fetched := dataFetcher.GetStakingParams(context.Background(), config.Chains[0])
 	require.True(t, fetched)
 	require.NotNil(t, data)
 	require.Equal(t, "504h0m0s", data.UnbondingTime.Duration.String())
@@ -1,12 +1,14 @@
 package data_fetcher
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types/amount"
 	"strings"
 )
 
 func (f *DataFetcher) PopulateMultichainDenomInfo(
+	ctx context.Context,
 	chainID string,
 	baseDenom amount.Denom,
 ) (*configTypes.DenomInfo, bool) {
@@ -22,17 +24,17 @@ func (f *DataFetcher) PopulateMultichainDenomInfo(
 	// 2. If it's an IBC denom - we need to fetch the remote chain's chain-id
 	// and fetch it from that chain.
 	if baseDenom.IsIbcToken() {
-		ibcChainID, remoteDenom, fetched := f.GetRemoteChainIDAndDenomByIBCDenom(chainID, baseDenom)
+		ibcChainID, remoteDenom, fetched := f.GetRemoteChainIDAndDenomByIBCDenom(ctx, chainID, baseDenom)
 		if !fetched {
 			return nil, false
 		}
 
-		return f.PopulateMultichainDenomInfo(ibcChainID, remoteDenom)
+		return f.PopulateMultichainDenomInfo(ctx, ibcChainID, remoteDenom)
 	}
 
 	// 3. Trying to fetch chain from cosmos.directory.
 	// 3.1. Fetching the cosmos.directory chains list
-	cosmosDirectoryChains, found := f.GetCosmosDirectoryChains()
+	cosmosDirectoryChains, found := f.GetCosmosDirectoryChains(ctx)
 	if !found {
 		return nil, false
 	}
@@ -58,6 +60,7 @@ func (f *DataFetcher) PopulateMultichainDenomInfo(
 }
 
 func (f *DataFetcher) GetRemoteChainIDAndDenomByIBCDenom(
+	ctx context.Context,
 	chainID string,
 	denom amount.Denom,
 ) (string, amount.Denom, bool) {
@@ -68,7 +71,7 @@ func (f *DataFetcher) GetRemoteChainIDAndDenomByIBCDenom(
 	}
 
 	// 1. Fetching remote DenomTrace from chain this transaction/message belongs to.
-	trace, found := f.GetDenomTrace(chain, string(denom))
+	trace, found := f.GetDenomTrace(ctx, chain, string(denom))
 	if !found {
 		return "", "", false
 	}
@@ -82,7 +85,7 @@ func (f *DataFetcher) GetRemoteChainIDAndDenomByIBCDenom(
 		pathParsed = pathParsedInternal
 
 		// 3. Getting the chain-id of the denom on the chain it was minted.
-		remoteChainIDFetched, found := f.GetIbcRemoteChainID(remoteChainID, channel, port)
+		remoteChainIDFetched, found := f.GetIbcRemoteChainID(ctx, remoteChainID, channel, port)
 		if !found {
 			return "", "", false
 		}
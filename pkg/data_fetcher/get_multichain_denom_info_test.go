@@ -1,9 +1,11 @@
 package data_fetcher
 
 import (
+	"context"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -34,7 +36,7 @@ func TestDataFetcherGetMultichainDenomInfoPresentLocally(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "udenom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "udenom")
 	require.True(t, found)
 	require.NotNil(t, denomInfo)
 	require.Equal(t, "denom", denomInfo.DisplayDenom)
@@ -59,11 +61,50 @@ func TestDataFetcherGetMultichainDenomInfoIbcDenomNotFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "ibc/denom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "ibc/denom")
 	require.False(t, found)
 	require.Nil(t, denomInfo)
 }
 
+func TestDataFetcherGetMultichainDenomInfoIbcDenomFromConfig(t *testing.T) {
+	t.Parallel()
+
+	// no API nodes, so everything should be taken from config
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{
+				Name:        "osmosis",
+				ChainID:     "osmosis-1",
+				IbcChannels: map[string]string{"channel-0": "cosmoshub-4"},
+				IbcDenoms: map[string]string{
+					"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2": "transfer/channel-0/uatom",
+				},
+			},
+			{
+				Name:    "cosmoshub",
+				ChainID: "cosmoshub-4",
+				Denoms:  types.DenomInfos{{Denom: "uatom", DisplayDenom: "atom"}},
+			},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(
+		context.Background(),
+		"osmosis-1",
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+	)
+	require.True(t, found)
+	require.NotNil(t, denomInfo)
+	require.Equal(t, "atom", denomInfo.DisplayDenom)
+}
+
 func TestDataFetcherGetMultichainDenomInfoIbcDenomTraceFailed(t *testing.T) {
 	t.Parallel()
 
@@ -84,9 +125,9 @@ func TestDataFetcherGetMultichainDenomInfoIbcDenomTraceFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeDenomTrace, "chain_denom", nil)
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "ibc/denom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "ibc/denom")
 	require.False(t, found)
 	require.Nil(t, denomInfo)
 }
@@ -111,13 +152,13 @@ func TestDataFetcherGetMultichainDenomInfoIbcDenomRemoteFetchIdFailed(t *testing
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", &transferTypes.DenomTrace{
+	dataFetcher.Cache.SetDenomTrace("chain", "denom", &transferTypes.DenomTrace{
 		Path: "port/channel",
 	})
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeIbcChannel, "chain_channel_port", nil)
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "ibc/denom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "ibc/denom")
 	require.False(t, found)
 	require.Nil(t, denomInfo)
 }
@@ -142,14 +183,14 @@ func TestDataFetcherGetMultichainDenomInfoIbcDenomMultihopRemoteChainNotFound(t
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", &transferTypes.DenomTrace{
+	dataFetcher.Cache.SetDenomTrace("chain", "denom", &transferTypes.DenomTrace{
 		Path: "port/channel/port2/channel2",
 	})
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "remotechain")
-	dataFetcher.Cache.Set("chain_channel_channel2_port_port2", "remotechain2")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "remotechain")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel2", "port2", "remotechain2")
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "ibc/denom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "ibc/denom")
 	require.False(t, found)
 	require.Nil(t, denomInfo)
 }
@@ -174,13 +215,13 @@ func TestDataFetcherGetMultichainDenomInfoIbcDenomRemoteChainNotFound(t *testing
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", &transferTypes.DenomTrace{
+	dataFetcher.Cache.SetDenomTrace("chain", "denom", &transferTypes.DenomTrace{
 		Path: "port/channel",
 	})
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "remotechain")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "remotechain")
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "ibc/denom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "ibc/denom")
 	require.False(t, found)
 	require.Nil(t, denomInfo)
 }
@@ -203,9 +244,9 @@ func TestDataFetcherGetMultichainDenomInfoCosmosDirectoryFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("cosmos_directory_chains", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeCosmosDirectoryChains, "", nil)
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "udenom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "udenom")
 	require.False(t, found)
 	require.Nil(t, denomInfo)
 }
@@ -228,9 +269,9 @@ func TestDataFetcherGetMultichainDenomInfoCosmosDirectoryChainNotFound(t *testin
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("cosmos_directory_chains", responses.CosmosDirectoryChains{})
+	dataFetcher.Cache.SetCosmosDirectoryChains(responses.CosmosDirectoryChains{})
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "udenom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "udenom")
 	require.False(t, found)
 	require.Nil(t, denomInfo)
 }
@@ -253,14 +294,14 @@ func TestDataFetcherGetMultichainDenomInfoCosmosDirectoryAssetNotFound(t *testin
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("cosmos_directory_chains", responses.CosmosDirectoryChains{
+	dataFetcher.Cache.SetCosmosDirectoryChains(responses.CosmosDirectoryChains{
 		{
 			ChainID: "chain-id",
 			Assets:  []responses.CosmosDirectoryAsset{},
 		},
 	})
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "udenom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "udenom")
 	require.False(t, found)
 	require.Nil(t, denomInfo)
 }
@@ -283,7 +324,7 @@ func TestDataFetcherGetMultichainDenomInfoCosmosDirectoryOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("cosmos_directory_chains", responses.CosmosDirectoryChains{
+	dataFetcher.Cache.SetCosmosDirectoryChains(responses.CosmosDirectoryChains{
 		{
 			ChainID: "chain-id",
 			Assets: []responses.CosmosDirectoryAsset{
@@ -296,7 +337,7 @@ func TestDataFetcherGetMultichainDenomInfoCosmosDirectoryOk(t *testing.T) {
 		},
 	})
 
-	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo("chain-id", "udenom")
+	denomInfo, found := dataFetcher.PopulateMultichainDenomInfo(context.Background(), "chain-id", "udenom")
 	require.True(t, found)
 	require.NotNil(t, denomInfo)
 	require.Equal(t, "denom", denomInfo.DisplayDenom)
@@ -1,51 +1,66 @@
 package data_fetcher
 
 import (
-	"fmt"
+	"context"
 	configTypes "main/pkg/config/types"
 	priceFetchers "main/pkg/price_fetchers"
 	"main/pkg/types"
 	amountPkg "main/pkg/types/amount"
+	"main/pkg/types/query_info"
+	"main/pkg/utils"
+	"strings"
 )
 
-func (f *DataFetcher) GetPriceFetcher(info *configTypes.DenomInfo) types.PriceFetcher {
-	if info.CoingeckoCurrency != "" {
-		if fetcher, ok := f.PriceFetchers[priceFetchers.CoingeckoPriceFetcherName]; ok {
-			return fetcher
-		}
+// GetPriceFetcher returns the price fetcher for the price source type, creating it if needed.
+func (f *DataFetcher) GetPriceFetcher(sourceType string) types.PriceFetcher {
+	f.priceFetchersMutex.Lock()
+	defer f.priceFetchersMutex.Unlock()
 
-		f.PriceFetchers[priceFetchers.CoingeckoPriceFetcherName] = priceFetchers.NewCoingeckoPriceFetcher(
-			f.Logger,
-			f.MetricsManager,
-		)
-		return f.PriceFetchers[priceFetchers.CoingeckoPriceFetcherName]
+	if fetcher, ok := f.PriceFetchers[sourceType]; ok {
+		return fetcher
 	}
 
-	return nil
+	var fetcher types.PriceFetcher
+
+	switch sourceType {
+	case priceFetchers.CoingeckoPriceFetcherName:
+		fetcher = priceFetchers.NewCoingeckoPriceFetcher(f.Logger, f.MetricsManager, f.Config.Timeouts)
+	case priceFetchers.OsmosisPriceFetcherName:
+		fetcher = priceFetchers.NewOsmosisPriceFetcher(f.Logger, f.MetricsManager, f.Config.Timeouts)
+	case priceFetchers.StaticPriceFetcherName:
+		fetcher = &priceFetchers.StaticPriceFetcher{}
+	case priceFetchers.JSONPriceFetcherName:
+		fetcher = priceFetchers.NewJSONPriceFetcher(f.Logger, f.MetricsManager, f.Config.Timeouts)
+	default:
+		return nil
+	}
+
+	f.PriceFetchers[sourceType] = fetcher
+	return fetcher
 }
 
-func (f *DataFetcher) GetDenomPriceKey(
-	chainID string,
+// queuePriceFetch adds the denom to the ones to query from its price source with the given index,
+// returning false if the denom does not have that many price sources.
+func queuePriceFetch(
+	denomsToQuery map[string]configTypes.DenomInfos,
 	denomInfo *configTypes.DenomInfo,
-) string {
-	return fmt.Sprintf("%s_price_%s", chainID, denomInfo.Denom)
+	sourceIndex int,
+) bool {
+	priceSources := denomInfo.GetPriceSources()
+	if sourceIndex >= len(priceSources) {
+		return false
+	}
+
+	sourceType := priceSources[sourceIndex].Type
+	denomsToQuery[sourceType] = append(denomsToQuery[sourceType], denomInfo)
+	return true
 }
+
 func (f *DataFetcher) MaybeGetCachedPrice(
 	chainID string,
 	denomInfo *configTypes.DenomInfo,
 ) (float64, bool) {
-	cacheKey := f.GetDenomPriceKey(chainID, denomInfo)
-
-	if cachedPrice, cachedPricePresent := f.Cache.Get(cacheKey); cachedPricePresent {
-		if cachedPriceFloat, ok := cachedPrice.(float64); ok {
-			return cachedPriceFloat, true
-		}
-
-		f.Logger.Error().Msg("Could not convert cached price to float64")
-		return 0, false
-	}
-
-	return 0, false
+	return f.Cache.GetPrice(chainID, denomInfo.Denom)
 }
 
 func (f *DataFetcher) SetCachedPrice(
@@ -53,20 +68,19 @@ func (f *DataFetcher) SetCachedPrice(
 	denomInfo *configTypes.DenomInfo,
 	notCachedPrice float64,
 ) {
-	cacheKey := f.GetDenomPriceKey(chainID, denomInfo)
-	f.Cache.Set(cacheKey, notCachedPrice)
+	f.Cache.SetPrice(chainID, denomInfo.Denom, notCachedPrice)
 }
 
-func (f *DataFetcher) PopulateAmount(chainID string, amount *amountPkg.Amount) {
-	f.PopulateAmounts(chainID, amountPkg.Amounts{amount})
+func (f *DataFetcher) PopulateAmount(ctx context.Context, chainID string, amount *amountPkg.Amount) {
+	f.PopulateAmounts(ctx, chainID, amountPkg.Amounts{amount})
 }
 
-func (f *DataFetcher) PopulateAmounts(chainID string, amounts amountPkg.Amounts) {
-	denomsToQueryByPriceFetcher := make(map[string]configTypes.DenomInfos)
+func (f *DataFetcher) PopulateAmounts(ctx context.Context, chainID string, amounts amountPkg.Amounts) {
+	denomsToFetch := make(configTypes.DenomInfos, 0)
 
 	// 1. Getting cached prices.
 	for _, amount := range amounts {
-		denomInfo, found := f.PopulateMultichainDenomInfo(chainID, amount.BaseDenom)
+		denomInfo, found := f.PopulateMultichainDenomInfo(ctx, chainID, amount.BaseDenom)
 		if !found {
 			f.Logger.Warn().
 				Str("chain", chainID).
@@ -92,62 +106,159 @@ func (f *DataFetcher) PopulateAmounts(chainID string, amounts amountPkg.Amounts)
 			continue
 		}
 
-		// Otherwise, we try to figure out what price fetcher to use
-		// and put it into a map to query it all at once.
-		priceFetcher := f.GetPriceFetcher(denomInfo)
-		if priceFetcher == nil {
-			continue
-		}
-
-		if _, ok := denomsToQueryByPriceFetcher[priceFetcher.Name()]; !ok {
-			denomsToQueryByPriceFetcher[priceFetcher.Name()] = make(configTypes.DenomInfos, 0)
+		// Otherwise, we put it into a list to query prices all at once, unless it's already there.
+		if !utils.Contains(denomsToFetch, denomInfo) {
+			denomsToFetch = append(denomsToFetch, denomInfo)
 		}
-
-		denomsToQueryByPriceFetcher[priceFetcher.Name()] = append(
-			denomsToQueryByPriceFetcher[priceFetcher.Name()],
-			denomInfo,
-		)
 	}
 
 	// 2. If we do not need to fetch any prices from price fetcher (e.g. no prices here
 	// or all prices are taken from cache), then we do not need to do anything else.
-	if len(denomsToQueryByPriceFetcher) == 0 {
+	if len(denomsToFetch) == 0 {
 		return
 	}
 
-	uncachedPrices := make(map[string]float64)
+	uncachedPrices := f.FetchPrices(ctx, chainID, denomsToFetch)
 
-	// 3. Fetching all prices by price fetcher.
-	for priceFetcherKey, priceFetcher := range f.PriceFetchers {
-		pricesToFetch, ok := denomsToQueryByPriceFetcher[priceFetcherKey]
+	// 3. Converting USD amounts for newly fetched prices.
+	for _, amount := range amounts {
+		uncachedPrice, ok := uncachedPrices[amount.BaseDenom.String()]
 		if !ok {
 			continue
 		}
 
-		// Actually fetching prices.
-		prices, err := priceFetcher.GetPrices(pricesToFetch)
+		if uncachedPrice != 0 {
+			amount.AddUSDPrice(uncachedPrice)
+		}
+	}
+}
 
-		if err != nil {
+// PrefetchPrices fetches prices for all the amounts denoms that are not cached yet at once,
+// with one query per price source, so they are taken from cache when messages with these amounts
+// are enriched. Unlike PopulateAmounts, it does not modify the amounts.
+func (f *DataFetcher) PrefetchPrices(ctx context.Context, chainID string, amounts amountPkg.Amounts) {
+	denomsToFetch := make(configTypes.DenomInfos, 0)
+
+	for _, amount := range amounts {
+		denomInfo, found := f.PopulateMultichainDenomInfo(ctx, chainID, amount.BaseDenom)
+		if !found {
 			continue
 		}
 
-		// Saving it to cache
-		for denomInfo, price := range prices {
-			f.SetCachedPrice(chainID, denomInfo, price)
+		if _, cached := f.MaybeGetCachedPrice(chainID, denomInfo); cached {
+			continue
+		}
 
-			uncachedPrices[denomInfo.Denom] = price
+		if !utils.Contains(denomsToFetch, denomInfo) {
+			denomsToFetch = append(denomsToFetch, denomInfo)
 		}
 	}
 
-	// 4. Converting USD amounts for newly fetched prices.
-	for _, amount := range amounts {
-		uncachedPrice, ok := uncachedPrices[amount.BaseDenom.String()]
-		if !ok {
-			continue
+	if len(denomsToFetch) > 0 {
+		f.FetchPrices(ctx, chainID, denomsToFetch)
+	}
+}
+
+// FetchPrices fetches prices for denoms and caches them, returning them by denom. Denoms are queried
+// from their first price source all at once, and if a price source has no price for a denom,
+// it's queried from its next price source, until there are no price sources left. Denoms
+// without price are cached with zero price, so they are not queried again for each message,
+// but only if all their price sources have responded, so a price source that is temporarily
+// unavailable (for example, rate-limited) does not leave denoms without prices till the cache expires.
+func (f *DataFetcher) FetchPrices(
+	ctx context.Context,
+	chainID string,
+	denomInfos configTypes.DenomInfos,
+) map[string]float64 {
+	denomsToQueryByPriceFetcher := make(map[string]configTypes.DenomInfos)
+
+	// Index of the price source the denom price is currently fetched from.
+	priceSourceIndexes := make(map[*configTypes.DenomInfo]int)
+
+	for _, denomInfo := range denomInfos {
+		if queuePriceFetch(denomsToQueryByPriceFetcher, denomInfo, 0) {
+			priceSourceIndexes[denomInfo] = 0
 		}
+	}
 
-		if uncachedPrice != 0 {
-			amount.AddUSDPrice(uncachedPrice)
+	prices := make(map[string]float64)
+
+	// Denoms for which any of the price sources failed to respond.
+	failedDenoms := make(map[*configTypes.DenomInfo]bool)
+
+	for len(denomsToQueryByPriceFetcher) > 0 {
+		nextDenomsToQuery := make(map[string]configTypes.DenomInfos)
+
+		for sourceType, sourceDenomInfos := range denomsToQueryByPriceFetcher {
+			sourcePrices, fetched := f.GetSourcePrices(ctx, chainID, sourceType, sourceDenomInfos)
+
+			for _, denomInfo := range sourceDenomInfos {
+				if !fetched {
+					failedDenoms[denomInfo] = true
+				}
+
+				// Saving it to cache
+				if price, ok := sourcePrices[denomInfo.Denom]; ok {
+					f.SetCachedPrice(chainID, denomInfo, price)
+					prices[denomInfo.Denom] = price
+					continue
+				}
+
+				priceSourceIndexes[denomInfo]++
+				queuePriceFetch(nextDenomsToQuery, denomInfo, priceSourceIndexes[denomInfo])
+			}
 		}
+
+		denomsToQueryByPriceFetcher = nextDenomsToQuery
 	}
+
+	for _, denomInfo := range denomInfos {
+		if _, ok := prices[denomInfo.Denom]; !ok && !failedDenoms[denomInfo] && len(denomInfo.GetPriceSources()) > 0 {
+			f.SetCachedPrice(chainID, denomInfo, 0)
+		}
+	}
+
+	return prices
+}
+
+// GetSourcePrices returns denoms prices from a price source by denom, and false if the price source
+// could not be queried. Concurrent queries for the same denoms are coalesced, so they are sent once.
+func (f *DataFetcher) GetSourcePrices(
+	ctx context.Context,
+	chainID string,
+	sourceType string,
+	denomInfos configTypes.DenomInfos,
+) (map[string]float64, bool) {
+	denoms := utils.Map(denomInfos, func(denomInfo *configTypes.DenomInfo) string {
+		return denomInfo.Denom
+	})
+
+	key := "prices_" + chainID + "_" + sourceType + "_" + strings.Join(denoms, ",")
+
+	prices, found := coalesce(ctx, f, key, query_info.QueryTypePrices, func(ctx context.Context) (map[string]float64, bool) {
+		priceFetcher := f.GetPriceFetcher(sourceType)
+		if priceFetcher == nil {
+			return map[string]float64{}, true
+		}
+
+		fetchedPrices, err := priceFetcher.GetPrices(ctx, denomInfos)
+		if err != nil {
+			return nil, false
+		}
+
+		// Denom infos can be different for concurrent callers (for example,
+		// if taken from cosmos.directory), so prices are returned by denom.
+		result := make(map[string]float64, len(fetchedPrices))
+		for denomInfo, price := range fetchedPrices {
+			result[denomInfo.Denom] = price
+		}
+
+		return result, true
+	})
+
+	if !found {
+		return map[string]float64{}, false
+	}
+
+	return prices, true
 }
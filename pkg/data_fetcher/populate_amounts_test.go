@@ -1,10 +1,13 @@
 package data_fetcher
 
 import (
+	"context"
+	"errors"
 	"main/assets"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -39,7 +42,7 @@ func TestPopulateAmountCachedNotConvertedNotFound(t *testing.T) {
 
 	amount := &amountPkg.Amount{BaseDenom: "uatom", Value: big.NewFloat(1230000)}
 
-	dataFetcher.PopulateAmount(config.Chains[0].ChainID, amount)
+	dataFetcher.PopulateAmount(context.Background(), config.Chains[0].ChainID, amount)
 	require.Equal(t, "1230000", amount.Value.String())
 	require.Equal(t, "uatom", amount.BaseDenom.String())
 	require.Nil(t, amount.PriceUSD)
@@ -69,7 +72,7 @@ func TestPopulateAmountCachedNotConvertedWithoutPriceFetcher(t *testing.T) {
 
 	amount := &amountPkg.Amount{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(1230000)}
 
-	dataFetcher.PopulateAmount(config.Chains[0].ChainID, amount)
+	dataFetcher.PopulateAmount(context.Background(), config.Chains[0].ChainID, amount)
 	require.Equal(t, "1.23", amount.Value.String())
 	require.Equal(t, "uatom", amount.BaseDenom.String())
 	require.Equal(t, "atom", amount.Denom.String())
@@ -100,11 +103,11 @@ func TestPopulateAmountCachedConvertedWithCachedPrice(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain-id_price_uatom", float64(10))
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", float64(10))
 
 	amount := &amountPkg.Amount{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(1230000)}
 
-	dataFetcher.PopulateAmount(config.Chains[0].ChainID, amount)
+	dataFetcher.PopulateAmount(context.Background(), config.Chains[0].ChainID, amount)
 	require.Equal(t, "1.23", amount.Value.String())
 	require.Equal(t, "uatom", amount.BaseDenom.String())
 	require.Equal(t, "atom", amount.Denom.String())
@@ -136,11 +139,11 @@ func TestPopulateAmountCachedNotConvertedWithCachedPriceInvalid(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain-id_price_uatom", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypePrice, "chain-id_uatom", nil)
 
 	amount := &amountPkg.Amount{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(1230000)}
 
-	dataFetcher.PopulateAmount(config.Chains[0].ChainID, amount)
+	dataFetcher.PopulateAmount(context.Background(), config.Chains[0].ChainID, amount)
 	require.Equal(t, "1.23", amount.Value.String())
 	require.Equal(t, "uatom", amount.BaseDenom.String())
 	require.Equal(t, "atom", amount.Denom.String())
@@ -175,7 +178,7 @@ func TestPopulateAmountsFetched(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos,cosmos&vs_currencies=usd",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko.json")),
 	)
 
@@ -184,7 +187,7 @@ func TestPopulateAmountsFetched(t *testing.T) {
 		{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(4560000)},
 	}
 
-	dataFetcher.PopulateAmounts(config.Chains[0].ChainID, amounts)
+	dataFetcher.PopulateAmounts(context.Background(), config.Chains[0].ChainID, amounts)
 
 	require.Equal(t, "1.23", amounts[0].Value.String())
 	require.Equal(t, "uatom", amounts[0].BaseDenom.String())
@@ -198,3 +201,203 @@ func TestPopulateAmountsFetched(t *testing.T) {
 	require.NotNil(t, amounts[1].PriceUSD)
 	require.Equal(t, "30.0504", amounts[1].PriceUSD.String())
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrefetchPrices(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{
+				Name:    "chain",
+				ChainID: "chain-id",
+				Denoms: types.DenomInfos{
+					{Denom: "uatom", DisplayDenom: "atom", DenomExponent: 6, CoingeckoCurrency: "cosmos"},
+					{Denom: "uakt", DisplayDenom: "akt", DenomExponent: 6, CoingeckoCurrency: "akash-network"},
+					{Denom: "ustake", DisplayDenom: "stake", DenomExponent: 6},
+				},
+			},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos,akash-network&vs_currencies=usd",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko.json")),
+	)
+
+	amounts := amountPkg.Amounts{
+		{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(1230000)},
+		{BaseDenom: "uakt", Denom: "uakt", Value: big.NewFloat(1000000)},
+		{BaseDenom: "ustake", Denom: "ustake", Value: big.NewFloat(1000000)},
+		{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(4560000)},
+	}
+
+	// prices are fetched with one query, and amounts are not changed
+	dataFetcher.PrefetchPrices(context.Background(), config.Chains[0].ChainID, amounts)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+	require.Equal(t, "uatom", amounts[0].Denom.String())
+	require.Nil(t, amounts[0].PriceUSD)
+
+	price, cached := dataFetcher.MaybeGetCachedPrice(config.Chains[0].ChainID, config.Chains[0].Denoms[0])
+	require.True(t, cached)
+	require.InDelta(t, 6.59, price, 0.001)
+
+	// denoms without prices are cached too, so they are not refetched
+	price, cached = dataFetcher.MaybeGetCachedPrice(config.Chains[0].ChainID, config.Chains[0].Denoms[1])
+	require.True(t, cached)
+	require.Zero(t, price)
+
+	// denoms without price sources are not fetched at all
+	_, cached = dataFetcher.MaybeGetCachedPrice(config.Chains[0].ChainID, config.Chains[0].Denoms[2])
+	require.False(t, cached)
+
+	dataFetcher.PopulateAmounts(context.Background(), config.Chains[0].ChainID, amounts)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+	require.NotNil(t, amounts[0].PriceUSD)
+	require.Equal(t, "8.1057", amounts[0].PriceUSD.String())
+	require.Nil(t, amounts[1].PriceUSD)
+	require.Nil(t, amounts[2].PriceUSD)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPopulateAmountsFallback(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{
+				Name:    "chain",
+				ChainID: "chain-id",
+				Denoms: types.DenomInfos{
+					{
+						Denom:             "uatom",
+						DisplayDenom:      "atom",
+						DenomExponent:     6,
+						CoingeckoCurrency: "cosmos",
+						PriceSources: types.PriceSources{
+							{Type: "coingecko"},
+							{Type: "json", URL: "https://prices.example.com", Path: "$.price"},
+							{Type: "static", Price: 2},
+						},
+					},
+					{
+						Denom:         "uusdc",
+						DisplayDenom:  "usdc",
+						DenomExponent: 6,
+						PriceSources:  types.PriceSources{{Type: "json", URL: "https://prices.example.com", Path: "$.usdc"}},
+					},
+				},
+			},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://prices.example.com",
+		httpmock.NewBytesResponder(200, []byte(`{"usdc":"1.001"}`)),
+	)
+
+	amounts := amountPkg.Amounts{
+		{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(1230000)},
+		{BaseDenom: "uusdc", Denom: "uusdc", Value: big.NewFloat(1000000)},
+	}
+
+	dataFetcher.PopulateAmounts(context.Background(), config.Chains[0].ChainID, amounts)
+
+	require.NotNil(t, amounts[0].PriceUSD)
+	require.Equal(t, "2.46", amounts[0].PriceUSD.String())
+	require.NotNil(t, amounts[1].PriceUSD)
+	require.Equal(t, "1.001", amounts[1].PriceUSD.String())
+
+	price, found := dataFetcher.Cache.GetPrice("chain-id", "uatom")
+	require.True(t, found)
+	require.InDelta(t, 2, price, 0.001)
+}
+
+func TestPopulateAmountsSourceFailedNotCached(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{
+				Name:    "chain",
+				ChainID: "chain-id",
+				Denoms: types.DenomInfos{
+					{
+						Denom:             "uatom",
+						DisplayDenom:      "atom",
+						DenomExponent:     6,
+						CoingeckoCurrency: "cosmos",
+						PriceSources:      types.PriceSources{{Type: "coingecko"}},
+					},
+				},
+			},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	amounts := amountPkg.Amounts{
+		{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(1230000)},
+	}
+
+	dataFetcher.PopulateAmounts(context.Background(), config.Chains[0].ChainID, amounts)
+
+	require.Nil(t, amounts[0].PriceUSD)
+
+	_, found := dataFetcher.Cache.GetPrice("chain-id", "uatom")
+	require.False(t, found)
+}
+
+func TestGetPriceFetcher(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{Metrics: configPkg.MetricsConfig{Enabled: false}}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, &fs.MockFs{})
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	for _, sourceType := range []string{"coingecko", "osmosis", "static", "json"} {
+		fetcher := dataFetcher.GetPriceFetcher(sourceType)
+		require.NotNil(t, fetcher)
+		require.Equal(t, sourceType, fetcher.Name())
+		require.Same(t, fetcher, dataFetcher.GetPriceFetcher(sourceType))
+	}
+
+	require.Nil(t, dataFetcher.GetPriceFetcher("unknown"))
+}
@@ -0,0 +1,86 @@
+package data_fetcher
+
+import (
+	"context"
+	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
+	"main/pkg/types"
+	amountPkg "main/pkg/types/amount"
+	"main/pkg/types/query_info"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// PopulateHistoricalPrices adds the values at the time of the transaction to the amounts
+// that are worth at least min-usd-value, if the transaction is old enough for the price to have changed.
+func (f *DataFetcher) PopulateHistoricalPrices(
+	ctx context.Context,
+	chainID string,
+	amounts amountPkg.Amounts,
+	txTime time.Time,
+) {
+	config := f.Config.HistoricalPrices
+	if !config.Enabled || time.Since(txTime) < config.MinAge {
+		return
+	}
+
+	minUSDValue := new(big.Float).SetFloat64(config.MinUSDValue)
+
+	for _, amount := range amounts {
+		if amount.PriceUSD == nil || amount.PriceUSD.Cmp(minUSDValue) < 0 {
+			continue
+		}
+
+		denomInfo, found := f.PopulateMultichainDenomInfo(ctx, chainID, amount.BaseDenom)
+		if !found {
+			continue
+		}
+
+		if price, found := f.GetHistoricalPrice(ctx, chainID, denomInfo, txTime); found {
+			amount.AddHistoricalUSDPrice(price)
+		}
+	}
+}
+
+// GetHistoricalPrice returns the denom price at the given time, taken from the first of its
+// price sources that supports historical prices and has it.
+func (f *DataFetcher) GetHistoricalPrice(
+	ctx context.Context,
+	chainID string,
+	denomInfo *configTypes.DenomInfo,
+	priceTime time.Time,
+) (float64, bool) {
+	priceTime = priceTime.Truncate(constants.HistoricalPriceGranularity)
+
+	if price, cached := f.Cache.GetHistoricalPrice(chainID, denomInfo.Denom, priceTime.Unix()); cached {
+		return price, true
+	}
+
+	key := "historical_price_" + chainID + "_" + denomInfo.Denom + "_" + strconv.FormatInt(priceTime.Unix(), 10)
+
+	return coalesce(ctx, f, key, query_info.QueryTypeHistoricalPrices, func(ctx context.Context) (float64, bool) {
+		for _, priceSource := range denomInfo.GetPriceSources() {
+			priceFetcher, ok := f.GetPriceFetcher(priceSource.Type).(types.HistoricalPriceFetcher)
+			if !ok {
+				continue
+			}
+
+			price, err := priceFetcher.GetHistoricalPrice(ctx, denomInfo, priceTime)
+			if err != nil {
+				f.Logger.Debug().
+					Err(err).
+					Str("chain", chainID).
+					Str("denom", denomInfo.Denom).
+					Str("source", priceSource.Type).
+					Msg("Could not get historical price")
+				continue
+			}
+
+			f.Cache.SetHistoricalPrice(chainID, denomInfo.Denom, priceTime.Unix(), price)
+			return price, true
+		}
+
+		return 0, false
+	})
+}
@@ -0,0 +1,154 @@
+package data_fetcher
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	aliasManagerPkg "main/pkg/alias_manager"
+	configPkg "main/pkg/config"
+	"main/pkg/config/types"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	amountPkg "main/pkg/types/amount"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func getHistoricalPricesDataFetcher(historicalPricesConfig configPkg.HistoricalPricesConfig) *DataFetcher {
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{
+				Name:    "chain",
+				ChainID: "chain-id",
+				Denoms: types.DenomInfos{
+					{
+						Denom:             "uatom",
+						DisplayDenom:      "atom",
+						DenomExponent:     6,
+						CoingeckoCurrency: "cosmos",
+						PriceSources: types.PriceSources{
+							{Type: "osmosis", PoolID: 1, BaseDenom: "uatom", QuoteDenom: "uusdc"},
+							{Type: "coingecko"},
+						},
+					},
+				},
+			},
+		},
+		HistoricalPrices: historicalPricesConfig,
+		Metrics:          configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+	dataFetcher.SetCachedPrice("chain-id", config.Chains[0].Denoms[0], 10)
+
+	return dataFetcher
+}
+
+func TestPopulateHistoricalPricesDisabled(t *testing.T) {
+	t.Parallel()
+
+	dataFetcher := getHistoricalPricesDataFetcher(configPkg.HistoricalPricesConfig{Enabled: false})
+
+	amounts := amountPkg.Amounts{{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(100000000)}}
+	dataFetcher.PopulateAmounts(context.Background(), "chain-id", amounts)
+	dataFetcher.PopulateHistoricalPrices(context.Background(), "chain-id", amounts, time.Now().Add(-time.Hour))
+
+	require.NotNil(t, amounts[0].PriceUSD)
+	require.Nil(t, amounts[0].HistoricalPriceUSD)
+}
+
+func TestPopulateHistoricalPricesTooRecent(t *testing.T) {
+	t.Parallel()
+
+	dataFetcher := getHistoricalPricesDataFetcher(configPkg.HistoricalPricesConfig{
+		Enabled: true,
+		MinAge:  10 * time.Minute,
+	})
+
+	amounts := amountPkg.Amounts{{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(100000000)}}
+	dataFetcher.PopulateAmounts(context.Background(), "chain-id", amounts)
+	dataFetcher.PopulateHistoricalPrices(context.Background(), "chain-id", amounts, time.Now().Add(-time.Minute))
+
+	require.NotNil(t, amounts[0].PriceUSD)
+	require.Nil(t, amounts[0].HistoricalPriceUSD)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPopulateHistoricalPricesFetched(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/coins/cosmos/market_chart/range?vs_currency=usd&from=1672572600&to=1672576200",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko-market-chart.json")),
+	)
+
+	dataFetcher := getHistoricalPricesDataFetcher(configPkg.HistoricalPricesConfig{
+		Enabled:     true,
+		MinUSDValue: 100,
+		MinAge:      10 * time.Minute,
+	})
+
+	amounts := amountPkg.Amounts{
+		{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(100000000)},
+		{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(1000000)},
+		{BaseDenom: "unknown", Denom: "unknown", Value: big.NewFloat(1000000)},
+	}
+	dataFetcher.PopulateAmounts(context.Background(), "chain-id", amounts)
+
+	// osmosis does not support historical prices, so it's taken from coingecko
+	txTime := time.Date(2023, 1, 1, 12, 1, 0, 0, time.UTC)
+	dataFetcher.PopulateHistoricalPrices(context.Background(), "chain-id", amounts, txTime)
+
+	require.NotNil(t, amounts[0].HistoricalPriceUSD)
+	require.Equal(t, "1020", amounts[0].HistoricalPriceUSD.String())
+
+	// worth less than min-usd-value
+	require.Nil(t, amounts[1].HistoricalPriceUSD)
+
+	// no price
+	require.Nil(t, amounts[2].HistoricalPriceUSD)
+
+	// fetched once and cached
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	price, found := dataFetcher.GetHistoricalPrice(context.Background(), "chain-id", dataFetcher.Config.Chains[0].Denoms[0], txTime)
+	require.True(t, found)
+	require.InDelta(t, 10.2, price, 0.001)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPopulateHistoricalPricesFailed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/coins/cosmos/market_chart/range?vs_currency=usd&from=1672572600&to=1672576200",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	dataFetcher := getHistoricalPricesDataFetcher(configPkg.HistoricalPricesConfig{
+		Enabled:     true,
+		MinUSDValue: 100,
+		MinAge:      10 * time.Minute,
+	})
+
+	amounts := amountPkg.Amounts{{BaseDenom: "uatom", Denom: "uatom", Value: big.NewFloat(100000000)}}
+	dataFetcher.PopulateAmounts(context.Background(), "chain-id", amounts)
+	dataFetcher.PopulateHistoricalPrices(context.Background(), "chain-id", amounts, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	require.NotNil(t, amounts[0].PriceUSD)
+	require.Nil(t, amounts[0].HistoricalPriceUSD)
+}
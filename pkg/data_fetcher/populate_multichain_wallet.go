@@ -1,6 +1,7 @@
 package data_fetcher
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 )
 
@@ -20,6 +21,7 @@ func (f *DataFetcher) PopulateWallet(
 }
 
 func (f *DataFetcher) PopulateMultichainWallet(
+	ctx context.Context,
 	chain *configTypes.Chain,
 	channel string,
 	port string,
@@ -33,7 +35,7 @@ func (f *DataFetcher) PopulateMultichainWallet(
 	}
 
 	// Wallet is from another chain. Resolving its chain-id it by traversing the IBC path.
-	remoteChainId, fetched := f.GetIbcRemoteChainID(chain.ChainID, channel, port)
+	remoteChainId, fetched := f.GetIbcRemoteChainID(ctx, chain.ChainID, channel, port)
 	if !fetched {
 		return
 	}
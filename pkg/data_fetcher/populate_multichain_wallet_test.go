@@ -1,9 +1,11 @@
 package data_fetcher
 
 import (
+	"context"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
@@ -89,7 +91,7 @@ func TestDataFetcherPopulateMultichainWalletNoChannelOrPort(t *testing.T) {
 	require.NoError(t, err)
 
 	wallet := &types.Link{Value: "address"}
-	dataFetcher.PopulateMultichainWallet(config.Chains[0], "", "", wallet, "subscription")
+	dataFetcher.PopulateMultichainWallet(context.Background(), config.Chains[0], "", "", wallet, "subscription")
 
 	require.Equal(t, "link address", wallet.Href)
 	require.Equal(t, "alias", wallet.Title)
@@ -114,13 +116,13 @@ func TestDataFetcherPopulateMultichainWalletNoRemoteChainFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeIbcChannel, "chain_channel_port", nil)
 
 	err := aliasManager.Set("subscription", "chain", "address", "alias")
 	require.NoError(t, err)
 
 	wallet := &types.Link{Value: "address"}
-	dataFetcher.PopulateMultichainWallet(config.Chains[0], "channel", "port", wallet, "subscription")
+	dataFetcher.PopulateMultichainWallet(context.Background(), config.Chains[0], "channel", "port", wallet, "subscription")
 
 	require.Empty(t, wallet.Href)
 	require.Empty(t, wallet.Title)
@@ -146,13 +148,13 @@ func TestDataFetcherPopulateMultichainWalletNoLocalChainFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "remote-chain")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "remote-chain")
 
 	err := aliasManager.Set("subscription", "chain", "address", "alias")
 	require.NoError(t, err)
 
 	wallet := &types.Link{Value: "address"}
-	dataFetcher.PopulateMultichainWallet(config.Chains[0], "channel", "port", wallet, "subscription")
+	dataFetcher.PopulateMultichainWallet(context.Background(), config.Chains[0], "channel", "port", wallet, "subscription")
 
 	require.Empty(t, wallet.Href)
 	require.Empty(t, wallet.Title)
@@ -182,13 +184,13 @@ func TestDataFetcherPopulateMultichainWalletNoLocalChainExplorer(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "remote-chain")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "remote-chain")
 
 	err := aliasManager.Set("subscription", "chain", "address", "alias")
 	require.NoError(t, err)
 
 	wallet := &types.Link{Value: "address"}
-	dataFetcher.PopulateMultichainWallet(config.Chains[0], "channel", "port", wallet, "subscription")
+	dataFetcher.PopulateMultichainWallet(context.Background(), config.Chains[0], "channel", "port", wallet, "subscription")
 
 	require.Empty(t, wallet.Href)
 	require.Empty(t, wallet.Title)
@@ -219,13 +221,13 @@ func TestDataFetcherPopulateMultichainWalletRemoteOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "remote-chain")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "remote-chain")
 
 	err := aliasManager.Set("subscription", "chain2", "address", "alias")
 	require.NoError(t, err)
 
 	wallet := &types.Link{Value: "address"}
-	dataFetcher.PopulateMultichainWallet(config.Chains[0], "channel", "port", wallet, "subscription")
+	dataFetcher.PopulateMultichainWallet(context.Background(), config.Chains[0], "channel", "port", wallet, "subscription")
 
 	require.Equal(t, "another link address", wallet.Href)
 	require.Equal(t, "alias", wallet.Title)
@@ -1,42 +1,80 @@
 package data_fetcher
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
+	"main/pkg/types/query_info"
 	"main/pkg/types/responses"
+	"main/pkg/utils"
+	"sync"
 )
 
-func (f *DataFetcher) GetValidator(chain *configTypes.Chain, address string) (*responses.Validator, bool) {
-	keyName := chain.Name + "_validator_" + address
+func (f *DataFetcher) GetValidator(ctx context.Context, chain *configTypes.Chain, address string) (*responses.Validator, bool) {
+	if cachedValidator, cachedValidatorPresent := f.Cache.GetValidator(chain.Name, address); cachedValidatorPresent {
+		return cachedValidator, true
+	}
+
+	key := "validator_" + chain.Name + "_" + address
+
+	return coalesce(ctx, f, key, query_info.QueryTypeValidator, func(ctx context.Context) (*responses.Validator, bool) {
+		for _, node := range f.GetApiClients(chain.Name) {
+			notCachedValidator, err := node.GetValidator(ctx, address)
+			if err != nil {
+				f.Logger.Error().Msg("Error fetching validator")
+				if ctx.Err() != nil {
+					break
+				}
 
-	if cachedValidator, cachedValidatorPresent := f.Cache.Get(keyName); cachedValidatorPresent {
-		if cachedValidatorParsed, ok := cachedValidator.(*responses.Validator); ok {
-			return cachedValidatorParsed, true
+				continue
+			}
+
+			f.Cache.SetValidator(chain.Name, address, notCachedValidator)
+			return notCachedValidator, true
 		}
 
-		f.Logger.Error().Msg("Could not convert cached validator to *responses.Validator")
+		f.Logger.Error().Msg("Could not connect to any nodes to get a validator")
 		return nil, false
-	}
+	})
+}
+
+// PrefetchValidators fetches all the validators that are not cached yet in parallel,
+// but not more than constants.ValidatorsPrefetchConcurrency at once, so they are taken
+// from cache when messages referencing them are enriched.
+func (f *DataFetcher) PrefetchValidators(ctx context.Context, chain *configTypes.Chain, addresses []string) {
+	var wg sync.WaitGroup
+
+	semaphore := make(chan struct{}, constants.ValidatorsPrefetchConcurrency)
 
-	for _, node := range f.TendermintApiClients[chain.Name] {
-		notCachedValidator, err := node.GetValidator(address)
-		if err != nil {
-			f.Logger.Error().Msg("Error fetching validator")
+	for _, address := range utils.Dedup(addresses) {
+		if _, cached := f.Cache.GetValidator(chain.Name, address); cached {
 			continue
 		}
 
-		f.Cache.Set(keyName, notCachedValidator)
-		return notCachedValidator, true
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			f.GetValidator(ctx, chain, address)
+		}(address)
 	}
 
-	f.Logger.Error().Msg("Could not connect to any nodes to get a validator")
-	return nil, false
+	wg.Wait()
 }
 
 func (f *DataFetcher) PopulateValidator(
+	ctx context.Context,
 	chain *configTypes.Chain,
 	validatorLink *configTypes.Link,
 ) {
-	validator, found := f.GetValidator(chain, validatorLink.Value)
+	validator, found := f.GetValidator(ctx, chain, validatorLink.Value)
 	if found {
 		validatorLink.Title = validator.Description.Moniker
 	}
@@ -1,17 +1,23 @@
 package data_fetcher
 
 import (
+	"context"
 	"main/assets"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	"main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
 	"main/pkg/types/responses"
+	"net/http"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,11 +37,11 @@ func TestDataFetcherFetchValidatorCachedOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_validator_address", &responses.Validator{
+	dataFetcher.Cache.SetValidator("chain", "address", &responses.Validator{
 		OperatorAddress: "test",
 	})
 
-	data, fetched := dataFetcher.GetValidator(config.Chains[0], "address")
+	data, fetched := dataFetcher.GetValidator(context.Background(), config.Chains[0], "address")
 	require.True(t, fetched)
 	require.NotNil(t, data)
 	require.Equal(t, "test", data.OperatorAddress)
@@ -57,9 +63,9 @@ func TestDataFetcherFetchValidatorCachedNotOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_validator_address", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeValidator, "chain_address", nil)
 
-	data, fetched := dataFetcher.GetValidator(config.Chains[0], "address")
+	data, fetched := dataFetcher.GetValidator(context.Background(), config.Chains[0], "address")
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
@@ -82,7 +88,7 @@ func TestDataFetcherFetchValidatorAllQueriesFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetValidator(config.Chains[0], "address")
+	data, fetched := dataFetcher.GetValidator(context.Background(), config.Chains[0], "address")
 	require.False(t, fetched)
 	require.Nil(t, data)
 }
@@ -111,7 +117,7 @@ func TestDataFetcherFetchValidatorSuccessfullyFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	data, fetched := dataFetcher.GetValidator(config.Chains[0], "address")
+	data, fetched := dataFetcher.GetValidator(context.Background(), config.Chains[0], "address")
 	require.True(t, fetched)
 	require.NotNil(t, data)
 	require.Equal(t, "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e", data.OperatorAddress)
@@ -137,7 +143,7 @@ func TestDataFetcherPopulateValidatorNotPresent(t *testing.T) {
 
 	validator := &types.Link{Value: "address"}
 
-	dataFetcher.PopulateValidator(config.Chains[0], validator)
+	dataFetcher.PopulateValidator(context.Background(), config.Chains[0], validator)
 	require.Empty(t, validator.Title)
 }
 
@@ -158,7 +164,7 @@ func TestDataFetcherPopulateValidatorPresent(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_validator_address", &responses.Validator{
+	dataFetcher.Cache.SetValidator("chain", "address", &responses.Validator{
 		Description: responses.ValidatorDescription{
 			Moniker: "🐹 Quokka Stake",
 		},
@@ -166,6 +172,143 @@ func TestDataFetcherPopulateValidatorPresent(t *testing.T) {
 
 	validator := &types.Link{Value: "address"}
 
-	dataFetcher.PopulateValidator(config.Chains[0], validator)
+	dataFetcher.PopulateValidator(context.Background(), config.Chains[0], validator)
 	require.Equal(t, "🐹 Quokka Stake", validator.Title)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestDataFetcherPrefetchValidators(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators/address",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validator.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators/address2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validator.json")),
+	)
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{Name: "chain", APINodes: []string{"https://example.com"}},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	// each validator is fetched once, and then taken from cache
+	dataFetcher.PrefetchValidators(context.Background(), config.Chains[0], []string{"address", "address2", "address"})
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	_, cached := dataFetcher.Cache.GetValidator("chain", "address")
+	require.True(t, cached)
+
+	dataFetcher.PrefetchValidators(context.Background(), config.Chains[0], []string{"address", "address2"})
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestDataFetcherGetValidatorCoalesced(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	release := make(chan struct{})
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators/address",
+		func(request *http.Request) (*http.Response, error) {
+			<-release
+			return httpmock.NewBytesResponse(200, assets.GetBytesOrPanic("validator.json")), nil
+		},
+	)
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{Name: "chain", APINodes: []string{"https://example.com"}},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			validator, found := dataFetcher.GetValidator(context.Background(), config.Chains[0], "address")
+			assert.True(t, found)
+			assert.NotNil(t, validator)
+		}()
+	}
+
+	// letting all the goroutines wait for the same query before it's done
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestDataFetcherGetValidatorCoalescedCallerCancelled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	release := make(chan struct{})
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators/address",
+		func(request *http.Request) (*http.Response, error) {
+			<-release
+			return httpmock.NewBytesResponse(200, assets.GetBytesOrPanic("validator.json")), nil
+		},
+	)
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{Name: "chain", APINodes: []string{"https://example.com"}},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	filesystem := &fs.MockFs{}
+	logger := loggerPkg.GetNopLogger()
+	aliasManager := aliasManagerPkg.NewAliasManager(logger, config, filesystem)
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	dataFetcher := NewDataFetcher(logger, config, aliasManager, metricsManager)
+
+	waiterDone := make(chan bool)
+	go func() {
+		_, found := dataFetcher.GetValidator(context.Background(), config.Chains[0], "address")
+		waiterDone <- found
+	}()
+
+	// a caller whose context is done stops waiting for the shared query, which is still done for the other one
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, found := dataFetcher.GetValidator(ctx, config.Chains[0], "address")
+	require.False(t, found)
+
+	close(release)
+	require.True(t, <-waiterDone)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,132 @@
+package filter
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AddressList is a named set of values that filters can reference as "@name",
+// like "transfer.recipient IN @exchanges". Its values can be replaced at runtime
+// (for example, when the file it is loaded from changes), and all filters
+// referencing it would use the new values without reparsing.
+type AddressList struct {
+	Name string
+
+	mutex  sync.RWMutex
+	values map[string]bool
+}
+
+type AddressLists map[string]*AddressList
+
+func NewAddressList(name string, values []string) *AddressList {
+	list := &AddressList{Name: name}
+	list.Set(values)
+	return list
+}
+
+func (l *AddressList) Set(values []string) {
+	valuesMap := make(map[string]bool, len(values))
+	for _, value := range values {
+		valuesMap[value] = true
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.values = valuesMap
+}
+
+func (l *AddressList) Contains(value string) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.values[value]
+}
+
+func (l *AddressList) Len() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return len(l.values)
+}
+
+// ListCondition matches if any value of the tag is in the address list.
+// The list is resolved by name after parsing, see Expression.BindAddressLists.
+type ListCondition struct {
+	Tag  string
+	Name string
+	List *AddressList
+}
+
+func (c *ListCondition) Matches(events map[string][]string) (bool, error) {
+	if c.List == nil {
+		return false, fmt.Errorf("address list @%s is not loaded", c.Name)
+	}
+
+	for _, value := range events[c.Tag] {
+		if c.List.Contains(value) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func walkNodes(node Node, callback func(node Node) error) error {
+	if err := callback(node); err != nil {
+		return err
+	}
+
+	switch typedNode := node.(type) {
+	case *AndNode:
+		if err := walkNodes(typedNode.Left, callback); err != nil {
+			return err
+		}
+
+		return walkNodes(typedNode.Right, callback)
+	case *OrNode:
+		if err := walkNodes(typedNode.Left, callback); err != nil {
+			return err
+		}
+
+		return walkNodes(typedNode.Right, callback)
+	case *NotNode:
+		return walkNodes(typedNode.Inner, callback)
+	default:
+		return nil
+	}
+}
+
+// GetAddressListNames returns the names of all address lists the expression references.
+func (e Expression) GetAddressListNames() []string {
+	names := make([]string, 0)
+
+	_ = walkNodes(e.Root, func(node Node) error {
+		if condition, ok := node.(*ListCondition); ok {
+			names = append(names, condition.Name)
+		}
+
+		return nil
+	})
+
+	return names
+}
+
+// BindAddressLists resolves all address lists the expression references,
+// returning an error if any of them is not in the given lists.
+func (e Expression) BindAddressLists(lists AddressLists) error {
+	return walkNodes(e.Root, func(node Node) error {
+		condition, ok := node.(*ListCondition)
+		if !ok {
+			return nil
+		}
+
+		list, found := lists[condition.Name]
+		if !found {
+			return fmt.Errorf("address list @%s is not defined", condition.Name)
+		}
+
+		condition.List = list
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package filter_test
+
+import (
+	"main/pkg/filter"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressListSet(t *testing.T) {
+	t.Parallel()
+
+	list := filter.NewAddressList("list", []string{"first", "second"})
+	require.Equal(t, 2, list.Len())
+	require.True(t, list.Contains("first"))
+
+	list.Set([]string{"third"})
+	require.Equal(t, 1, list.Len())
+	require.False(t, list.Contains("first"))
+	require.True(t, list.Contains("third"))
+}
+
+func TestExpressionAddressLists(t *testing.T) {
+	t.Parallel()
+
+	expression := filter.MustParse("transfer.sender IN @exchanges OR NOT (transfer.recipient NOT IN @treasury)")
+	require.Equal(t, []string{"exchanges", "treasury"}, expression.GetAddressListNames())
+
+	events := map[string][]string{"transfer.sender": {"cosmos1exchange"}}
+
+	_, err := expression.Matches(events)
+	require.Error(t, err)
+
+	require.Error(t, expression.BindAddressLists(filter.AddressLists{
+		"exchanges": filter.NewAddressList("exchanges", []string{}),
+	}))
+
+	exchanges := filter.NewAddressList("exchanges", []string{"cosmos1exchange"})
+	require.NoError(t, expression.BindAddressLists(filter.AddressLists{
+		"exchanges": exchanges,
+		"treasury":  filter.NewAddressList("treasury", []string{"cosmos1treasury"}),
+	}))
+
+	matches, err := expression.Matches(events)
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	matches, err = expression.Matches(map[string][]string{"transfer.recipient": {"cosmos1treasury"}})
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	exchanges.Set([]string{})
+	matches, err = expression.Matches(events)
+	require.NoError(t, err)
+	require.False(t, matches)
+}
+
+func TestParseAddressListEmptyName(t *testing.T) {
+	t.Parallel()
+
+	_, err := filter.Parse("transfer.sender IN @")
+	require.Error(t, err)
+}
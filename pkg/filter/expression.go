@@ -0,0 +1,179 @@
+package filter
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+)
+
+type Node interface {
+	Matches(events map[string][]string) (bool, error)
+}
+
+type AndNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *AndNode) Matches(events map[string][]string) (bool, error) {
+	if matches, err := n.Left.Matches(events); err != nil || !matches {
+		return false, err
+	}
+
+	return n.Right.Matches(events)
+}
+
+type OrNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *OrNode) Matches(events map[string][]string) (bool, error) {
+	if matches, err := n.Left.Matches(events); err != nil || matches {
+		return matches, err
+	}
+
+	return n.Right.Matches(events)
+}
+
+type NotNode struct {
+	Inner Node
+}
+
+func (n *NotNode) Matches(events map[string][]string) (bool, error) {
+	matches, err := n.Inner.Matches(events)
+	if err != nil {
+		return false, err
+	}
+
+	return !matches, nil
+}
+
+// QueryCondition is a single condition written in the Tendermint query syntax,
+// like "message.sender = 'address'" or "tx.height > 100", which is matched
+// by Tendermint itself, so the existing filters behave exactly like before.
+type QueryCondition struct {
+	Query *query.Query
+}
+
+func (c *QueryCondition) Matches(events map[string][]string) (bool, error) {
+	return c.Query.Matches(events)
+}
+
+// InCondition matches if any value of the tag is equal to any of the values.
+type InCondition struct {
+	Tag    string
+	Values []string
+}
+
+func (c *InCondition) Matches(events map[string][]string) (bool, error) {
+	for _, value := range events[c.Tag] {
+		for _, expected := range c.Values {
+			if value == expected {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// RegexCondition matches if any value of the tag matches the regular expression.
+type RegexCondition struct {
+	Tag   string
+	Regex *regexp.Regexp
+}
+
+func (c *RegexCondition) Matches(events map[string][]string) (bool, error) {
+	for _, value := range events[c.Tag] {
+		if c.Regex.MatchString(value) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ParseCoin splits a coin like "100uatom" into its amount and denom.
+func ParseCoin(value string) (*big.Float, string, bool) {
+	denomStart := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if denomStart <= 0 {
+		return nil, "", false
+	}
+
+	denom := value[denomStart:]
+	if first := denom[0]; (first < 'a' || first > 'z') && (first < 'A' || first > 'Z') {
+		return nil, "", false
+	}
+
+	amount, ok := new(big.Float).SetString(value[:denomStart])
+	if !ok {
+		return nil, "", false
+	}
+
+	return amount, denom, true
+}
+
+// AmountCondition compares coins in the tag values, like "100uatom,5uosmo"
+// in "transfer.amount", with an amount of a specific denom, like "1000000uatom".
+// It matches if any coin of this denom satisfies the comparison,
+// coins of other denoms and values that are not coins are ignored.
+type AmountCondition struct {
+	Tag      string
+	Operator string
+	Amount   *big.Float
+	Denom    string
+}
+
+func (c *AmountCondition) Matches(events map[string][]string) (bool, error) {
+	for _, value := range events[c.Tag] {
+		for _, coin := range strings.Split(value, ",") {
+			coinAmount, denom, ok := ParseCoin(strings.TrimSpace(coin))
+			if !ok || denom != c.Denom {
+				continue
+			}
+
+			if c.compare(coinAmount.Cmp(c.Amount)) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (c *AmountCondition) compare(result int) bool {
+	switch c.Operator {
+	case "=":
+		return result == 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	default:
+		return false
+	}
+}
+
+// Expression is a parsed filter. It keeps the source it was parsed from,
+// so it can be written back to config as is.
+type Expression struct {
+	Source string
+	Root   Node
+}
+
+func (e Expression) String() string {
+	return e.Source
+}
+
+func (e Expression) Matches(events map[string][]string) (bool, error) {
+	return e.Root.Matches(events)
+}
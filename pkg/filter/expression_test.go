@@ -0,0 +1,92 @@
+package filter_test
+
+import (
+	"main/pkg/filter"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpressionMatches(t *testing.T) {
+	t.Parallel()
+
+	events := map[string][]string{
+		"message.action":   {"/cosmos.bank.v1beta1.MsgSend"},
+		"transfer.sender":  {"cosmos1sender"},
+		"transfer.amount":  {"100uatom,5000000uosmo", "2000000uatom"},
+		"tx.height":        {"150"},
+		"tx.memo":          {"tag:airdrop"},
+		"tx.signers_count": {"1"},
+	}
+
+	testCases := map[string]bool{
+		"transfer.sender = 'cosmos1sender'":                                 true,
+		"transfer.sender = 'cosmos1other'":                                  false,
+		"transfer.sender != 'cosmos1other'":                                 true,
+		"transfer.sender != 'cosmos1sender'":                                false,
+		"tx.height > 100 AND tx.height < 200":                               true,
+		"tx.height > 100 AND tx.height < 120":                               false,
+		"transfer.sender = 'cosmos1other' OR tx.height = 150":               true,
+		"NOT transfer.sender = 'cosmos1sender'":                             false,
+		"NOT (transfer.sender = 'cosmos1other' OR tx.height = 100)":         true,
+		"tx.height = 100 OR tx.height = 150 AND tx.signers_count = 1":       true,
+		"(tx.height = 100 OR tx.height = 150) AND tx.signers_count = 2":     false,
+		"transfer.sender IN ('cosmos1other', 'cosmos1sender')":              true,
+		"transfer.sender IN ('cosmos1other')":                               false,
+		"transfer.sender NOT IN ('cosmos1other')":                           true,
+		"tx.height IN (100, 150)":                                           true,
+		"tx.memo MATCHES '/^tag:.*$/'":                                      true,
+		"tx.memo MATCHES 'drop$'":                                           true,
+		"tx.memo MATCHES '^drop'":                                           false,
+		"tx.memo CONTAINS 'air'":                                            true,
+		"tx.memo EXISTS":                                                    true,
+		"tx.fee EXISTS":                                                     false,
+		"transfer.amount >= 1000000uatom":                                   true,
+		"transfer.amount > 2000000uatom":                                    false,
+		"transfer.amount = 5000000uosmo":                                    true,
+		"transfer.amount < 100uatom":                                        false,
+		"transfer.amount <= 100uatom":                                       true,
+		"transfer.amount > 1ujuno":                                          false,
+		"message.action = '/cosmos.bank.v1beta1.MsgSend' AND tx.height > 1": true,
+	}
+
+	for source, expected := range testCases {
+		matches, err := filter.MustParse(source).Matches(events)
+		require.NoError(t, err, source)
+		require.Equal(t, expected, matches, source)
+	}
+}
+
+func TestExpressionMatchesError(t *testing.T) {
+	t.Parallel()
+
+	matches, err := filter.MustParse("tx.memo > 100").Matches(map[string][]string{
+		"tx.memo": {"not a number"},
+	})
+	require.Error(t, err)
+	require.False(t, matches)
+
+	matches, err = filter.MustParse("NOT tx.memo > 100").Matches(map[string][]string{
+		"tx.memo": {"not a number"},
+	})
+	require.Error(t, err)
+	require.False(t, matches)
+}
+
+func TestParseCoin(t *testing.T) {
+	t.Parallel()
+
+	amount, denom, ok := filter.ParseCoin("100.5uatom")
+	require.True(t, ok)
+	require.Equal(t, "uatom", denom)
+	require.Equal(t, "100.5", amount.String())
+
+	_, _, ok = filter.ParseCoin("100")
+	require.False(t, ok)
+
+	_, _, ok = filter.ParseCoin("uatom")
+	require.False(t, ok)
+
+	_, _, ok = filter.ParseCoin("100/uatom")
+	require.False(t, ok)
+}
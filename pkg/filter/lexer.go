@@ -0,0 +1,119 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type TokenType int
+
+const (
+	TokenWord TokenType = iota
+	TokenString
+	TokenOperator
+	TokenLeftParen
+	TokenRightParen
+	TokenComma
+	TokenEOF
+)
+
+type Token struct {
+	Type     TokenType
+	Value    string
+	Position int
+}
+
+// IsKeyword returns true if the token is a bare word equal to the given keyword.
+// Keywords are case-sensitive, same as in Tendermint queries.
+func (t Token) IsKeyword(keyword string) bool {
+	return t.Type == TokenWord && t.Value == keyword
+}
+
+func (t Token) String() string {
+	if t.Type == TokenEOF {
+		return "end of input"
+	}
+
+	if t.Type == TokenString {
+		return "'" + t.Value + "'"
+	}
+
+	return t.Value
+}
+
+func isWordChar(r rune) bool {
+	if unicode.IsSpace(r) {
+		return false
+	}
+
+	return !strings.ContainsRune("()'\",=<>!", r)
+}
+
+func Tokenize(input string) ([]Token, error) {
+	tokens := make([]Token, 0)
+	runes := []rune(input)
+
+	for position := 0; position < len(runes); {
+		r := runes[position]
+
+		switch {
+		case unicode.IsSpace(r):
+			position++
+		case r == '(':
+			tokens = append(tokens, Token{Type: TokenLeftParen, Value: "(", Position: position})
+			position++
+		case r == ')':
+			tokens = append(tokens, Token{Type: TokenRightParen, Value: ")", Position: position})
+			position++
+		case r == ',':
+			tokens = append(tokens, Token{Type: TokenComma, Value: ",", Position: position})
+			position++
+		case r == '\'':
+			end := position + 1
+			for end < len(runes) && runes[end] != '\'' {
+				end++
+			}
+
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", position)
+			}
+
+			tokens = append(tokens, Token{
+				Type:     TokenString,
+				Value:    string(runes[position+1 : end]),
+				Position: position,
+			})
+			position = end + 1
+		case r == '<' || r == '>' || r == '!' || r == '=':
+			operator := string(r)
+			if position+1 < len(runes) && runes[position+1] == '=' && r != '=' {
+				operator += "="
+			}
+
+			if operator == "!" {
+				return nil, fmt.Errorf("unexpected '!' at position %d", position)
+			}
+
+			tokens = append(tokens, Token{Type: TokenOperator, Value: operator, Position: position})
+			position += len(operator)
+		case r == '"':
+			return nil, fmt.Errorf("unexpected '\"' at position %d, use single quotes for strings", position)
+		default:
+			end := position
+			for end < len(runes) && isWordChar(runes[end]) {
+				end++
+			}
+
+			tokens = append(tokens, Token{
+				Type:     TokenWord,
+				Value:    string(runes[position:end]),
+				Position: position,
+			})
+			position = end
+		}
+	}
+
+	tokens = append(tokens, Token{Type: TokenEOF, Position: len(runes)})
+	return tokens, nil
+}
@@ -0,0 +1,310 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+)
+
+func isKeyword(value string) bool {
+	switch value {
+	case "AND", "OR", "NOT", "IN", "MATCHES", "CONTAINS", "EXISTS", "DATE", "TIME":
+		return true
+	default:
+		return false
+	}
+}
+
+// Parser builds an expression out of the following grammar:
+//
+//	expression := and ("OR" and)*
+//	and        := unary ("AND" unary)*
+//	unary      := "NOT" unary | "(" expression ")" | condition
+//	condition  := tag "EXISTS"
+//	            | tag "CONTAINS" string
+//	            | tag "MATCHES" string
+//	            | tag ["NOT"] "IN" "(" literal ("," literal)* ")"
+//	            | tag ["NOT"] "IN" "@" list
+//	            | tag ("=" | "!=" | "<" | "<=" | ">" | ">=") operand
+//
+// AND takes precedence over OR, so any Tendermint query is a valid expression.
+type Parser struct {
+	tokens   []Token
+	position int
+}
+
+func Parse(source string) (*Expression, error) {
+	tokens, err := Tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &Parser{tokens: tokens}
+
+	root, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := parser.peek(); token.Type != TokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", token, token.Position)
+	}
+
+	return &Expression{Source: source, Root: root}, nil
+}
+
+func MustParse(source string) *Expression {
+	expression, err := Parse(source)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse %s: %v", source, err))
+	}
+
+	return expression
+}
+
+func (p *Parser) peek() Token {
+	return p.tokens[p.position]
+}
+
+func (p *Parser) next() Token {
+	token := p.tokens[p.position]
+	if token.Type != TokenEOF {
+		p.position++
+	}
+
+	return token
+}
+
+func (p *Parser) expect(tokenType TokenType, description string) (Token, error) {
+	token := p.next()
+	if token.Type != tokenType {
+		return token, fmt.Errorf("expected %s at position %d, got %s", description, token.Position, token)
+	}
+
+	return token, nil
+}
+
+func (p *Parser) parseOr() (Node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().IsKeyword("OR") {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &OrNode{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *Parser) parseAnd() (Node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().IsKeyword("AND") {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &AndNode{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *Parser) parseUnary() (Node, error) {
+	token := p.peek()
+
+	if token.IsKeyword("NOT") {
+		p.next()
+
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &NotNode{Inner: inner}, nil
+	}
+
+	if token.Type == TokenLeftParen {
+		p.next()
+
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := p.expect(TokenRightParen, "')'"); err != nil {
+			return nil, err
+		}
+
+		return inner, nil
+	}
+
+	return p.parseCondition()
+}
+
+func (p *Parser) parseCondition() (Node, error) {
+	tag := p.next()
+	if tag.Type != TokenWord || isKeyword(tag.Value) {
+		return nil, fmt.Errorf("expected tag at position %d, got %s", tag.Position, tag)
+	}
+
+	token := p.next()
+
+	switch {
+	case token.IsKeyword("EXISTS"):
+		return newQueryCondition(tag.Value + " EXISTS")
+	case token.IsKeyword("CONTAINS"):
+		value, err := p.expect(TokenString, "string")
+		if err != nil {
+			return nil, err
+		}
+
+		return newQueryCondition(fmt.Sprintf("%s CONTAINS '%s'", tag.Value, value.Value))
+	case token.IsKeyword("MATCHES"):
+		return p.parseRegex(tag.Value)
+	case token.IsKeyword("IN"):
+		return p.parseIn(tag.Value)
+	case token.IsKeyword("NOT"):
+		if next := p.next(); !next.IsKeyword("IN") {
+			return nil, fmt.Errorf("expected IN at position %d, got %s", next.Position, next)
+		}
+
+		condition, err := p.parseIn(tag.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &NotNode{Inner: condition}, nil
+	case token.Type == TokenOperator:
+		return p.parseComparison(tag.Value, token.Value)
+	default:
+		return nil, fmt.Errorf("expected operator at position %d, got %s", token.Position, token)
+	}
+}
+
+func (p *Parser) parseRegex(tag string) (Node, error) {
+	value, err := p.expect(TokenString, "regular expression")
+	if err != nil {
+		return nil, err
+	}
+
+	// both 'regex' and '/regex/' are allowed
+	pattern := value.Value
+	if len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		pattern = pattern[1 : len(pattern)-1]
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression at position %d: %s", value.Position, err)
+	}
+
+	return &RegexCondition{Tag: tag, Regex: regex}, nil
+}
+
+func (p *Parser) parseIn(tag string) (Node, error) {
+	if token := p.peek(); token.Type == TokenWord && strings.HasPrefix(token.Value, "@") {
+		p.next()
+
+		name := strings.TrimPrefix(token.Value, "@")
+		if name == "" {
+			return nil, fmt.Errorf("empty address list name at position %d", token.Position)
+		}
+
+		return &ListCondition{Tag: tag, Name: name}, nil
+	}
+
+	if _, err := p.expect(TokenLeftParen, "'(' or address list"); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0)
+
+	for {
+		value := p.next()
+		if value.Type != TokenString && value.Type != TokenWord {
+			return nil, fmt.Errorf("expected value at position %d, got %s", value.Position, value)
+		}
+
+		values = append(values, value.Value)
+
+		separator := p.next()
+		if separator.Type == TokenRightParen {
+			break
+		}
+
+		if separator.Type != TokenComma {
+			return nil, fmt.Errorf("expected ',' or ')' at position %d, got %s", separator.Position, separator)
+		}
+	}
+
+	return &InCondition{Tag: tag, Values: values}, nil
+}
+
+func (p *Parser) parseComparison(tag string, operator string) (Node, error) {
+	// "a != b" is the same as "NOT a = b", so it matches if there's no such tag
+	if operator == "!=" {
+		condition, err := p.parseComparison(tag, "=")
+		if err != nil {
+			return nil, err
+		}
+
+		return &NotNode{Inner: condition}, nil
+	}
+
+	operand := p.next()
+
+	switch {
+	case operand.Type == TokenString:
+		if operator != "=" {
+			return nil, fmt.Errorf("cannot compare string at position %d with %s", operand.Position, operator)
+		}
+
+		return newQueryCondition(fmt.Sprintf("%s = '%s'", tag, operand.Value))
+	case operand.IsKeyword("DATE") || operand.IsKeyword("TIME"):
+		value, err := p.expect(TokenWord, "date or time")
+		if err != nil {
+			return nil, err
+		}
+
+		return newQueryCondition(fmt.Sprintf("%s %s %s %s", tag, operator, operand.Value, value.Value))
+	case operand.Type == TokenWord:
+		if amount, denom, ok := ParseCoin(operand.Value); ok {
+			return &AmountCondition{
+				Tag:      tag,
+				Operator: operator,
+				Amount:   amount,
+				Denom:    denom,
+			}, nil
+		}
+
+		return newQueryCondition(fmt.Sprintf("%s %s %s", tag, operator, operand.Value))
+	default:
+		return nil, fmt.Errorf("expected value at position %d, got %s", operand.Position, operand)
+	}
+}
+
+func newQueryCondition(source string) (Node, error) {
+	parsed, err := query.New(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition \"%s\": %s", source, err)
+	}
+
+	return &QueryCondition{Query: parsed}, nil
+}
@@ -0,0 +1,82 @@
+package filter_test
+
+import (
+	"main/pkg/filter"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	invalid := []string{
+		"",
+		"event.key",
+		"event.key = 'value",
+		"event.key = \"value\"",
+		"event.key ! 'value'",
+		"event.key = 'value' AND",
+		"event.key = 'value' OR OR event.key = 'value'",
+		"(event.key = 'value'",
+		"event.key = 'value')",
+		"event.key > 'value'",
+		"event.key IN 'value'",
+		"event.key IN ('value'",
+		"event.key IN ('value' 'value2')",
+		"event.key NOT 'value'",
+		"event.key MATCHES '['",
+		"event.key MATCHES value",
+		"event.key CONTAINS value",
+		"event.key = DATE wrong",
+		"AND = 'value'",
+		"event.key = =",
+	}
+
+	for _, source := range invalid {
+		_, err := filter.Parse(source)
+		require.Error(t, err, source)
+	}
+}
+
+func TestParseValid(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		"event.key = 'value'",
+		"event.key = 'value' AND event.key2 = 'value2'",
+		"tx.height > 100 AND tx.height <= 200",
+		"tx.fee = 5000uatom",
+		"event.key EXISTS",
+		"event.key CONTAINS 'val'",
+		"block.time >= TIME 2013-05-03T14:45:00Z",
+		"block.date < DATE 2013-05-03",
+		"event.key != 'value'",
+		"NOT event.key = 'value'",
+		"NOT (event.key = 'value' OR event.key = 'value2')",
+		"event.key IN ('value', 'value2', 3)",
+		"event.key NOT IN ('value')",
+		"event.key MATCHES '/^val.*$/'",
+		"event.key MATCHES '^val'",
+		"transfer.amount >= 1000000uatom",
+		"transfer.amount < 10ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+	}
+
+	for _, source := range valid {
+		expression, err := filter.Parse(source)
+		require.NoError(t, err, source)
+		require.Equal(t, source, expression.String())
+	}
+}
+
+func TestMustParsePanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	filter.MustParse("invalid")
+}
@@ -8,7 +8,10 @@ import (
 	messagesPkg "main/pkg/messages"
 	metricsPkg "main/pkg/metrics"
 	"main/pkg/types"
+	"main/pkg/types/amount"
+	"math/big"
 	"strconv"
+	"sync"
 
 	"github.com/rs/zerolog"
 )
@@ -18,6 +21,7 @@ type Filterer struct {
 	MetricsManager   *metricsPkg.Manager
 	Config           *configPkg.AppConfig
 	lastBlockHeights map[string]int64
+	mutex            sync.RWMutex
 }
 
 func NewFilterer(
@@ -100,7 +104,7 @@ func (f *Filterer) FilterForChainAndSubscription(
 		return reportable
 	}
 
-	if _, ok := reportable.(*types.NodeConnectError); ok {
+	if isNodeError(reportable) {
 		if !chainSubscription.LogNodeErrors {
 			f.MetricsManager.LogFilteredEvent(
 				chainSubscription.Chain,
@@ -142,8 +146,10 @@ func (f *Filterer) FilterForChainAndSubscription(
 		f.Logger.Panic().Err(err).Msg("Error converting height to int64")
 	}
 
-	chainLastBlockHeight, ok := f.lastBlockHeights[chain.Name]
-	if ok && chainLastBlockHeight > txHeight {
+	// Backfilled transactions are older than the ones received after reconnecting,
+	// so they should not be skipped.
+	chainLastBlockHeight, ok := f.GetLastBlockHeight(chain.Name)
+	if ok && chainLastBlockHeight > txHeight && !tx.Backfilled {
 		f.Logger.Debug().
 			Str("chain", chainSubscription.Chain).
 			Str("hash", tx.GetHash()).
@@ -153,8 +159,15 @@ func (f *Filterer) FilterForChainAndSubscription(
 		return nil
 	}
 
-	if !ok || chainLastBlockHeight < txHeight {
-		f.lastBlockHeights[chain.Name] = txHeight
+	f.SetLastBlockHeight(chain.Name, txHeight)
+
+	if !f.FilterTx(tx, chainSubscription) {
+		f.MetricsManager.LogFilteredEvent(
+			chainSubscription.Chain,
+			reportable.Type(),
+			constants.EventFilterReasonTxFiltersNotMatched,
+		)
+		return nil
 	}
 
 	messages := make([]types.Message, 0)
@@ -182,6 +195,58 @@ func (f *Filterer) FilterForChainAndSubscription(
 	return tx
 }
 
+func (f *Filterer) GetLastBlockHeight(chain string) (int64, bool) {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	height, ok := f.lastBlockHeights[chain]
+	return height, ok
+}
+
+// SetLastBlockHeight stores the height of the last transaction processed on a chain,
+// if it is greater than the one stored.
+func (f *Filterer) SetLastBlockHeight(chain string, height int64) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if lastHeight, ok := f.lastBlockHeights[chain]; !ok || lastHeight < height {
+		f.lastBlockHeights[chain] = height
+	}
+}
+
+func (f *Filterer) FilterTx(
+	tx *types.Tx,
+	chainSubscription *configTypes.ChainSubscription,
+) bool {
+	values := tx.GetValues().ToMap()
+	matches, err := chainSubscription.TxFilters.MatchesMap(values)
+
+	if trace := f.Logger.Trace(); trace.Enabled() {
+		trace.
+			Str("hash", tx.GetHash()).
+			Str("values", fmt.Sprintf("%+v", values)).
+			Str("filters", fmt.Sprintf("%+v", chainSubscription.TxFilters)).
+			Bool("matches", matches).
+			Msg("Result of matching transaction events against tx filters")
+	}
+
+	if err != nil {
+		f.Logger.Error().
+			Err(err).
+			Str("hash", tx.GetHash()).
+			Msg("Error checking if transaction matches tx filters")
+		return true
+	}
+
+	if !matches {
+		f.Logger.Debug().
+			Str("hash", tx.GetHash()).
+			Msg("Transaction is ignored by tx filters.")
+	}
+
+	return matches
+}
+
 func (f *Filterer) FilterMessage(
 	message types.Message,
 	chainSubscription *configTypes.ChainSubscription,
@@ -213,14 +278,17 @@ func (f *Filterer) FilterMessage(
 	// internal -> filter only if subscription.FilterInternalMessages is true
 	// !internal -> filter regardless
 	if !internal || chainSubscription.FilterInternalMessages {
-		matches, err := chainSubscription.Filters.Matches(message.GetValues())
+		values := message.GetValues().ToMap()
+		matches, err := chainSubscription.Filters.MatchesMap(values)
 
-		f.Logger.Trace().
-			Str("type", message.Type()).
-			Str("values", fmt.Sprintf("%+v", message.GetValues().ToMap())).
-			Str("filters", fmt.Sprintf("%+v", chainSubscription.Filters)).
-			Bool("matches", matches).
-			Msg("Result of matching message events against filters")
+		if trace := f.Logger.Trace(); trace.Enabled() {
+			trace.
+				Str("type", message.Type()).
+				Str("values", fmt.Sprintf("%+v", values)).
+				Str("filters", fmt.Sprintf("%+v", chainSubscription.Filters)).
+				Bool("matches", matches).
+				Msg("Result of matching message events against filters")
+		}
 
 		if err != nil {
 			f.Logger.Error().
@@ -258,3 +326,133 @@ func (f *Filterer) FilterMessage(
 	message.SetParsedMessages(parsedInternalMessages)
 	return message
 }
+
+// FilterByAmounts filters out messages which amounts do not match subscription's amount filters.
+// Unlike other filters, this should be called after the additional data is fetched,
+// as it needs amounts converted to display denoms and USD prices.
+// The tx is shared between reports for all the subscriptions matching it,
+// so it is not modified, and a copy with filtered messages is returned instead.
+func (f *Filterer) FilterByAmounts(report types.Report) types.Reportable {
+	chainSubscription := report.ChainSubscription
+	if !chainSubscription.HasAmountFilters() {
+		return report.Reportable
+	}
+
+	tx, ok := report.Reportable.(*types.Tx)
+	if !ok {
+		return report.Reportable
+	}
+
+	messages := make([]types.Message, 0)
+
+	for _, message := range tx.Messages {
+		if filteredMessage := f.FilterMessageByAmounts(message, chainSubscription); filteredMessage != nil {
+			messages = append(messages, filteredMessage)
+		}
+	}
+
+	if len(messages) == 0 {
+		f.Logger.Debug().
+			Str("hash", tx.GetHash()).
+			Msg("All messages in transaction were filtered out by amount filters, skipping.")
+		f.MetricsManager.LogFilteredEvent(
+			chainSubscription.Chain,
+			tx.Type(),
+			constants.EventFilterReasonAmountFiltersNotMatched,
+		)
+		return nil
+	}
+
+	filteredTx := *tx
+	filteredTx.Messages = messages
+	return &filteredTx
+}
+
+func (f *Filterer) FilterMessageByAmounts(
+	message types.Message,
+	chainSubscription *configTypes.ChainSubscription,
+) types.Message {
+	// Messages with messages inside (such as MsgExec) are matched by their internal messages.
+	if len(message.GetParsedMessages()) > 0 {
+		parsedInternalMessages := make([]types.Message, 0)
+
+		for _, internalMessage := range message.GetParsedMessages() {
+			if internalMessageParsed := f.FilterMessageByAmounts(internalMessage, chainSubscription); internalMessageParsed != nil {
+				parsedInternalMessages = append(parsedInternalMessages, internalMessageParsed)
+			}
+		}
+
+		if len(parsedInternalMessages) == 0 {
+			return nil
+		}
+
+		return message.WithParsedMessages(parsedInternalMessages)
+	}
+
+	if !AmountsMatch(message.GetAmounts(), chainSubscription) {
+		f.Logger.Debug().
+			Str("type", message.Type()).
+			Str("amounts", message.GetAmounts().String()).
+			Msg("Message is ignored by amount filters.")
+		return nil
+	}
+
+	return message
+}
+
+// AmountsMatch checks whether amounts match all the amount filters set in chain subscription.
+// USD value is the sum of all amounts' USD values, and if none of them has a price, it does not match.
+// Per-denom min amount matches if at least one of the amounts in the listed denoms is big enough.
+func AmountsMatch(amounts amount.Amounts, chainSubscription *configTypes.ChainSubscription) bool {
+	if chainSubscription.MinUSDValue != nil || chainSubscription.MaxUSDValue != nil {
+		totalUSDValue := new(big.Float)
+		hasPrice := false
+
+		for _, messageAmount := range amounts {
+			if messageAmount == nil || messageAmount.PriceUSD == nil {
+				continue
+			}
+
+			totalUSDValue.Add(totalUSDValue, messageAmount.PriceUSD)
+			hasPrice = true
+		}
+
+		if !hasPrice {
+			return false
+		}
+
+		if chainSubscription.MinUSDValue != nil && totalUSDValue.Cmp(chainSubscription.MinUSDValue) < 0 {
+			return false
+		}
+
+		if chainSubscription.MaxUSDValue != nil && totalUSDValue.Cmp(chainSubscription.MaxUSDValue) > 0 {
+			return false
+		}
+	}
+
+	if len(chainSubscription.MinAmounts) == 0 {
+		return true
+	}
+
+	for _, messageAmount := range amounts {
+		if messageAmount == nil {
+			continue
+		}
+
+		minAmount, ok := chainSubscription.MinAmounts[messageAmount.Denom.String()]
+		if ok && messageAmount.Value.Cmp(minAmount) >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNodeError(reportable types.Reportable) bool {
+	switch reportable.(type) {
+	case *types.NodeConnectError, *types.NodeStalled:
+		return true
+	default:
+		return false
+	}
+}
@@ -3,15 +3,16 @@ package filterer_test
 import (
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
+	"main/pkg/filter"
 	filtererPkg "main/pkg/filterer"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/messages"
 	"main/pkg/metrics"
 	"main/pkg/types"
 	"main/pkg/types/amount"
+	"math/big"
 	"testing"
 
-	queryPkg "github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -68,7 +69,7 @@ func TestFilterMessageSimpleNotMatching(t *testing.T) {
 	chainSubscription := &configTypes.ChainSubscription{
 		Chain: "chain",
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("transfer.sender = 'from2'"),
+			*filter.MustParse("transfer.sender = 'from2'"),
 		},
 	}
 
@@ -92,7 +93,7 @@ func TestFilterMessageSimpleMatching(t *testing.T) {
 	chainSubscription := &configTypes.ChainSubscription{
 		Chain: "chain",
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("transfer.sender = 'from'"),
+			*filter.MustParse("transfer.sender = 'from'"),
 		},
 	}
 
@@ -123,7 +124,7 @@ func TestFilterMessageSimpleRecursiveMatchingExternalAndIgnoreInternal(t *testin
 		Chain:                  "chain",
 		FilterInternalMessages: false,
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("message.action = '/cosmos.authz.v1beta1.MsgExec'"),
+			*filter.MustParse("message.action = '/cosmos.authz.v1beta1.MsgExec'"),
 		},
 	}
 
@@ -154,7 +155,7 @@ func TestFilterMessageSimpleRecursiveMatchingExternalAndFilterInternal(t *testin
 		Chain:                  "chain",
 		FilterInternalMessages: true,
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("message.action = '/cosmos.authz.v1beta1.MsgExec'"),
+			*filter.MustParse("message.action = '/cosmos.authz.v1beta1.MsgExec'"),
 		},
 	}
 
@@ -185,8 +186,8 @@ func TestFilterMessageSimpleRecursiveMatchingExternalAndInternal(t *testing.T) {
 		Chain:                  "chain",
 		FilterInternalMessages: true,
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("message.action = '/cosmos.authz.v1beta1.MsgExec'"),
-			*queryPkg.MustParse("transfer.sender = 'from'"),
+			*filter.MustParse("message.action = '/cosmos.authz.v1beta1.MsgExec'"),
+			*filter.MustParse("transfer.sender = 'from'"),
 		},
 	}
 
@@ -233,6 +234,26 @@ func TestFilterReportableTxNodeConnectError(t *testing.T) {
 	}))
 }
 
+func TestFilterReportableNodeStalled(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	filterer := filtererPkg.NewFilterer(logger, config, metricsManager)
+	chain := &configTypes.Chain{Name: "chain"}
+
+	reportable := &types.NodeStalled{}
+	require.NotNil(t, filterer.FilterForChainAndSubscription(reportable, chain, &configTypes.ChainSubscription{
+		LogNodeErrors: true,
+		Chain:         "chain",
+	}))
+	require.Nil(t, filterer.FilterForChainAndSubscription(reportable, chain, &configTypes.ChainSubscription{
+		LogNodeErrors: false,
+		Chain:         "chain",
+	}))
+}
+
 func TestFilterReportableNotSupported(t *testing.T) {
 	t.Parallel()
 
@@ -274,14 +295,14 @@ func TestFilterReportableTxFailed(t *testing.T) {
 		LogFailedTransactions: true,
 		Chain:                 "chain",
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("transfer.sender = 'from'"),
+			*filter.MustParse("transfer.sender = 'from'"),
 		},
 	}))
 	require.Nil(t, filterer.FilterForChainAndSubscription(reportable, chain, &configTypes.ChainSubscription{
 		LogFailedTransactions: false,
 		Chain:                 "chain",
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("transfer.sender = 'from'"),
+			*filter.MustParse("transfer.sender = 'from'"),
 		},
 	}))
 }
@@ -298,7 +319,7 @@ func TestFilterReportableTxProcessedBefore(t *testing.T) {
 	subscription := &configTypes.ChainSubscription{
 		Chain: "chain",
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("transfer.sender = 'from'"),
+			*filter.MustParse("transfer.sender = 'from'"),
 		},
 	}
 	reportable := &types.Tx{
@@ -321,6 +342,46 @@ func TestFilterReportableTxProcessedBefore(t *testing.T) {
 	require.Nil(t, filterer.FilterForChainAndSubscription(reportable, chain, subscription))
 }
 
+func TestFilterReportableTxBackfilled(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	filterer := filtererPkg.NewFilterer(logger, config, metricsManager)
+	chain := &configTypes.Chain{Name: "chain"}
+
+	subscription := &configTypes.ChainSubscription{
+		Chain: "chain",
+		Filters: configTypes.Filters{
+			*filter.MustParse("transfer.sender = 'from'"),
+		},
+	}
+	reportable := &types.Tx{
+		Height: configTypes.Link{Value: "456"},
+		Code:   0,
+		Messages: []types.Message{
+			&messages.MsgSend{
+				From: &configTypes.Link{Value: "from"},
+				To:   &configTypes.Link{Value: "to"},
+				Amount: amount.Amounts{
+					amount.AmountFromString("100", "ustake"),
+				},
+			},
+		},
+	}
+	require.NotNil(t, filterer.FilterForChainAndSubscription(reportable, chain, subscription))
+
+	reportable.Height.Value = "123"
+	reportable.Backfilled = true
+
+	require.NotNil(t, filterer.FilterForChainAndSubscription(reportable, chain, subscription))
+
+	lastHeight, found := filterer.GetLastBlockHeight("chain")
+	require.True(t, found)
+	require.Equal(t, int64(456), lastHeight)
+}
+
 func TestFilterReportableTxAllMessagesFiltered(t *testing.T) {
 	t.Parallel()
 
@@ -333,7 +394,7 @@ func TestFilterReportableTxAllMessagesFiltered(t *testing.T) {
 	subscription := &configTypes.ChainSubscription{
 		Chain: "chain",
 		Filters: configTypes.Filters{
-			*queryPkg.MustParse("transfer.sender = 'from'"),
+			*filter.MustParse("transfer.sender = 'from'"),
 		},
 	}
 	reportable := &types.Tx{
@@ -351,6 +412,67 @@ func TestFilterReportableTxAllMessagesFiltered(t *testing.T) {
 	}
 	require.Nil(t, filterer.FilterForChainAndSubscription(reportable, chain, subscription))
 }
+
+func TestFilterReportableTxFiltersNotMatching(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	filterer := filtererPkg.NewFilterer(logger, config, metricsManager)
+	chain := &configTypes.Chain{Name: "chain"}
+
+	subscription := &configTypes.ChainSubscription{
+		Chain: "chain",
+		TxFilters: configTypes.Filters{
+			*filter.MustParse("tx.memo = 'memo'"),
+		},
+	}
+	reportable := &types.Tx{
+		Height: configTypes.Link{Value: "456"},
+		Memo:   "other memo",
+		Messages: []types.Message{
+			&messages.MsgSend{
+				From:   &configTypes.Link{Value: "from"},
+				To:     &configTypes.Link{Value: "to"},
+				Amount: amount.Amounts{amount.AmountFromString("100", "ustake")},
+			},
+		},
+	}
+	require.Nil(t, filterer.FilterForChainAndSubscription(reportable, chain, subscription))
+}
+
+func TestFilterReportableTxFiltersMatching(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	filterer := filtererPkg.NewFilterer(logger, config, metricsManager)
+	chain := &configTypes.Chain{Name: "chain"}
+
+	subscription := &configTypes.ChainSubscription{
+		Chain:                 "chain",
+		LogFailedTransactions: true,
+		TxFilters: configTypes.Filters{
+			*filter.MustParse("tx.code > 0 AND tx.signer = 'signer'"),
+		},
+	}
+	reportable := &types.Tx{
+		Height:  configTypes.Link{Value: "456"},
+		Code:    5,
+		Signers: []string{"signer"},
+		Messages: []types.Message{
+			&messages.MsgSend{
+				From:   &configTypes.Link{Value: "from"},
+				To:     &configTypes.Link{Value: "to"},
+				Amount: amount.Amounts{amount.AmountFromString("100", "ustake")},
+			},
+		},
+	}
+	require.NotNil(t, filterer.FilterForChainAndSubscription(reportable, chain, subscription))
+}
+
 func TestFilterReportableTxInvalidHeight(t *testing.T) {
 	t.Parallel()
 
@@ -434,3 +556,220 @@ func TestGetReporters(t *testing.T) {
 	_, ok := result["reporter-2"]
 	require.True(t, ok)
 }
+
+func TestAmountsMatchUSDValue(t *testing.T) {
+	t.Parallel()
+
+	withPrice := amount.AmountFromString("100", "atom")
+	withPrice.AddUSDPrice(10)
+	withoutPrice := amount.AmountFromString("100", "osmo")
+
+	subscription := &configTypes.ChainSubscription{
+		MinUSDValue: big.NewFloat(500),
+		MaxUSDValue: big.NewFloat(5000),
+	}
+
+	require.True(t, filtererPkg.AmountsMatch(amount.Amounts{withPrice}, subscription))
+	require.True(t, filtererPkg.AmountsMatch(amount.Amounts{withPrice, withoutPrice}, subscription))
+	require.False(t, filtererPkg.AmountsMatch(amount.Amounts{withoutPrice}, subscription))
+	require.False(t, filtererPkg.AmountsMatch(amount.Amounts{}, subscription))
+
+	require.False(t, filtererPkg.AmountsMatch(amount.Amounts{withPrice}, &configTypes.ChainSubscription{
+		MinUSDValue: big.NewFloat(5000),
+	}))
+	require.False(t, filtererPkg.AmountsMatch(amount.Amounts{withPrice}, &configTypes.ChainSubscription{
+		MaxUSDValue: big.NewFloat(500),
+	}))
+}
+
+func TestAmountsMatchMinAmounts(t *testing.T) {
+	t.Parallel()
+
+	subscription := &configTypes.ChainSubscription{
+		MinAmounts: map[string]*big.Float{"atom": big.NewFloat(100)},
+	}
+
+	require.True(t, filtererPkg.AmountsMatch(amount.Amounts{
+		amount.AmountFromString("100", "atom"),
+	}, subscription))
+	require.True(t, filtererPkg.AmountsMatch(amount.Amounts{
+		amount.AmountFromString("1", "osmo"),
+		amount.AmountFromString("1000", "atom"),
+	}, subscription))
+	require.False(t, filtererPkg.AmountsMatch(amount.Amounts{
+		amount.AmountFromString("99", "atom"),
+	}, subscription))
+	require.False(t, filtererPkg.AmountsMatch(amount.Amounts{
+		amount.AmountFromString("1000", "osmo"),
+	}, subscription))
+}
+
+func TestFilterByAmountsNoFilters(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{}
+	filterer := filtererPkg.NewFilterer(logger, config, nil)
+
+	reportable := &types.Tx{}
+	report := types.Report{
+		Reportable:        reportable,
+		ChainSubscription: &configTypes.ChainSubscription{Chain: "chain"},
+	}
+
+	require.Equal(t, reportable, filterer.FilterByAmounts(report))
+}
+
+func TestFilterByAmountsNotTx(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{}
+	filterer := filtererPkg.NewFilterer(logger, config, nil)
+
+	reportable := &types.NodeConnectError{}
+	report := types.Report{
+		Reportable: reportable,
+		ChainSubscription: &configTypes.ChainSubscription{
+			Chain:       "chain",
+			MinUSDValue: big.NewFloat(100),
+		},
+	}
+
+	require.Equal(t, reportable, filterer.FilterByAmounts(report))
+}
+
+func TestFilterByAmountsFiltered(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{}
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	filterer := filtererPkg.NewFilterer(logger, config, metricsManager)
+
+	report := types.Report{
+		Reportable: &types.Tx{
+			Messages: []types.Message{
+				&messages.MsgSend{
+					From:   &configTypes.Link{Value: "from"},
+					To:     &configTypes.Link{Value: "to"},
+					Amount: amount.Amounts{amount.AmountFromString("100", "atom")},
+				},
+			},
+		},
+		ChainSubscription: &configTypes.ChainSubscription{
+			Chain:      "chain",
+			MinAmounts: map[string]*big.Float{"atom": big.NewFloat(1000)},
+		},
+	}
+
+	require.Nil(t, filterer.FilterByAmounts(report))
+}
+
+func TestFilterByAmountsInternalMessages(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{}
+	filterer := filtererPkg.NewFilterer(logger, config, nil)
+
+	report := types.Report{
+		Reportable: &types.Tx{
+			Messages: []types.Message{
+				&messages.MsgExec{
+					Grantee: &configTypes.Link{Value: "grantee"},
+					Messages: []types.Message{
+						&messages.MsgSend{
+							From:   &configTypes.Link{Value: "from"},
+							To:     &configTypes.Link{Value: "to"},
+							Amount: amount.Amounts{amount.AmountFromString("100", "atom")},
+						},
+						&messages.MsgSend{
+							From:   &configTypes.Link{Value: "from"},
+							To:     &configTypes.Link{Value: "to"},
+							Amount: amount.Amounts{amount.AmountFromString("10000", "atom")},
+						},
+					},
+				},
+			},
+		},
+		ChainSubscription: &configTypes.ChainSubscription{
+			Chain:      "chain",
+			MinAmounts: map[string]*big.Float{"atom": big.NewFloat(1000)},
+		},
+	}
+
+	filtered := filterer.FilterByAmounts(report)
+	require.NotNil(t, filtered)
+	require.Len(t, filtered.GetMessages(), 1)
+	require.Len(t, filtered.GetMessages()[0].GetParsedMessages(), 1)
+
+	// The original tx is shared with other subscriptions' reports and should stay intact.
+	require.Len(t, report.Reportable.GetMessages(), 1)
+	require.Len(t, report.Reportable.GetMessages()[0].GetParsedMessages(), 2)
+}
+
+func TestFilterByAmountsDoesNotModifyTx(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{}
+	filterer := filtererPkg.NewFilterer(logger, config, nil)
+
+	tx := &types.Tx{
+		Messages: []types.Message{
+			&messages.MsgSend{
+				From:   &configTypes.Link{Value: "from"},
+				To:     &configTypes.Link{Value: "to"},
+				Amount: amount.Amounts{amount.AmountFromString("100", "atom")},
+			},
+			&messages.MsgSend{
+				From:   &configTypes.Link{Value: "from"},
+				To:     &configTypes.Link{Value: "to"},
+				Amount: amount.Amounts{amount.AmountFromString("10000", "atom")},
+			},
+		},
+	}
+
+	strictReport := types.Report{
+		Reportable: tx,
+		ChainSubscription: &configTypes.ChainSubscription{
+			Chain:      "chain",
+			MinAmounts: map[string]*big.Float{"atom": big.NewFloat(1000)},
+		},
+	}
+	looseReport := types.Report{
+		Reportable: tx,
+		ChainSubscription: &configTypes.ChainSubscription{
+			Chain:      "chain",
+			MinAmounts: map[string]*big.Float{"atom": big.NewFloat(10)},
+		},
+	}
+
+	strictFiltered := filterer.FilterByAmounts(strictReport)
+	require.NotNil(t, strictFiltered)
+	require.Len(t, strictFiltered.GetMessages(), 1)
+
+	looseFiltered := filterer.FilterByAmounts(looseReport)
+	require.NotNil(t, looseFiltered)
+	require.Len(t, looseFiltered.GetMessages(), 2)
+	require.Len(t, tx.Messages, 2)
+}
+
+func TestFiltererLastBlockHeight(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{}
+	logger := loggerPkg.GetNopLogger()
+	filterer := filtererPkg.NewFilterer(logger, config, nil)
+
+	_, found := filterer.GetLastBlockHeight("chain")
+	require.False(t, found)
+
+	filterer.SetLastBlockHeight("chain", 100)
+	filterer.SetLastBlockHeight("chain", 50)
+
+	height, found := filterer.GetLastBlockHeight("chain")
+	require.True(t, found)
+	require.Equal(t, int64(100), height)
+}
@@ -1,14 +1,49 @@
 package fs
 
 import (
+	"fmt"
 	"io"
 )
 
 type File interface {
 	io.WriteCloser
+	Sync() error
 }
 
 type FS interface {
 	ReadFile(name string) ([]byte, error)
 	Create(path string) (File, error)
+	Rename(oldPath, newPath string) error
+}
+
+// WriteFileAtomically writes a file via a temporary one next to it, which is synced
+// to disk and then renamed over the original file, so a crash while writing leaves
+// either the old or the new file, but never a partly written one.
+func WriteFileAtomically(filesystem FS, path string, write func(writer io.Writer) error) error {
+	tmpPath := path + ".tmp"
+
+	f, err := filesystem.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %s", err)
+	}
+
+	if writeErr := write(f); writeErr != nil {
+		_ = f.Close()
+		return fmt.Errorf("error writing temporary file: %s", writeErr)
+	}
+
+	if syncErr := f.Sync(); syncErr != nil {
+		_ = f.Close()
+		return fmt.Errorf("error syncing temporary file: %s", syncErr)
+	}
+
+	if closeErr := f.Close(); closeErr != nil {
+		return fmt.Errorf("error closing temporary file: %s", closeErr)
+	}
+
+	if renameErr := filesystem.Rename(tmpPath, path); renameErr != nil {
+		return fmt.Errorf("error renaming temporary file: %s", renameErr)
+	}
+
+	return nil
 }
@@ -0,0 +1,71 @@
+package fs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomicallyOk(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "file.yml")
+	filesystem := &OsFS{}
+
+	err := WriteFileAtomically(filesystem, path, func(writer io.Writer) error {
+		_, writeErr := writer.Write([]byte("content"))
+		return writeErr
+	})
+	require.NoError(t, err)
+
+	content, err := filesystem.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "content", string(content))
+
+	_, err = os.Stat(path + ".tmp")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestWriteFileAtomicallyWriteFailed(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "file.yml")
+	filesystem := &OsFS{}
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o600))
+
+	err := WriteFileAtomically(filesystem, path, func(writer io.Writer) error {
+		_, _ = writer.Write([]byte("partial"))
+		return errors.New("custom error")
+	})
+	require.Error(t, err)
+
+	// the original file is left intact
+	content, err := filesystem.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "old", string(content))
+}
+
+func TestWriteFileAtomicallyFailed(t *testing.T) {
+	t.Parallel()
+
+	write := func(writer io.Writer) error {
+		_, err := writer.Write([]byte("content"))
+		return err
+	}
+
+	for _, filesystem := range []*MockFs{
+		{FailCreate: true},
+		{FailWrite: true},
+		{FailSync: true},
+		{FailClose: true},
+		{FailRename: true},
+	} {
+		require.Error(t, WriteFileAtomically(filesystem, "file.yml", write))
+	}
+
+	require.NoError(t, WriteFileAtomically(&MockFs{}, "file.yml", write))
+}
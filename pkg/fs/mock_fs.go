@@ -7,6 +7,7 @@ import (
 
 type MockFile struct {
 	FailWrite bool
+	FailSync  bool
 	FailClose bool
 }
 
@@ -18,6 +19,14 @@ func (file *MockFile) Write(p []byte) (int, error) {
 	return len(p), nil
 }
 
+func (file *MockFile) Sync() error {
+	if file.FailSync {
+		return errors.New("not yet supported")
+	}
+
+	return nil
+}
+
 func (file *MockFile) Close() error {
 	if file.FailClose {
 		return errors.New("not yet supported")
@@ -29,7 +38,9 @@ func (file *MockFile) Close() error {
 type MockFs struct {
 	FailCreate bool
 	FailWrite  bool
+	FailSync   bool
 	FailClose  bool
+	FailRename bool
 }
 
 func (filesystem *MockFs) ReadFile(name string) ([]byte, error) {
@@ -43,10 +54,19 @@ func (filesystem *MockFs) Create(path string) (File, error) {
 
 	return &MockFile{
 		FailWrite: filesystem.FailWrite,
+		FailSync:  filesystem.FailSync,
 		FailClose: filesystem.FailClose,
 	}, nil
 }
 
+func (filesystem *MockFs) Rename(oldPath, newPath string) error {
+	if filesystem.FailRename {
+		return errors.New("not yet supported")
+	}
+
+	return nil
+}
+
 func (filesystem *MockFs) Write(p []byte) (int, error) {
 	return 0, errors.New("not yet supported")
 }
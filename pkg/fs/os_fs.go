@@ -12,3 +12,7 @@ func (fs *OsFS) ReadFile(name string) ([]byte, error) {
 func (fs *OsFS) Create(path string) (File, error) {
 	return os.Create(path)
 }
+
+func (fs *OsFS) Rename(oldPath, newPath string) error {
+	return os.Rename(oldPath, newPath)
+}
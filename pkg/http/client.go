@@ -1,8 +1,10 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"main/pkg/config"
 	"main/pkg/types/query_info"
 	"net/http"
 	"time"
@@ -10,16 +12,49 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// sharedTransport is reused by all the clients, so connections are pooled
+// instead of being established again for each query.
+var sharedTransport = newTransport()
+
+func newTransport() *http.Transport {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return &http.Transport{}
+	}
+
+	return transport.Clone()
+}
+
+// getTransport returns the shared transport, unless the default one was replaced
+// (for example, by httpmock in tests), in which case the replaced one is used.
+func getTransport() http.RoundTripper {
+	if _, ok := http.DefaultTransport.(*http.Transport); !ok {
+		return http.DefaultTransport
+	}
+
+	return sharedTransport
+}
+
+// StatusError is returned when the node answers with a bad HTTP code.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("bad HTTP code: %d", e.StatusCode)
+}
+
 type Client struct {
-	logger  zerolog.Logger
-	host    string
-	timeout time.Duration
+	logger   zerolog.Logger
+	host     string
+	timeouts config.TimeoutsConfig
 }
 
 func NewClient(
 	logger *zerolog.Logger,
 	host string,
 	chainName string,
+	timeouts config.TimeoutsConfig,
 ) *Client {
 	return &Client{
 		logger: logger.With().
@@ -27,35 +62,33 @@ func NewClient(
 			Str("url", host).
 			Str("chain", chainName).
 			Logger(),
-		host:    host,
-		timeout: 60 * time.Second,
+		host:     host,
+		timeouts: timeouts,
 	}
 }
 
-func (c *Client) Get(url string, target interface{}) (error, query_info.QueryInfo) {
-	return c.GetWithHeaders(url, target, map[string]string{})
+func (c *Client) Get(
+	ctx context.Context,
+	url string,
+	target interface{},
+	queryType query_info.QueryType,
+) (error, query_info.QueryInfo) {
+	return c.GetWithHeaders(ctx, url, target, map[string]string{}, queryType)
 }
 
 func (c *Client) GetWithHeaders(
+	ctx context.Context,
 	relativeURL string,
 	target interface{},
 	headers map[string]string,
+	queryType query_info.QueryType,
 ) (error, query_info.QueryInfo) {
 	url := fmt.Sprintf("%s%s", c.host, relativeURL)
 
-	var transport http.RoundTripper
-
-	transportRaw, ok := http.DefaultTransport.(*http.Transport)
-	if ok {
-		transport = transportRaw.Clone()
-	} else {
-		transport = http.DefaultTransport
-	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeouts.GetQueryTimeout(queryType))
+	defer cancel()
 
-	client := &http.Client{
-		Timeout:   c.timeout,
-		Transport: transport,
-	}
+	client := &http.Client{Transport: getTransport()}
 	start := time.Now()
 	queryInfo := query_info.QueryInfo{
 		Success: false,
@@ -63,7 +96,7 @@ func (c *Client) GetWithHeaders(
 		Time:    0,
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return err, queryInfo
 	}
@@ -90,7 +123,7 @@ func (c *Client) GetWithHeaders(
 			Err(err).
 			Int("status", res.StatusCode).
 			Msg("Query returned bad HTTP code")
-		return fmt.Errorf("bad HTTP code: %d", res.StatusCode), queryInfo
+		return &StatusError{StatusCode: res.StatusCode}, queryInfo
 	}
 
 	c.logger.Debug().Str("url", url).Dur("duration", time.Since(start)).Msg("Query is finished")
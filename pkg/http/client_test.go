@@ -1,10 +1,14 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"main/assets"
+	configPkg "main/pkg/config"
 	loggerPkg "main/pkg/logger"
+	"main/pkg/types/query_info"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/require"
@@ -14,8 +18,8 @@ func TestHttpClientErrorCreating(t *testing.T) {
 	t.Parallel()
 
 	logger := loggerPkg.GetNopLogger()
-	client := NewClient(logger, "", "chain")
-	err, queryInfo := client.Get("://test", nil)
+	client := NewClient(logger, "", "chain", configPkg.TimeoutsConfig{})
+	err, queryInfo := client.Get(context.Background(), "://test", nil, query_info.QueryTypeStatus)
 	require.Error(t, err)
 	require.False(t, queryInfo.Success)
 }
@@ -31,10 +35,10 @@ func TestHttpClientQueryFail(t *testing.T) {
 		httpmock.NewErrorResponder(errors.New("custom error")),
 	)
 	logger := loggerPkg.GetNopLogger()
-	client := NewClient(logger, "https://example.com", "chain")
+	client := NewClient(logger, "https://example.com", "chain", configPkg.TimeoutsConfig{})
 
 	var response interface{}
-	err, queryInfo := client.Get("/", &response)
+	err, queryInfo := client.Get(context.Background(), "/", &response, query_info.QueryTypeStatus)
 	require.Error(t, err)
 	require.ErrorContains(t, err, "custom error")
 	require.False(t, queryInfo.Success)
@@ -51,10 +55,10 @@ func TestHttpClientJsonParseFail(t *testing.T) {
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("invalid-json.json")),
 	)
 	logger := loggerPkg.GetNopLogger()
-	client := NewClient(logger, "https://example.com", "chain")
+	client := NewClient(logger, "https://example.com", "chain", configPkg.TimeoutsConfig{})
 	var response interface{}
 
-	err, queryInfo := client.Get("/", &response)
+	err, queryInfo := client.Get(context.Background(), "/", &response, query_info.QueryTypeStatus)
 	require.Error(t, err)
 	require.ErrorContains(t, err, "invalid character")
 	require.False(t, queryInfo.Success)
@@ -71,10 +75,10 @@ func TestHttpClientBadHttpCode(t *testing.T) {
 		httpmock.NewBytesResponder(500, assets.GetBytesOrPanic("error.json")),
 	)
 	logger := loggerPkg.GetNopLogger()
-	client := NewClient(logger, "https://example.com", "chain")
+	client := NewClient(logger, "https://example.com", "chain", configPkg.TimeoutsConfig{})
 
 	var response interface{}
-	err, queryInfo := client.Get("/", &response)
+	err, queryInfo := client.Get(context.Background(), "/", &response, query_info.QueryTypeStatus)
 	require.Error(t, err)
 	require.ErrorContains(t, err, "bad HTTP code")
 	require.False(t, queryInfo.Success)
@@ -91,12 +95,59 @@ func TestHttpClientOk(t *testing.T) {
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("error.json")),
 	)
 	logger := loggerPkg.GetNopLogger()
-	client := NewClient(logger, "https://example.com", "chain")
+	client := NewClient(logger, "https://example.com", "chain", configPkg.TimeoutsConfig{})
 
 	var response interface{}
-	err, queryInfo := client.GetWithHeaders("/", &response, map[string]string{
+	err, queryInfo := client.GetWithHeaders(context.Background(), "/", &response, map[string]string{
 		"User-Agent": "custom",
-	})
+	}, query_info.QueryTypeStatus)
 	require.NoError(t, err)
 	require.True(t, queryInfo.Success)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestHttpClientTimeout(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("error.json")).Delay(time.Second),
+	)
+	logger := loggerPkg.GetNopLogger()
+	client := NewClient(logger, "https://example.com", "chain", configPkg.TimeoutsConfig{
+		Queries: map[query_info.QueryType]time.Duration{
+			query_info.QueryTypeStatus: 10 * time.Millisecond,
+		},
+	})
+
+	var response interface{}
+	err, queryInfo := client.Get(context.Background(), "/", &response, query_info.QueryTypeStatus)
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestHttpClientCancelled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("error.json")).Delay(time.Second),
+	)
+	logger := loggerPkg.GetNopLogger()
+	client := NewClient(logger, "https://example.com", "chain", configPkg.TimeoutsConfig{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var response interface{}
+	err, queryInfo := client.Get(ctx, "/", &response, query_info.QueryTypeStatus)
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.Canceled)
+	require.False(t, queryInfo.Success)
+}
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -47,8 +48,8 @@ func (m *MsgAcknowledgement) Type() string {
 	return "/ibc.core.channel.v1.MsgAcknowledgement"
 }
 
-func (m *MsgAcknowledgement) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
-	fetcher.PopulateAmount(m.Chain.ChainID, m.Token)
+func (m *MsgAcknowledgement) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
+	fetcher.PopulateAmount(ctx, m.Chain.ChainID, m.Token)
 	fetcher.PopulateWalletAlias(m.Chain, m.Sender, subscriptionName)
 	fetcher.PopulateWalletAlias(m.Chain, m.Signer, subscriptionName)
 }
@@ -60,6 +61,14 @@ func (m *MsgAcknowledgement) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgAcknowledgement) GetAmounts() amount.Amounts {
+	return amount.Amounts{m.Token}
+}
+
+func (m *MsgAcknowledgement) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgAcknowledgement) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -70,6 +79,11 @@ func (m *MsgAcknowledgement) AddParsedMessage(message types.Message) {
 func (m *MsgAcknowledgement) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgAcknowledgement) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgAcknowledgement) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
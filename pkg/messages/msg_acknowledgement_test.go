@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
@@ -127,6 +128,9 @@ func TestMsgAcknowledgementBase(t *testing.T) {
 		event.From(cosmosTypes.EventTypeMessage, cosmosTypes.AttributeKeySender, "signer"),
 	}, values)
 
+	require.Len(t, parsed.GetAmounts(), 1)
+	require.Empty(t, parsed.GetValidators())
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -193,7 +197,7 @@ func TestMsgAcknowledgementPopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "receiver", "receiver_alias")
 	require.NoError(t, err)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, ok := parsed.(*MsgAcknowledgement)
 	require.True(t, ok)
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -41,11 +42,11 @@ func (m *MsgBeginRedelegate) Type() string {
 	return "/cosmos.staking.v1beta1.MsgBeginRedelegate"
 }
 
-func (m *MsgBeginRedelegate) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
-	fetcher.PopulateValidator(m.Chain, m.ValidatorSrcAddress)
-	fetcher.PopulateValidator(m.Chain, m.ValidatorDstAddress)
+func (m *MsgBeginRedelegate) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
+	fetcher.PopulateValidator(ctx, m.Chain, m.ValidatorSrcAddress)
+	fetcher.PopulateValidator(ctx, m.Chain, m.ValidatorDstAddress)
 
-	fetcher.PopulateAmount(m.Chain.ChainID, m.Amount)
+	fetcher.PopulateAmount(ctx, m.Chain.ChainID, m.Amount)
 	fetcher.PopulateWalletAlias(m.Chain, m.DelegatorAddress, subscriptionName)
 }
 
@@ -60,6 +61,14 @@ func (m *MsgBeginRedelegate) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgBeginRedelegate) GetAmounts() amount.Amounts {
+	return amount.Amounts{m.Amount}
+}
+
+func (m *MsgBeginRedelegate) GetValidators() []string {
+	return []string{m.ValidatorSrcAddress.Value, m.ValidatorDstAddress.Value}
+}
+
 func (m *MsgBeginRedelegate) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -70,6 +79,11 @@ func (m *MsgBeginRedelegate) AddParsedMessage(message types.Message) {
 func (m *MsgBeginRedelegate) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgBeginRedelegate) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgBeginRedelegate) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
@@ -71,6 +72,9 @@ func TestMsgBeginRedelegateBase(t *testing.T) {
 		event.From(cosmosStakingTypes.EventTypeRedelegate, cosmosTypes.AttributeKeyAmount, "100ustake"),
 	}, values)
 
+	require.Len(t, parsed.GetAmounts(), 1)
+	require.Len(t, parsed.GetValidators(), 2)
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -116,17 +120,17 @@ func TestMsgBeginRedelegatePopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "delegator", "delegator_alias")
 	require.NoError(t, err)
 
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
-	dataFetcher.Cache.Set("chain_validator_validator_src", &responses.Validator{
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
+	dataFetcher.Cache.SetValidator("chain", "validator_src", &responses.Validator{
 		OperatorAddress: "test",
 		Description:     responses.ValidatorDescription{Moniker: "Src Validator Moniker"},
 	})
-	dataFetcher.Cache.Set("chain_validator_validator_dst", &responses.Validator{
+	dataFetcher.Cache.SetValidator("chain", "validator_dst", &responses.Validator{
 		OperatorAddress: "test",
 		Description:     responses.ValidatorDescription{Moniker: "Dst Validator Moniker"},
 	})
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	msgDelegate, _ := parsed.(*MsgBeginRedelegate)
 
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -39,9 +40,9 @@ func (m *MsgDelegate) Type() string {
 	return "/cosmos.staking.v1beta1.MsgDelegate"
 }
 
-func (m *MsgDelegate) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
-	fetcher.PopulateValidator(m.Chain, m.ValidatorAddress)
-	fetcher.PopulateAmount(m.Chain.ChainID, m.Amount)
+func (m *MsgDelegate) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
+	fetcher.PopulateValidator(ctx, m.Chain, m.ValidatorAddress)
+	fetcher.PopulateAmount(ctx, m.Chain.ChainID, m.Amount)
 	fetcher.PopulateWalletAlias(m.Chain, m.DelegatorAddress, subscriptionName)
 }
 
@@ -55,6 +56,14 @@ func (m *MsgDelegate) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgDelegate) GetAmounts() amount.Amounts {
+	return amount.Amounts{m.Amount}
+}
+
+func (m *MsgDelegate) GetValidators() []string {
+	return []string{m.ValidatorAddress.Value}
+}
+
 func (m *MsgDelegate) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -65,6 +74,11 @@ func (m *MsgDelegate) AddParsedMessage(message types.Message) {
 func (m *MsgDelegate) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgDelegate) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgDelegate) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
@@ -68,6 +69,9 @@ func TestMsgDelegateBase(t *testing.T) {
 		event.From(cosmosStakingTypes.EventTypeDelegate, cosmosTypes.AttributeKeyAmount, "100ustake"),
 	}, values)
 
+	require.Len(t, parsed.GetAmounts(), 1)
+	require.Len(t, parsed.GetValidators(), 1)
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -112,13 +116,13 @@ func TestMsgDelegatePopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "delegator", "delegator_alias")
 	require.NoError(t, err)
 
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
-	dataFetcher.Cache.Set("chain_validator_validator", &responses.Validator{
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
+	dataFetcher.Cache.SetValidator("chain", "validator", &responses.Validator{
 		OperatorAddress: "test",
 		Description:     responses.ValidatorDescription{Moniker: "Validator Moniker"},
 	})
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	msgDelegate, _ := parsed.(*MsgDelegate)
 
@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 	"strconv"
 
@@ -39,12 +41,12 @@ func (m *MsgExec) Type() string {
 	return "/cosmos.authz.v1beta1.MsgExec"
 }
 
-func (m *MsgExec) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgExec) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	fetcher.PopulateWalletAlias(m.Chain, m.Grantee, subscriptionName)
 
 	for _, message := range m.Messages {
 		if message != nil {
-			message.GetAdditionalData(fetcher, subscriptionName)
+			message.GetAdditionalData(ctx, fetcher, subscriptionName)
 		}
 	}
 }
@@ -66,6 +68,14 @@ func (m *MsgExec) GetMessagesLabel() string {
 	return fmt.Sprintf("%d, %d skipped", len(m.RawMessages), len(m.RawMessages)-len(m.Messages))
 }
 
+func (m *MsgExec) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (m *MsgExec) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgExec) GetRawMessages() []*codecTypes.Any {
 	return m.RawMessages
 }
@@ -78,6 +88,12 @@ func (m *MsgExec) SetParsedMessages(messages []types.Message) {
 	m.Messages = messages
 }
 
+func (m *MsgExec) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	copied.Messages = messages
+	return &copied
+}
+
 func (m *MsgExec) GetParsedMessages() []types.Message {
 	return m.Messages
 }
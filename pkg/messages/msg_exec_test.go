@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
@@ -84,6 +85,10 @@ func TestMsgExecBase(t *testing.T) {
 	parsed.AddParsedMessage(msgSetAddr)
 	require.Len(t, parsed.GetParsedMessages(), 1)
 	require.Len(t, parsed.GetRawMessages(), 1)
+
+	copied := parsed.WithParsedMessages([]types.Message{})
+	require.Empty(t, copied.GetParsedMessages())
+	require.Len(t, parsed.GetParsedMessages(), 1)
 }
 
 func TestMsgExecPopulate(t *testing.T) {
@@ -133,7 +138,7 @@ func TestMsgExecPopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "withdraw", "withdraw_alias")
 	require.NoError(t, err)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgExec)
 
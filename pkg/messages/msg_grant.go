@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"main/pkg/types"
 	"main/pkg/types/amount"
 	"time"
@@ -98,7 +99,7 @@ func (m *MsgGrant) Type() string {
 	return "/cosmos.authz.v1beta1.MsgGrant"
 }
 
-func (m *MsgGrant) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgGrant) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	fetcher.PopulateWalletAlias(m.Chain, m.Grantee, subscriptionName)
 	fetcher.PopulateWalletAlias(m.Chain, m.Granter, subscriptionName)
 }
@@ -110,6 +111,18 @@ func (m *MsgGrant) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgGrant) GetAmounts() amount.Amounts {
+	if authorization, ok := m.Authorization.(StakeAuthorization); ok && authorization.MaxTokens != nil {
+		return amount.Amounts{authorization.MaxTokens}
+	}
+
+	return amount.Amounts{}
+}
+
+func (m *MsgGrant) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgGrant) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -120,6 +133,11 @@ func (m *MsgGrant) AddParsedMessage(message types.Message) {
 func (m *MsgGrant) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgGrant) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgGrant) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
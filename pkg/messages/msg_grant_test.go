@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
@@ -189,6 +190,9 @@ func TestMsgGrantBase(t *testing.T) {
 	}, values)
 
 	parsed.SetParsedMessages([]types.Message{})
+
+	require.Empty(t, parsed.GetAmounts())
+	require.Empty(t, parsed.GetValidators())
 	parsed.AddParsedMessage(nil)
 	require.Empty(t, parsed.GetParsedMessages())
 	require.Empty(t, parsed.GetRawMessages())
@@ -235,7 +239,7 @@ func TestMsgGrantPopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "grantee", "grantee_alias")
 	require.NoError(t, err)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgGrant)
 	require.Equal(t, "grantee_alias", message.Grantee.Title)
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -53,15 +54,15 @@ func (m *MsgMultiSend) Type() string {
 	return "/cosmos.bank.v1beta1.MsgMultiSend"
 }
 
-func (m *MsgMultiSend) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgMultiSend) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	for _, input := range m.Inputs {
 		fetcher.PopulateWalletAlias(m.Chain, input.Address, subscriptionName)
-		fetcher.PopulateAmounts(m.Chain.ChainID, input.Amount)
+		fetcher.PopulateAmounts(ctx, m.Chain.ChainID, input.Amount)
 	}
 
 	for _, output := range m.Outputs {
 		fetcher.PopulateWalletAlias(m.Chain, output.Address, subscriptionName)
-		fetcher.PopulateAmounts(m.Chain.ChainID, output.Amount)
+		fetcher.PopulateAmounts(ctx, m.Chain.ChainID, output.Amount)
 	}
 }
 
@@ -90,6 +91,20 @@ func (m *MsgMultiSend) GetValues() event.EventValues {
 	return values
 }
 
+func (m *MsgMultiSend) GetAmounts() amount.Amounts {
+	// Inputs and outputs total amounts are the same, so taking only inputs.
+	amounts := make(amount.Amounts, 0)
+	for _, input := range m.Inputs {
+		amounts = append(amounts, input.Amount...)
+	}
+
+	return amounts
+}
+
+func (m *MsgMultiSend) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgMultiSend) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -100,6 +115,11 @@ func (m *MsgMultiSend) AddParsedMessage(message types.Message) {
 func (m *MsgMultiSend) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgMultiSend) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgMultiSend) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,7 +1,9 @@
 package messages
 
 import (
+	"context"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -13,13 +15,21 @@ func (m *MsgNotExistingMessage) Type() string {
 	return "MsgNotExistingMessage"
 }
 
-func (m *MsgNotExistingMessage) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgNotExistingMessage) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 }
 
 func (m *MsgNotExistingMessage) GetValues() event.EventValues {
 	return []event.EventValue{}
 }
 
+func (m *MsgNotExistingMessage) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (m *MsgNotExistingMessage) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgNotExistingMessage) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -30,6 +40,11 @@ func (m *MsgNotExistingMessage) AddParsedMessage(message types.Message) {
 func (m *MsgNotExistingMessage) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgNotExistingMessage) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgNotExistingMessage) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
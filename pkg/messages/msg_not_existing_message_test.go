@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"main/pkg/types"
 	"testing"
 
@@ -16,7 +17,7 @@ func TestMsgNotExistingMessageBase(t *testing.T) {
 
 	msg.AddParsedMessage(nil)
 	msg.SetParsedMessages([]types.Message{})
-	msg.GetAdditionalData(nil, "subscription")
+	msg.GetAdditionalData(context.Background(), nil, "subscription")
 
 	require.Empty(t, msg.GetValues())
 	require.Empty(t, msg.GetParsedMessages())
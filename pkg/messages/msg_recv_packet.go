@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/messages/packet"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -42,9 +44,9 @@ func (m *MsgRecvPacket) Type() string {
 	return "/ibc.core.channel.v1.MsgRecvPacket"
 }
 
-func (m *MsgRecvPacket) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgRecvPacket) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	fetcher.PopulateWalletAlias(m.Chain, m.Signer, subscriptionName)
-	m.Packet.GetAdditionalData(fetcher, subscriptionName)
+	m.Packet.GetAdditionalData(ctx, fetcher, subscriptionName)
 }
 
 func (m *MsgRecvPacket) GetValues() event.EventValues {
@@ -57,6 +59,14 @@ func (m *MsgRecvPacket) GetValues() event.EventValues {
 	return values
 }
 
+func (m *MsgRecvPacket) GetAmounts() amount.Amounts {
+	return m.Packet.GetAmounts()
+}
+
+func (m *MsgRecvPacket) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgRecvPacket) GetRawMessages() []*codecTypes.Any {
 	return m.Packet.GetRawMessages()
 }
@@ -69,6 +79,12 @@ func (m *MsgRecvPacket) SetParsedMessages(messages []types.Message) {
 	m.Packet.SetParsedMessages(messages)
 }
 
+func (m *MsgRecvPacket) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	copied.Packet = m.Packet.WithParsedMessages(messages)
+	return &copied
+}
+
 func (m *MsgRecvPacket) GetParsedMessages() []types.Message {
 	return m.Packet.GetParsedMessages()
 }
@@ -1,8 +1,10 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -39,7 +41,7 @@ func (m *MsgRevoke) Type() string {
 	return "/cosmos.authz.v1beta1.MsgRevoke"
 }
 
-func (m *MsgRevoke) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgRevoke) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	fetcher.PopulateWalletAlias(m.Chain, m.Grantee, subscriptionName)
 	fetcher.PopulateWalletAlias(m.Chain, m.Granter, subscriptionName)
 }
@@ -51,6 +53,14 @@ func (m *MsgRevoke) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgRevoke) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (m *MsgRevoke) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgRevoke) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -61,6 +71,11 @@ func (m *MsgRevoke) AddParsedMessage(message types.Message) {
 func (m *MsgRevoke) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgRevoke) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgRevoke) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
@@ -61,6 +62,9 @@ func TestMsgRevokeBase(t *testing.T) {
 		event.From(cosmosTypes.EventTypeMessage, cosmosTypes.AttributeKeySender, "grantee"),
 	}, values)
 
+	require.Empty(t, parsed.GetAmounts())
+	require.Empty(t, parsed.GetValidators())
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -98,7 +102,7 @@ func TestMsgRevokePopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "grantee", "grantee_alias")
 	require.NoError(t, err)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgRevoke)
 
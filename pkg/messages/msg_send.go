@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -40,8 +41,8 @@ func (m *MsgSend) Type() string {
 	return "/cosmos.bank.v1beta1.MsgSend"
 }
 
-func (m *MsgSend) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
-	fetcher.PopulateAmounts(m.Chain.ChainID, m.Amount)
+func (m *MsgSend) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
+	fetcher.PopulateAmounts(ctx, m.Chain.ChainID, m.Amount)
 
 	fetcher.PopulateWalletAlias(m.Chain, m.From, subscriptionName)
 	fetcher.PopulateWalletAlias(m.Chain, m.To, subscriptionName)
@@ -60,6 +61,14 @@ func (m *MsgSend) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgSend) GetAmounts() amount.Amounts {
+	return m.Amount
+}
+
+func (m *MsgSend) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgSend) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -70,6 +79,11 @@ func (m *MsgSend) AddParsedMessage(message types.Message) {
 func (m *MsgSend) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgSend) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgSend) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
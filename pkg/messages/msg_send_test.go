@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
@@ -69,6 +70,9 @@ func TestMsgSendBase(t *testing.T) {
 		event.From(cosmosTypes.EventTypeMessage, cosmosTypes.AttributeKeySender, "from"),
 	}, values)
 
+	require.Len(t, parsed.GetAmounts(), 1)
+	require.Empty(t, parsed.GetValidators())
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -115,9 +119,9 @@ func TestMsgSendPopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "to", "to_alias")
 	require.NoError(t, err)
 
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	msgSend, _ := parsed.(*MsgSend)
 
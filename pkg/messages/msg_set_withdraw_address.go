@@ -1,8 +1,10 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -36,7 +38,7 @@ func (m *MsgSetWithdrawAddress) Type() string {
 	return "/cosmos.distribution.v1beta1.MsgSetWithdrawAddress"
 }
 
-func (m *MsgSetWithdrawAddress) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgSetWithdrawAddress) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	fetcher.PopulateWalletAlias(m.Chain, m.DelegatorAddress, subscriptionName)
 	fetcher.PopulateWalletAlias(m.Chain, m.WithdrawAddress, subscriptionName)
 }
@@ -48,6 +50,14 @@ func (m *MsgSetWithdrawAddress) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgSetWithdrawAddress) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (m *MsgSetWithdrawAddress) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgSetWithdrawAddress) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -58,6 +68,11 @@ func (m *MsgSetWithdrawAddress) AddParsedMessage(message types.Message) {
 func (m *MsgSetWithdrawAddress) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgSetWithdrawAddress) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgSetWithdrawAddress) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
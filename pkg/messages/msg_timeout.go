@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/messages/packet"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -42,9 +44,9 @@ func (m *MsgTimeout) Type() string {
 	return "/ibc.core.channel.v1.MsgTimeout"
 }
 
-func (m *MsgTimeout) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgTimeout) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	fetcher.PopulateWalletAlias(m.Chain, m.Signer, subscriptionName)
-	m.Packet.GetAdditionalData(fetcher, subscriptionName)
+	m.Packet.GetAdditionalData(ctx, fetcher, subscriptionName)
 }
 
 func (m *MsgTimeout) GetValues() event.EventValues {
@@ -57,6 +59,14 @@ func (m *MsgTimeout) GetValues() event.EventValues {
 	return values
 }
 
+func (m *MsgTimeout) GetAmounts() amount.Amounts {
+	return m.Packet.GetAmounts()
+}
+
+func (m *MsgTimeout) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgTimeout) GetRawMessages() []*codecTypes.Any {
 	return m.Packet.GetRawMessages()
 }
@@ -69,6 +79,12 @@ func (m *MsgTimeout) SetParsedMessages(messages []types.Message) {
 	m.Packet.SetParsedMessages(messages)
 }
 
+func (m *MsgTimeout) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	copied.Packet = m.Packet.WithParsedMessages(messages)
+	return &copied
+}
+
 func (m *MsgTimeout) GetParsedMessages() []types.Message {
 	return m.Packet.GetParsedMessages()
 }
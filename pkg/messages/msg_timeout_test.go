@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
@@ -132,6 +133,9 @@ func TestMsgTimeoutBase(t *testing.T) {
 		event.From(cosmosTypes.EventTypeMessage, cosmosTypes.AttributeKeySender, "sender"),
 	}, values)
 
+	require.Len(t, parsed.GetAmounts(), 1)
+	require.Empty(t, parsed.GetValidators())
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -196,7 +200,7 @@ func TestMsgTimeoutPopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "receiver", "receiver_alias")
 	require.NoError(t, err)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, ok := parsed.(*MsgTimeout)
 	require.True(t, ok)
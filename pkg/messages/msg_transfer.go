@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -44,13 +45,13 @@ func (m *MsgTransfer) Type() string {
 	return "/ibc.applications.transfer.v1.MsgTransfer"
 }
 
-func (m *MsgTransfer) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
-	m.FetchRemoteChainData(fetcher)
-	fetcher.PopulateMultichainWallet(m.Chain, m.SrcChannel, m.SrcPort, m.Receiver, subscriptionName)
+func (m *MsgTransfer) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
+	m.FetchRemoteChainData(ctx, fetcher)
+	fetcher.PopulateMultichainWallet(ctx, m.Chain, m.SrcChannel, m.SrcPort, m.Receiver, subscriptionName)
 	fetcher.PopulateWalletAlias(m.Chain, m.Sender, subscriptionName)
 }
 
-func (m *MsgTransfer) FetchRemoteChainData(fetcher types.DataFetcher) {
+func (m *MsgTransfer) FetchRemoteChainData(ctx context.Context, fetcher types.DataFetcher) {
 	// p.Receiver is always someone from the remote chain, so we need to fetch the data
 	// from cross-chain.
 	// p.Sender is on native chain, so we can use p.Chain to generate links
@@ -63,7 +64,7 @@ func (m *MsgTransfer) FetchRemoteChainData(fetcher types.DataFetcher) {
 	// the remote chain for links generation.
 	var trace ibcTypes.DenomTrace
 	if m.Token.Denom.IsIbcToken() {
-		externalTrace, found := fetcher.GetDenomTrace(m.Chain, m.Token.Denom.String())
+		externalTrace, found := fetcher.GetDenomTrace(ctx, m.Chain, m.Token.Denom.String())
 		if !found {
 			return
 		}
@@ -77,19 +78,19 @@ func (m *MsgTransfer) FetchRemoteChainData(fetcher types.DataFetcher) {
 
 	// If it's native - populate denom as it is, taking current chain as the source chain.
 	if trace.IsNativeDenom() {
-		fetcher.PopulateAmount(m.Chain.ChainID, m.Token)
+		fetcher.PopulateAmount(ctx, m.Chain.ChainID, m.Token)
 		return
 	}
 
 	// If it's not native - we need the remote chain ID to get the original denoms from,
 	// if we can't fetch it - we can't fetch prices, or generate links (if chain is in local
 	// config.)
-	originalChainID, fetched := fetcher.GetIbcRemoteChainID(m.Chain.ChainID, m.SrcChannel, m.SrcPort)
+	originalChainID, fetched := fetcher.GetIbcRemoteChainID(ctx, m.Chain.ChainID, m.SrcChannel, m.SrcPort)
 	if !fetched {
 		return
 	}
 
-	fetcher.PopulateAmount(originalChainID, m.Token)
+	fetcher.PopulateAmount(ctx, originalChainID, m.Token)
 }
 
 func (m *MsgTransfer) GetValues() event.EventValues {
@@ -102,6 +103,14 @@ func (m *MsgTransfer) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgTransfer) GetAmounts() amount.Amounts {
+	return amount.Amounts{m.Token}
+}
+
+func (m *MsgTransfer) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgTransfer) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -112,6 +121,11 @@ func (m *MsgTransfer) AddParsedMessage(message types.Message) {
 func (m *MsgTransfer) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgTransfer) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgTransfer) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,10 +1,12 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/data_fetcher"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
@@ -68,6 +70,9 @@ func TestMsgTransferBase(t *testing.T) {
 		event.From(ibcTypes.EventTypeTransfer, cosmosTypes.AttributeKeyAmount, "100ustake"),
 	}, values)
 
+	require.Len(t, parsed.GetAmounts(), 1)
+	require.Empty(t, parsed.GetValidators())
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -120,14 +125,14 @@ func TestMsgTransferPopulateLinks(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "chain-id-2")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "chain-id-2")
 
 	err = aliasManager.Set("subscription", "chain", "sender", "sender_alias")
 	require.NoError(t, err)
 	err = aliasManager.Set("subscription", "chain2", "receiver", "receiver_alias")
 	require.NoError(t, err)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgTransfer)
 
@@ -174,9 +179,9 @@ func TestMsgTransferPopulateNativeDenom(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgTransfer)
 	require.Equal(t, "100.00", fmt.Sprintf("%.2f", message.Token.Value))
@@ -225,9 +230,9 @@ func TestMsgTransferPopulateIbcDenomFetchDenomFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeDenomTrace, "chain_denom", nil)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgTransfer)
 	require.Equal(t, "100000000.00", fmt.Sprintf("%.2f", message.Token.Value))
@@ -276,13 +281,13 @@ func TestMsgTransferPopulateIbcDenomFetchRemoteChainFailed(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", &ibcTypes.DenomTrace{
+	dataFetcher.Cache.SetDenomTrace("chain", "denom", &ibcTypes.DenomTrace{
 		Path:      "path",
 		BaseDenom: "uatom",
 	})
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeIbcChannel, "chain_channel_port", nil)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgTransfer)
 	require.Equal(t, "100000000.00", fmt.Sprintf("%.2f", message.Token.Value))
@@ -331,14 +336,14 @@ func TestMsgTransferPopulateIbcDenom(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", &ibcTypes.DenomTrace{
+	dataFetcher.Cache.SetDenomTrace("chain", "denom", &ibcTypes.DenomTrace{
 		Path:      "path",
 		BaseDenom: "uatom",
 	})
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "remote-chain")
-	dataFetcher.Cache.Set("remote-chain_price_uatom", 6.7)
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "remote-chain")
+	dataFetcher.Cache.SetPrice("remote-chain", "uatom", 6.7)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgTransfer)
 	require.Equal(t, "100.00", fmt.Sprintf("%.2f", message.Token.Value))
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"main/pkg/types"
 	"main/pkg/types/amount"
 	"time"
@@ -42,14 +43,14 @@ func (m *MsgUndelegate) Type() string {
 	return "/cosmos.staking.v1beta1.MsgUndelegate"
 }
 
-func (m *MsgUndelegate) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
-	fetcher.PopulateValidator(m.Chain, m.ValidatorAddress)
+func (m *MsgUndelegate) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
+	fetcher.PopulateValidator(ctx, m.Chain, m.ValidatorAddress)
 
-	if stakingParams, found := fetcher.GetStakingParams(m.Chain); found {
+	if stakingParams, found := fetcher.GetStakingParams(ctx, m.Chain); found {
 		m.UndelegateFinishTime = time.Now().Add(stakingParams.UnbondingTime.Duration)
 	}
 
-	fetcher.PopulateAmount(m.Chain.ChainID, m.Amount)
+	fetcher.PopulateAmount(ctx, m.Chain.ChainID, m.Amount)
 	fetcher.PopulateWalletAlias(m.Chain, m.DelegatorAddress, subscriptionName)
 }
 
@@ -63,6 +64,14 @@ func (m *MsgUndelegate) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgUndelegate) GetAmounts() amount.Amounts {
+	return amount.Amounts{m.Amount}
+}
+
+func (m *MsgUndelegate) GetValidators() []string {
+	return []string{m.ValidatorAddress.Value}
+}
+
 func (m *MsgUndelegate) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -73,6 +82,11 @@ func (m *MsgUndelegate) AddParsedMessage(message types.Message) {
 func (m *MsgUndelegate) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgUndelegate) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgUndelegate) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
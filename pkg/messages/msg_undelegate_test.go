@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
@@ -69,6 +70,9 @@ func TestMsgUndelegateBase(t *testing.T) {
 		event.From(cosmosStakingTypes.EventTypeUnbond, cosmosTypes.AttributeKeyAmount, "100ustake"),
 	}, values)
 
+	require.Len(t, parsed.GetAmounts(), 1)
+	require.Len(t, parsed.GetValidators(), 1)
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -113,16 +117,16 @@ func TestMsgUndelegatePopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "delegator", "delegator_alias")
 	require.NoError(t, err)
 
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
-	dataFetcher.Cache.Set("chain_validator_validator", &responses.Validator{
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
+	dataFetcher.Cache.SetValidator("chain", "validator", &responses.Validator{
 		OperatorAddress: "test",
 		Description:     responses.ValidatorDescription{Moniker: "Validator Moniker"},
 	})
-	dataFetcher.Cache.Set("chain_staking_params", &responses.StakingParams{
+	dataFetcher.Cache.SetStakingParams("chain", &responses.StakingParams{
 		UnbondingTime: responses.Duration{Duration: 15 * time.Second},
 	})
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgUndelegate)
 
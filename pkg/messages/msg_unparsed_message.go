@@ -1,7 +1,9 @@
 package messages
 
 import (
+	"context"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,13 +18,21 @@ func (m *MsgUnparsedMessage) Type() string {
 	return "MsgUnparsedMessage"
 }
 
-func (m *MsgUnparsedMessage) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgUnparsedMessage) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 }
 
 func (m *MsgUnparsedMessage) GetValues() event.EventValues {
 	return []event.EventValue{}
 }
 
+func (m *MsgUnparsedMessage) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (m *MsgUnparsedMessage) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgUnparsedMessage) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -33,6 +43,11 @@ func (m *MsgUnparsedMessage) AddParsedMessage(message types.Message) {
 func (m *MsgUnparsedMessage) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgUnparsedMessage) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgUnparsedMessage) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"errors"
 	"main/pkg/types"
 	"testing"
@@ -17,7 +18,7 @@ func TestMsgUnparsedMessageBase(t *testing.T) {
 
 	msg.AddParsedMessage(nil)
 	msg.SetParsedMessages([]types.Message{})
-	msg.GetAdditionalData(nil, "subscription")
+	msg.GetAdditionalData(context.Background(), nil, "subscription")
 
 	require.Empty(t, msg.GetValues())
 	require.Empty(t, msg.GetParsedMessages())
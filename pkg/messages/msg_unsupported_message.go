@@ -1,7 +1,9 @@
 package messages
 
 import (
+	"context"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -15,13 +17,21 @@ func (m *MsgUnsupportedMessage) Type() string {
 	return "MsgUnsupportedMessage"
 }
 
-func (m *MsgUnsupportedMessage) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgUnsupportedMessage) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 }
 
 func (m *MsgUnsupportedMessage) GetValues() event.EventValues {
 	return []event.EventValue{}
 }
 
+func (m *MsgUnsupportedMessage) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (m *MsgUnsupportedMessage) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgUnsupportedMessage) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -32,6 +42,11 @@ func (m *MsgUnsupportedMessage) AddParsedMessage(message types.Message) {
 func (m *MsgUnsupportedMessage) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgUnsupportedMessage) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgUnsupportedMessage) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
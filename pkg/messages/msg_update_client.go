@@ -1,8 +1,10 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -36,7 +38,7 @@ func (m *MsgUpdateClient) Type() string {
 	return "/ibc.core.client.v1.MsgUpdateClient"
 }
 
-func (m *MsgUpdateClient) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgUpdateClient) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	fetcher.PopulateWalletAlias(m.Chain, m.Signer, subscriptionName)
 }
 
@@ -48,6 +50,14 @@ func (m *MsgUpdateClient) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgUpdateClient) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (m *MsgUpdateClient) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgUpdateClient) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -58,6 +68,11 @@ func (m *MsgUpdateClient) AddParsedMessage(message types.Message) {
 func (m *MsgUpdateClient) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgUpdateClient) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgUpdateClient) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
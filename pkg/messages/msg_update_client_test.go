@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
@@ -62,6 +63,9 @@ func TestMsgUpdateClientBase(t *testing.T) {
 		event.From(ibcClientTypes.EventTypeUpdateClient, ibcClientTypes.AttributeKeyClientID, "client"),
 	}, values)
 
+	require.Empty(t, parsed.GetAmounts())
+	require.Empty(t, parsed.GetValidators())
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -97,7 +101,7 @@ func TestMsgUpdateClientPopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "signer", "signer_alias")
 	require.NoError(t, err)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgUpdateClient)
 
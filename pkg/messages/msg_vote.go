@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	"main/pkg/types"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	configTypes "main/pkg/config/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 	"main/pkg/types/responses"
 
@@ -44,8 +46,8 @@ func (m *MsgVote) Type() string {
 	return "/cosmos.gov.v1beta1.MsgVote"
 }
 
-func (m *MsgVote) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
-	proposal, found := fetcher.GetProposal(m.Chain, m.ProposalID.Value)
+func (m *MsgVote) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
+	proposal, found := fetcher.GetProposal(ctx, m.Chain, m.ProposalID.Value)
 	if found {
 		m.Proposal = proposal
 		m.ProposalID.Title = fmt.Sprintf("#%s: %s", m.ProposalID.Value, proposal.Content.Title)
@@ -82,6 +84,14 @@ func (m *MsgVote) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgVote) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (m *MsgVote) GetValidators() []string {
+	return []string{}
+}
+
 func (m *MsgVote) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -92,6 +102,11 @@ func (m *MsgVote) AddParsedMessage(message types.Message) {
 func (m *MsgVote) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgVote) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgVote) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"context"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/data_fetcher"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
@@ -67,6 +69,9 @@ func TestMsgVoteBase(t *testing.T) {
 		event.From(cosmosGovEvents.EventTypeProposalVote, cosmosGovEvents.AttributeKeyOption, "VOTE_OPTION_YES"),
 	}, values)
 
+	require.Empty(t, parsed.GetAmounts())
+	require.Empty(t, parsed.GetValidators())
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -114,12 +119,12 @@ func TestMsgVotePopulatePresent(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "voter", "voter_alias")
 	require.NoError(t, err)
 
-	dataFetcher.Cache.Set("chain_proposal_1", &responses.Proposal{
+	dataFetcher.Cache.SetProposal("chain", "1", &responses.Proposal{
 		ProposalID: "1",
 		Content:    responses.ProposalContent{Title: "Title"},
 	})
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	msgSend, _ := parsed.(*MsgVote)
 
@@ -157,9 +162,9 @@ func TestMsgVotePopulateAbsent(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "voter", "voter_alias")
 	require.NoError(t, err)
 
-	dataFetcher.Cache.Set("chain_proposal_1", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeProposal, "chain_1", nil)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	msgSend, _ := parsed.(*MsgVote)
 
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -40,8 +41,9 @@ func (m *MsgWithdrawDelegatorReward) Type() string {
 	return "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward"
 }
 
-func (m *MsgWithdrawDelegatorReward) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgWithdrawDelegatorReward) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	rewards, found := fetcher.GetRewardsAtBlock(
+		ctx,
 		m.Chain,
 		m.DelegatorAddress.Value,
 		m.ValidatorAddress.Value,
@@ -54,10 +56,10 @@ func (m *MsgWithdrawDelegatorReward) GetAdditionalData(fetcher types.DataFetcher
 			m.Amount[index] = amount.AmountFromString(reward.Amount, reward.Denom)
 		}
 
-		fetcher.PopulateAmounts(m.Chain.ChainID, m.Amount)
+		fetcher.PopulateAmounts(ctx, m.Chain.ChainID, m.Amount)
 	}
 
-	fetcher.PopulateValidator(m.Chain, m.ValidatorAddress)
+	fetcher.PopulateValidator(ctx, m.Chain, m.ValidatorAddress)
 	fetcher.PopulateWalletAlias(m.Chain, m.DelegatorAddress, subscriptionName)
 }
 
@@ -70,6 +72,14 @@ func (m *MsgWithdrawDelegatorReward) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgWithdrawDelegatorReward) GetAmounts() amount.Amounts {
+	return m.Amount
+}
+
+func (m *MsgWithdrawDelegatorReward) GetValidators() []string {
+	return []string{m.ValidatorAddress.Value}
+}
+
 func (m *MsgWithdrawDelegatorReward) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -80,6 +90,11 @@ func (m *MsgWithdrawDelegatorReward) AddParsedMessage(message types.Message) {
 func (m *MsgWithdrawDelegatorReward) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgWithdrawDelegatorReward) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgWithdrawDelegatorReward) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
@@ -65,6 +66,9 @@ func TestMsgWithdrawDelegatorRewardBase(t *testing.T) {
 		event.From(cosmosDistributionTypes.EventTypeWithdrawRewards, cosmosDistributionTypes.AttributeKeyDelegator, "delegator"),
 	}, values)
 
+	require.Empty(t, parsed.GetAmounts())
+	require.Len(t, parsed.GetValidators(), 1)
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -108,16 +112,16 @@ func TestMsgWithdrawDelegatorRewardPopulate(t *testing.T) {
 	err = aliasManager.Set("subscription", "chain", "delegator", "delegator_alias")
 	require.NoError(t, err)
 
-	dataFetcher.Cache.Set("chain_rewards_delegator_validator_100", []responses.Reward{
+	dataFetcher.Cache.SetRewards("chain", "delegator", "validator", 100, []responses.Reward{
 		{Amount: "100000000", Denom: "uatom"},
 	})
-	dataFetcher.Cache.Set("chain_validator_validator", &responses.Validator{
+	dataFetcher.Cache.SetValidator("chain", "validator", &responses.Validator{
 		OperatorAddress: "test",
 		Description:     responses.ValidatorDescription{Moniker: "Validator Moniker"},
 	})
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgWithdrawDelegatorReward)
 
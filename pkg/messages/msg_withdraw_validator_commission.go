@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -38,8 +39,9 @@ func (m *MsgWithdrawValidatorCommission) Type() string {
 	return "/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission"
 }
 
-func (m *MsgWithdrawValidatorCommission) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (m *MsgWithdrawValidatorCommission) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	rewards, found := fetcher.GetCommissionAtBlock(
+		ctx,
 		m.Chain,
 		m.ValidatorAddress.Value,
 		m.Height,
@@ -51,10 +53,10 @@ func (m *MsgWithdrawValidatorCommission) GetAdditionalData(fetcher types.DataFet
 			m.Amount[index] = amount.AmountFromString(reward.Amount, reward.Denom)
 		}
 
-		fetcher.PopulateAmounts(m.Chain.ChainID, m.Amount)
+		fetcher.PopulateAmounts(ctx, m.Chain.ChainID, m.Amount)
 	}
 
-	fetcher.PopulateValidator(m.Chain, m.ValidatorAddress)
+	fetcher.PopulateValidator(ctx, m.Chain, m.ValidatorAddress)
 }
 
 func (m *MsgWithdrawValidatorCommission) GetValues() event.EventValues {
@@ -64,6 +66,14 @@ func (m *MsgWithdrawValidatorCommission) GetValues() event.EventValues {
 	}
 }
 
+func (m *MsgWithdrawValidatorCommission) GetAmounts() amount.Amounts {
+	return m.Amount
+}
+
+func (m *MsgWithdrawValidatorCommission) GetValidators() []string {
+	return []string{m.ValidatorAddress.Value}
+}
+
 func (m *MsgWithdrawValidatorCommission) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -74,6 +84,11 @@ func (m *MsgWithdrawValidatorCommission) AddParsedMessage(message types.Message)
 func (m *MsgWithdrawValidatorCommission) SetParsedMessages(messages []types.Message) {
 }
 
+func (m *MsgWithdrawValidatorCommission) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *m
+	return &copied
+}
+
 func (m *MsgWithdrawValidatorCommission) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
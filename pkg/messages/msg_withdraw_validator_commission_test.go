@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
@@ -61,6 +62,9 @@ func TestMsgWithdrawValidatorCommissionBase(t *testing.T) {
 		event.From(cosmosDistributionTypes.EventTypeWithdrawCommission, cosmosDistributionTypes.AttributeKeyValidator, "validator"),
 	}, values)
 
+	require.Empty(t, parsed.GetAmounts())
+	require.Len(t, parsed.GetValidators(), 1)
+
 	parsed.AddParsedMessage(nil)
 	parsed.SetParsedMessages([]types.Message{})
 	require.Empty(t, parsed.GetParsedMessages())
@@ -100,16 +104,16 @@ func TestMsgWithdrawValidatorCommissionPopulate(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_commission_validator_100", []responses.Commission{
+	dataFetcher.Cache.SetCommission("chain", "validator", 100, []responses.Commission{
 		{Amount: "100000000", Denom: "uatom"},
 	})
-	dataFetcher.Cache.Set("chain_validator_validator", &responses.Validator{
+	dataFetcher.Cache.SetValidator("chain", "validator", &responses.Validator{
 		OperatorAddress: "test",
 		Description:     responses.ValidatorDescription{Moniker: "Validator Moniker"},
 	})
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*MsgWithdrawValidatorCommission)
 
@@ -1,6 +1,7 @@
 package packet
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
 	"main/pkg/types/amount"
@@ -48,13 +49,13 @@ func (p *FungibleTokenPacket) Type() string {
 	return "FungibleTokenPacket"
 }
 
-func (p *FungibleTokenPacket) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
-	p.FetchRemoteChainData(fetcher)
-	fetcher.PopulateMultichainWallet(p.Chain, p.DstChannel, p.DstPort, p.Sender, subscriptionName)
+func (p *FungibleTokenPacket) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
+	p.FetchRemoteChainData(ctx, fetcher)
+	fetcher.PopulateMultichainWallet(ctx, p.Chain, p.DstChannel, p.DstPort, p.Sender, subscriptionName)
 	fetcher.PopulateWalletAlias(p.Chain, p.Receiver, subscriptionName)
 }
 
-func (p *FungibleTokenPacket) FetchRemoteChainData(fetcher types.DataFetcher) {
+func (p *FungibleTokenPacket) FetchRemoteChainData(ctx context.Context, fetcher types.DataFetcher) {
 	// p.Sender is always someone from the remote chain, so we need to fetch the data
 	// from cross-chain.
 	// p.Receiver is on native chain, so we can use p.Chain to generate links
@@ -65,20 +66,20 @@ func (p *FungibleTokenPacket) FetchRemoteChainData(fetcher types.DataFetcher) {
 	p.Token.BaseDenom = amount.Denom(trace.BaseDenom)
 
 	if !trace.IsNativeDenom() {
-		fetcher.PopulateAmount(p.Chain.ChainID, p.Token)
+		fetcher.PopulateAmount(ctx, p.Chain.ChainID, p.Token)
 		return
 	}
 
-	originalChainID, fetched := fetcher.GetIbcRemoteChainID(p.Chain.ChainID, p.DstChannel, p.DstPort)
+	originalChainID, fetched := fetcher.GetIbcRemoteChainID(ctx, p.Chain.ChainID, p.DstChannel, p.DstPort)
 
 	if !fetched {
 		return
 	}
 
 	if chain, found := fetcher.FindChainById(originalChainID); found {
-		fetcher.PopulateAmount(chain.ChainID, p.Token)
+		fetcher.PopulateAmount(ctx, chain.ChainID, p.Token)
 	} else {
-		fetcher.PopulateAmount(originalChainID, p.Token)
+		fetcher.PopulateAmount(ctx, originalChainID, p.Token)
 	}
 }
 func (p *FungibleTokenPacket) GetValues() event.EventValues {
@@ -89,6 +90,14 @@ func (p *FungibleTokenPacket) GetValues() event.EventValues {
 	}
 }
 
+func (p *FungibleTokenPacket) GetAmounts() amount.Amounts {
+	return amount.Amounts{p.Token}
+}
+
+func (p *FungibleTokenPacket) GetValidators() []string {
+	return []string{}
+}
+
 func (p *FungibleTokenPacket) GetRawMessages() []*codecTypes.Any {
 	return []*codecTypes.Any{}
 }
@@ -99,6 +108,11 @@ func (p *FungibleTokenPacket) AddParsedMessage(message types.Message) {
 func (p *FungibleTokenPacket) SetParsedMessages(messages []types.Message) {
 }
 
+func (p *FungibleTokenPacket) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *p
+	return &copied
+}
+
 func (p *FungibleTokenPacket) GetParsedMessages() []types.Message {
 	return []types.Message{}
 }
@@ -1,10 +1,12 @@
 package packet
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
 	"main/pkg/data_fetcher"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
@@ -128,14 +130,14 @@ func TestFungibleTokenPacketPopulateLinks(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "chain-id-2")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "chain-id-2")
 
 	err := aliasManager.Set("subscription", "chain2", "sender", "sender_alias")
 	require.NoError(t, err)
 	err = aliasManager.Set("subscription", "chain", "receiver", "receiver_alias")
 	require.NoError(t, err)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*FungibleTokenPacket)
 
@@ -185,9 +187,9 @@ func TestMsgTransferPopulateNativeDenomNotFetched(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", nil)
+	dataFetcher.Cache.Set(constants.CacheEntryTypeIbcChannel, "chain_channel_port", nil)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*FungibleTokenPacket)
 	require.Equal(t, "100.00", fmt.Sprintf("%.2f", message.Token.Value))
@@ -235,9 +237,9 @@ func TestMsgTransferPopulateNotNativeDenom(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*FungibleTokenPacket)
 	require.Equal(t, "100.00", fmt.Sprintf("%.2f", message.Token.Value))
@@ -282,11 +284,11 @@ func TestMsgTransferPopulateNativeDenomNotFoundLocally(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_denom_trace_denom", &ibcTypes.DenomTrace{
+	dataFetcher.Cache.SetDenomTrace("chain", "denom", &ibcTypes.DenomTrace{
 		Path:      "port/channel",
 		BaseDenom: "uatom",
 	})
-	dataFetcher.Cache.Set("cosmos_directory_chains", responses.CosmosDirectoryChains{
+	dataFetcher.Cache.SetCosmosDirectoryChains(responses.CosmosDirectoryChains{
 		{
 			ChainID: "remote-chain",
 			Assets: []responses.CosmosDirectoryAsset{
@@ -299,10 +301,10 @@ func TestMsgTransferPopulateNativeDenomNotFoundLocally(t *testing.T) {
 			},
 		},
 	})
-	dataFetcher.Cache.Set("remote-chain_price_uatom", 6.7)
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "remote-chain")
+	dataFetcher.Cache.SetPrice("remote-chain", "uatom", 6.7)
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "remote-chain")
 
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	message, _ := parsed.(*FungibleTokenPacket)
 	require.Equal(t, "100.00", fmt.Sprintf("%.2f", message.Token.Value))
@@ -1,9 +1,11 @@
 package packet
 
 import (
+	"context"
 	"fmt"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 	"strconv"
 
@@ -57,9 +59,9 @@ func (p *InterchainAccountsPacket) Type() string {
 	return "InterchainAccountsPacket"
 }
 
-func (p *InterchainAccountsPacket) GetAdditionalData(fetcher types.DataFetcher, subscriptionName string) {
+func (p *InterchainAccountsPacket) GetAdditionalData(ctx context.Context, fetcher types.DataFetcher, subscriptionName string) {
 	for _, message := range p.TxMessages {
-		message.GetAdditionalData(fetcher, subscriptionName)
+		message.GetAdditionalData(ctx, fetcher, subscriptionName)
 	}
 }
 
@@ -73,6 +75,14 @@ func (p *InterchainAccountsPacket) GetValues() event.EventValues {
 	return values
 }
 
+func (p *InterchainAccountsPacket) GetAmounts() amount.Amounts {
+	return amount.Amounts{}
+}
+
+func (p *InterchainAccountsPacket) GetValidators() []string {
+	return []string{}
+}
+
 func (p *InterchainAccountsPacket) GetRawMessages() []*codecTypes.Any {
 	return p.TxRawMessages
 }
@@ -85,6 +95,12 @@ func (p *InterchainAccountsPacket) SetParsedMessages(messages []types.Message) {
 	p.TxMessages = messages
 }
 
+func (p *InterchainAccountsPacket) WithParsedMessages(messages []types.Message) types.Message {
+	copied := *p
+	copied.TxMessages = messages
+	return &copied
+}
+
 func (p *InterchainAccountsPacket) GetParsedMessages() []types.Message {
 	return p.TxMessages
 }
@@ -1,6 +1,7 @@
 package packet
 
 import (
+	"context"
 	"fmt"
 	aliasManagerPkg "main/pkg/alias_manager"
 	configPkg "main/pkg/config"
@@ -160,9 +161,9 @@ func TestInterchainAccountPacketPopulate(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, config.Metrics)
 	dataFetcher := data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager)
 
-	dataFetcher.Cache.Set("chain_channel_channel_port_port", "chain-id")
-	dataFetcher.Cache.Set("chain-id_price_uatom", 6.7)
-	parsed.GetAdditionalData(dataFetcher, "subscription")
+	dataFetcher.Cache.SetIbcRemoteChainID("chain", "channel", "port", "chain-id")
+	dataFetcher.Cache.SetPrice("chain-id", "uatom", 6.7)
+	parsed.GetAdditionalData(context.Background(), dataFetcher, "subscription")
 
 	parsedMessages := parsed.GetParsedMessages()
 	require.Len(t, parsedMessages, 1)
@@ -34,6 +34,22 @@ type Manager struct {
 	// Node metrics
 	nodeConnectedCollector *prometheus.GaugeVec
 	reconnectsCounter      *prometheus.CounterVec
+	backfilledTxsCounter   *prometheus.CounterVec
+	nodeStandbyGauge       *prometheus.GaugeVec
+	nodeFailoversCounter   *prometheus.CounterVec
+	nodeLastBlockGauge     *prometheus.GaugeVec
+	nodeStalledGauge       *prometheus.GaugeVec
+
+	// API node metrics
+	apiNodeHealthScoreGauge *prometheus.GaugeVec
+	apiNodeLatencyGauge     *prometheus.GaugeVec
+	apiNodeCircuitOpenGauge *prometheus.GaugeVec
+
+	// Cache metrics
+	cacheHitsCounter      *prometheus.CounterVec
+	cacheMissesCounter    *prometheus.CounterVec
+	cacheEvictionsCounter *prometheus.CounterVec
+	cacheEntriesGauge     *prometheus.GaugeVec
 
 	// Reporters metrics
 	reporterReportsCounter *prometheus.CounterVec
@@ -42,6 +58,18 @@ type Manager struct {
 	reporterEnabledGauge   *prometheus.GaugeVec
 	reporterQueriesCounter *prometheus.CounterVec
 
+	// Pipeline metrics
+	enrichmentQueueGauge        *prometheus.GaugeVec
+	reporterQueueGauge          *prometheus.GaugeVec
+	reportsDroppedCounter       *prometheus.CounterVec
+	enrichmentTimeHistogram     *prometheus.HistogramVec
+	reportDeliveryTimeHistogram *prometheus.HistogramVec
+
+	// Outbox metrics
+	outboxPendingGauge       *prometheus.GaugeVec
+	outboxSentCounter        *prometheus.CounterVec
+	outboxRetryErrorsCounter *prometheus.CounterVec
+
 	// Subscriptions metrics
 	subscriptionsInfoCounter *prometheus.GaugeVec
 	eventsMatchedCounter     *prometheus.CounterVec
@@ -100,6 +128,58 @@ func NewManager(logger *zerolog.Logger, config configPkg.MetricsConfig) *Manager
 			Name: constants.PrometheusMetricsPrefix + "reconnects_total",
 			Help: "Node reconnects count",
 		}, []string{"chain", "node"}),
+		backfilledTxsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: constants.PrometheusMetricsPrefix + "backfilled_transactions_total",
+			Help: "Transactions fetched via /tx_search after reconnecting to a node",
+		}, []string{"chain", "node"}),
+		nodeStandbyGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "node_standby",
+			Help: "Whether the node is a standby one and is not listened to (1 if yes, 0 if no)",
+		}, []string{"chain", "node"}),
+		nodeFailoversCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: constants.PrometheusMetricsPrefix + "node_failovers_total",
+			Help: "How many times an active node stalled and was replaced with a standby one",
+		}, []string{"chain", "node"}),
+		nodeLastBlockGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "node_last_block_height",
+			Help: "Latest block height received from the node",
+		}, []string{"chain", "node"}),
+		nodeStalledGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "node_stalled",
+			Help: "Whether the node has no new blocks or is behind other nodes (1 if yes, 0 if no)",
+		}, []string{"chain", "node"}),
+
+		// API node metrics
+		apiNodeHealthScoreGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "api_node_health_score",
+			Help: "API node health score from 0 to 100, based on recent queries latency and errors",
+		}, []string{"chain", "node"}),
+		apiNodeLatencyGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "api_node_latency_seconds",
+			Help: "API node recent queries average latency",
+		}, []string{"chain", "node"}),
+		apiNodeCircuitOpenGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "api_node_circuit_open",
+			Help: "Whether the API node is skipped due to failing queries (1 if yes, 0 if no)",
+		}, []string{"chain", "node"}),
+
+		// Cache metrics
+		cacheHitsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: constants.PrometheusMetricsPrefix + "cache_hits_total",
+			Help: "Counter of data found in cache, by data type",
+		}, []string{"type"}),
+		cacheMissesCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: constants.PrometheusMetricsPrefix + "cache_misses_total",
+			Help: "Counter of data not found in cache or expired, by data type",
+		}, []string{"type"}),
+		cacheEvictionsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: constants.PrometheusMetricsPrefix + "cache_evictions_total",
+			Help: "Counter of least recently used data removed from cache as it was full, by data type",
+		}, []string{"type"}),
+		cacheEntriesGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "cache_entries",
+			Help: "Count of entries in cache",
+		}, []string{}),
 
 		// Reporter metrics
 		reporterEnabledGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
@@ -123,6 +203,42 @@ func NewManager(logger *zerolog.Logger, config configPkg.MetricsConfig) *Manager
 			Help: "Counter of reporters' queries (like chain status, aliases etc.)",
 		}, []string{"reporter", "type"}),
 
+		// Pipeline metrics
+		enrichmentQueueGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "enrichment_queue_size",
+			Help: "Count of reports waiting for additional data to be fetched",
+		}, []string{}),
+		reporterQueueGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "reporter_queue_size",
+			Help: "Count of reports waiting to be sent by reporter",
+		}, []string{"reporter"}),
+		reportsDroppedCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: constants.PrometheusMetricsPrefix + "reports_dropped",
+			Help: "Count of reports dropped as the reporter queue was full, by reporter",
+		}, []string{"reporter"}),
+		enrichmentTimeHistogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: constants.PrometheusMetricsPrefix + "enrichment_duration_seconds",
+			Help: "Time spent fetching additional data for reports, by chain",
+		}, []string{"chain"}),
+		reportDeliveryTimeHistogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: constants.PrometheusMetricsPrefix + "report_delivery_duration_seconds",
+			Help: "Time from receiving a report to it being sent, by reporter",
+		}, []string{"reporter"}),
+
+		// Outbox metrics
+		outboxPendingGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: constants.PrometheusMetricsPrefix + "outbox_pending_messages",
+			Help: "Count of messages reporter failed to send and is going to retry",
+		}, []string{"reporter"}),
+		outboxSentCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: constants.PrometheusMetricsPrefix + "outbox_sent",
+			Help: "Count of messages that failed to be sent before and were sent on retry",
+		}, []string{"reporter"}),
+		outboxRetryErrorsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: constants.PrometheusMetricsPrefix + "outbox_retry_errors",
+			Help: "Count of failed retries to send messages that failed to be sent before",
+		}, []string{"reporter"}),
+
 		// Subscription metrics
 		subscriptionsInfoCounter: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: constants.PrometheusMetricsPrefix + "subscriptions",
@@ -164,11 +280,31 @@ func (m *Manager) Start() {
 		m.eventsFilteredCounter,
 		m.nodeConnectedCollector,
 		m.reconnectsCounter,
+		m.backfilledTxsCounter,
+		m.nodeStandbyGauge,
+		m.nodeFailoversCounter,
+		m.nodeLastBlockGauge,
+		m.nodeStalledGauge,
+		m.apiNodeHealthScoreGauge,
+		m.apiNodeLatencyGauge,
+		m.apiNodeCircuitOpenGauge,
+		m.cacheHitsCounter,
+		m.cacheMissesCounter,
+		m.cacheEvictionsCounter,
+		m.cacheEntriesGauge,
 		m.reporterReportsCounter,
 		m.reporterErrorsCounter,
 		m.reportEntriesCounter,
 		m.reporterEnabledGauge,
 		m.reporterQueriesCounter,
+		m.enrichmentQueueGauge,
+		m.reporterQueueGauge,
+		m.reportsDroppedCounter,
+		m.enrichmentTimeHistogram,
+		m.reportDeliveryTimeHistogram,
+		m.outboxPendingGauge,
+		m.outboxSentCounter,
+		m.outboxRetryErrorsCounter,
 		m.subscriptionsInfoCounter,
 		m.eventsMatchedCounter,
 		m.appVersionGauge,
@@ -216,6 +352,13 @@ func (m *Manager) SetAllDefaultMetrics(config *configPkg.AppConfig) {
 		m.SetDefaultMetrics(chain)
 	}
 
+	for _, entryType := range constants.GetCacheEntryTypes() {
+		labels := prometheus.Labels{"type": string(entryType)}
+		m.cacheHitsCounter.With(labels).Add(0)
+		m.cacheMissesCounter.With(labels).Add(0)
+		m.cacheEvictionsCounter.With(labels).Add(0)
+	}
+
 	for _, subscription := range config.Subscriptions {
 		m.subscriptionsInfoCounter.
 			With(prometheus.Labels{
@@ -238,6 +381,14 @@ func (m *Manager) SetDefaultMetrics(chain *configTypes.Chain) {
 		m.reconnectsCounter.
 			With(prometheus.Labels{"chain": chain.Name, "node": node}).
 			Add(0)
+
+		m.backfilledTxsCounter.
+			With(prometheus.Labels{"chain": chain.Name, "node": node}).
+			Add(0)
+
+		m.nodeFailoversCounter.
+			With(prometheus.Labels{"chain": chain.Name, "node": node}).
+			Add(0)
 	}
 }
 
@@ -364,3 +515,120 @@ func (m *Manager) LogNodeReconnect(chain string, node string) {
 		With(prometheus.Labels{"chain": chain, "node": node}).
 		Inc()
 }
+
+func (m *Manager) LogNodeStandby(chain string, node string, standby bool) {
+	m.nodeStandbyGauge.
+		With(prometheus.Labels{"chain": chain, "node": node}).
+		Set(utils.BoolToFloat64(standby))
+}
+
+func (m *Manager) LogNodeFailover(chain string, node string) {
+	m.nodeFailoversCounter.
+		With(prometheus.Labels{"chain": chain, "node": node}).
+		Inc()
+}
+
+func (m *Manager) LogNodeLastBlock(chain string, node string, height int64) {
+	m.nodeLastBlockGauge.
+		With(prometheus.Labels{"chain": chain, "node": node}).
+		Set(float64(height))
+}
+
+func (m *Manager) LogNodeStalled(chain string, node string, stalled bool) {
+	m.nodeStalledGauge.
+		With(prometheus.Labels{"chain": chain, "node": node}).
+		Set(utils.BoolToFloat64(stalled))
+}
+
+func (m *Manager) LogApiNodeHealth(chain string, node string, status types.ApiNodeStatus) {
+	m.apiNodeHealthScoreGauge.
+		With(prometheus.Labels{"chain": chain, "node": node}).
+		Set(status.Score)
+
+	m.apiNodeLatencyGauge.
+		With(prometheus.Labels{"chain": chain, "node": node}).
+		Set(status.Latency.Seconds())
+
+	m.apiNodeCircuitOpenGauge.
+		With(prometheus.Labels{"chain": chain, "node": node}).
+		Set(utils.BoolToFloat64(status.CircuitOpen))
+}
+
+func (m *Manager) LogCacheHit(entryType constants.CacheEntryType) {
+	m.cacheHitsCounter.
+		With(prometheus.Labels{"type": string(entryType)}).
+		Inc()
+}
+
+func (m *Manager) LogCacheMiss(entryType constants.CacheEntryType) {
+	m.cacheMissesCounter.
+		With(prometheus.Labels{"type": string(entryType)}).
+		Inc()
+}
+
+func (m *Manager) LogCacheEviction(entryType constants.CacheEntryType) {
+	m.cacheEvictionsCounter.
+		With(prometheus.Labels{"type": string(entryType)}).
+		Inc()
+}
+
+func (m *Manager) LogCacheEntries(count int) {
+	m.cacheEntriesGauge.
+		With(prometheus.Labels{}).
+		Set(float64(count))
+}
+
+func (m *Manager) LogBackfilledTxs(chain string, node string, count int) {
+	m.backfilledTxsCounter.
+		With(prometheus.Labels{"chain": chain, "node": node}).
+		Add(float64(count))
+}
+
+func (m *Manager) LogEnrichmentQueueSize(size int) {
+	m.enrichmentQueueGauge.
+		With(prometheus.Labels{}).
+		Set(float64(size))
+}
+
+func (m *Manager) LogReporterQueueSize(reporter string, size int) {
+	m.reporterQueueGauge.
+		With(prometheus.Labels{"reporter": reporter}).
+		Set(float64(size))
+}
+
+func (m *Manager) LogReportDropped(reporter string) {
+	m.reportsDroppedCounter.
+		With(prometheus.Labels{"reporter": reporter}).
+		Inc()
+}
+
+func (m *Manager) LogEnrichmentTime(chain string, duration time.Duration) {
+	m.enrichmentTimeHistogram.
+		With(prometheus.Labels{"chain": chain}).
+		Observe(duration.Seconds())
+}
+
+func (m *Manager) LogReportDeliveryTime(reporter string, duration time.Duration) {
+	m.reportDeliveryTimeHistogram.
+		With(prometheus.Labels{"reporter": reporter}).
+		Observe(duration.Seconds())
+}
+
+func (m *Manager) LogOutboxPending(reporter string, count int) {
+	m.outboxPendingGauge.
+		With(prometheus.Labels{"reporter": reporter}).
+		Set(float64(count))
+}
+
+func (m *Manager) LogOutboxRetry(reporter string, success bool) {
+	if !success {
+		m.outboxRetryErrorsCounter.
+			With(prometheus.Labels{"reporter": reporter}).
+			Inc()
+		return
+	}
+
+	m.outboxSentCounter.
+		With(prometheus.Labels{"reporter": reporter}).
+		Inc()
+}
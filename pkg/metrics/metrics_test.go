@@ -4,6 +4,7 @@ import (
 	"io"
 	configPkg "main/pkg/config"
 	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/messages"
 	"main/pkg/types"
@@ -115,6 +116,105 @@ func TestMetricsManagerLogReporterQuery(t *testing.T) {
 	})), 0.01)
 }
 
+func TestMetricsManagerLogBackfilledTxs(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.MetricsConfig{Enabled: false}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := NewManager(logger, config)
+
+	metricsManager.LogBackfilledTxs("chain", "node", 3)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(metricsManager.backfilledTxsCounter))
+	assert.InDelta(t, 3, testutil.ToFloat64(metricsManager.backfilledTxsCounter.With(prometheus.Labels{
+		"chain": "chain",
+		"node":  "node",
+	})), 0.01)
+}
+
+func TestMetricsManagerLogApiNodeHealth(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.MetricsConfig{Enabled: false}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := NewManager(logger, config)
+
+	metricsManager.LogApiNodeHealth("chain", "node", types.ApiNodeStatus{
+		Score:       50,
+		Latency:     2 * time.Second,
+		CircuitOpen: true,
+	})
+
+	labels := prometheus.Labels{"chain": "chain", "node": "node"}
+	assert.InDelta(t, 50, testutil.ToFloat64(metricsManager.apiNodeHealthScoreGauge.With(labels)), 0.01)
+	assert.InDelta(t, 2, testutil.ToFloat64(metricsManager.apiNodeLatencyGauge.With(labels)), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(metricsManager.apiNodeCircuitOpenGauge.With(labels)), 0.01)
+}
+
+func TestMetricsManagerLogCache(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.MetricsConfig{Enabled: false}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := NewManager(logger, config)
+
+	metricsManager.LogCacheHit(constants.CacheEntryTypePrice)
+	metricsManager.LogCacheHit(constants.CacheEntryTypePrice)
+	metricsManager.LogCacheMiss(constants.CacheEntryTypePrice)
+	metricsManager.LogCacheEviction(constants.CacheEntryTypeValidator)
+	metricsManager.LogCacheEntries(5)
+
+	priceLabels := prometheus.Labels{"type": "price"}
+	assert.InDelta(t, 2, testutil.ToFloat64(metricsManager.cacheHitsCounter.With(priceLabels)), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(metricsManager.cacheMissesCounter.With(priceLabels)), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(metricsManager.cacheEvictionsCounter.With(prometheus.Labels{
+		"type": "validator",
+	})), 0.01)
+	assert.InDelta(t, 5, testutil.ToFloat64(metricsManager.cacheEntriesGauge.With(prometheus.Labels{})), 0.01)
+}
+
+func TestMetricsManagerLogPipeline(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.MetricsConfig{Enabled: false}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := NewManager(logger, config)
+
+	metricsManager.LogEnrichmentQueueSize(3)
+	metricsManager.LogReporterQueueSize("reporter", 2)
+	metricsManager.LogReportDropped("reporter")
+	metricsManager.LogEnrichmentTime("chain", time.Second)
+	metricsManager.LogReportDeliveryTime("reporter", 2*time.Second)
+
+	assert.InDelta(t, 3, testutil.ToFloat64(metricsManager.enrichmentQueueGauge.With(prometheus.Labels{})), 0.01)
+	assert.InDelta(t, 2, testutil.ToFloat64(metricsManager.reporterQueueGauge.With(prometheus.Labels{
+		"reporter": "reporter",
+	})), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(metricsManager.reportsDroppedCounter.With(prometheus.Labels{
+		"reporter": "reporter",
+	})), 0.01)
+	assert.Equal(t, 1, testutil.CollectAndCount(metricsManager.enrichmentTimeHistogram))
+	assert.Equal(t, 1, testutil.CollectAndCount(metricsManager.reportDeliveryTimeHistogram))
+}
+
+func TestMetricsManagerLogOutbox(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.MetricsConfig{Enabled: false}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := NewManager(logger, config)
+
+	metricsManager.LogOutboxPending("reporter", 3)
+	metricsManager.LogOutboxRetry("reporter", true)
+	metricsManager.LogOutboxRetry("reporter", false)
+	metricsManager.LogOutboxRetry("reporter", false)
+
+	labels := prometheus.Labels{"reporter": "reporter"}
+	assert.InDelta(t, 3, testutil.ToFloat64(metricsManager.outboxPendingGauge.With(labels)), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(metricsManager.outboxSentCounter.With(labels)), 0.01)
+	assert.InDelta(t, 2, testutil.ToFloat64(metricsManager.outboxRetryErrorsCounter.With(labels)), 0.01)
+}
+
 func TestMetricsManagerLogNodeReconnect(t *testing.T) {
 	t.Parallel()
 
@@ -139,6 +239,41 @@ func TestMetricsManagerLogNodeReconnect(t *testing.T) {
 	})), 0.01)
 }
 
+func TestMetricsManagerLogNodeFailover(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.MetricsConfig{Enabled: false}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := NewManager(logger, config)
+
+	labels := prometheus.Labels{"chain": "chain", "node": "node"}
+
+	metricsManager.LogNodeStandby("chain", "node", true)
+	metricsManager.LogNodeFailover("chain", "node")
+
+	assert.InDelta(t, 1, testutil.ToFloat64(metricsManager.nodeStandbyGauge.With(labels)), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(metricsManager.nodeFailoversCounter.With(labels)), 0.01)
+
+	metricsManager.LogNodeStandby("chain", "node", false)
+	assert.InDelta(t, 0, testutil.ToFloat64(metricsManager.nodeStandbyGauge.With(labels)), 0.01)
+}
+
+func TestMetricsManagerLogNodeStalled(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.MetricsConfig{Enabled: false}
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := NewManager(logger, config)
+
+	labels := prometheus.Labels{"chain": "chain", "node": "node"}
+
+	metricsManager.LogNodeLastBlock("chain", "node", 123)
+	metricsManager.LogNodeStalled("chain", "node", true)
+
+	assert.InDelta(t, 123, testutil.ToFloat64(metricsManager.nodeLastBlockGauge.With(labels)), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(metricsManager.nodeStalledGauge.With(labels)), 0.01)
+}
+
 func TestMetricsManagerLogReporterEnabled(t *testing.T) {
 	t.Parallel()
 
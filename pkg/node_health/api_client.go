@@ -0,0 +1,216 @@
+package node_health
+
+import (
+	"context"
+	"errors"
+	"main/pkg/http"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"main/pkg/types/responses"
+	"sort"
+	"time"
+
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TrackedApiClient wraps an API client (either REST or gRPC one),
+// recording each query result in the node health.
+type TrackedApiClient struct {
+	Client         types.ApiClient
+	Health         *NodeHealth
+	MetricsManager *metrics.Manager
+}
+
+func NewTrackedApiClient(
+	client types.ApiClient,
+	chain string,
+	url string,
+	metricsManager *metrics.Manager,
+) *TrackedApiClient {
+	return &TrackedApiClient{
+		Client:         client,
+		Health:         NewNodeHealth(chain, url),
+		MetricsManager: metricsManager,
+	}
+}
+
+// record records the query result. Queries failed because the caller's context was done
+// (for example, on shutdown) are not the node's fault, so they are not recorded.
+func (c *TrackedApiClient) record(ctx context.Context, start time.Time, err error) {
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	c.Health.Record(!IsNodeFailure(err), time.Since(start))
+	c.MetricsManager.LogApiNodeHealth(c.Health.Chain, c.Health.URL, c.Health.Status())
+}
+
+// IsNodeFailure returns whether the query error is caused by the node itself. Only transport
+// errors, timeouts and server errors are; answers like a missing tx or denom trace
+// (4xx in REST, NotFound and alike in gRPC) mean the node is working fine.
+func IsNodeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var statusErr *http.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+
+	if grpcStatus, ok := status.FromError(err); ok {
+		switch grpcStatus.Code() {
+		case codes.NotFound,
+			codes.InvalidArgument,
+			codes.FailedPrecondition,
+			codes.OutOfRange,
+			codes.AlreadyExists,
+			codes.PermissionDenied,
+			codes.Unauthenticated:
+			return false
+		default:
+			return true
+		}
+	}
+
+	return true
+}
+
+func (c *TrackedApiClient) GetValidator(ctx context.Context, address string) (*responses.Validator, error) {
+	start := time.Now()
+	validator, err := c.Client.GetValidator(ctx, address)
+	c.record(ctx, start, err)
+	return validator, err
+}
+
+func (c *TrackedApiClient) GetDelegatorsRewardsAtBlock(
+	ctx context.Context,
+	delegator string,
+	validator string,
+	block int64,
+) ([]responses.Reward, error) {
+	start := time.Now()
+	rewards, err := c.Client.GetDelegatorsRewardsAtBlock(ctx, delegator, validator, block)
+	c.record(ctx, start, err)
+	return rewards, err
+}
+
+func (c *TrackedApiClient) GetValidatorCommissionAtBlock(
+	ctx context.Context,
+	validator string,
+	block int64,
+) ([]responses.Commission, error) {
+	start := time.Now()
+	commission, err := c.Client.GetValidatorCommissionAtBlock(ctx, validator, block)
+	c.record(ctx, start, err)
+	return commission, err
+}
+
+func (c *TrackedApiClient) GetProposal(ctx context.Context, id string) (*responses.Proposal, error) {
+	start := time.Now()
+	proposal, err := c.Client.GetProposal(ctx, id)
+	c.record(ctx, start, err)
+	return proposal, err
+}
+
+func (c *TrackedApiClient) GetStakingParams(ctx context.Context) (*responses.StakingParams, error) {
+	start := time.Now()
+	params, err := c.Client.GetStakingParams(ctx)
+	c.record(ctx, start, err)
+	return params, err
+}
+
+func (c *TrackedApiClient) GetIbcChannel(
+	ctx context.Context,
+	channel string,
+	port string,
+) (*responses.IbcChannel, error) {
+	start := time.Now()
+	ibcChannel, err := c.Client.GetIbcChannel(ctx, channel, port)
+	c.record(ctx, start, err)
+	return ibcChannel, err
+}
+
+func (c *TrackedApiClient) GetIbcConnectionClientState(
+	ctx context.Context,
+	connectionID string,
+) (*responses.IbcIdentifiedClientState, error) {
+	start := time.Now()
+	clientState, err := c.Client.GetIbcConnectionClientState(ctx, connectionID)
+	c.record(ctx, start, err)
+	return clientState, err
+}
+
+func (c *TrackedApiClient) GetIbcDenomTrace(ctx context.Context, hash string) (*transferTypes.DenomTrace, error) {
+	start := time.Now()
+	denomTrace, err := c.Client.GetIbcDenomTrace(ctx, hash)
+	c.record(ctx, start, err)
+	return denomTrace, err
+}
+
+type TrackedApiClients []*TrackedApiClient
+
+// GetAvailable returns the clients which circuit breaker is not open,
+// the healthiest ones first. If the circuit breakers of all the clients are open,
+// it returns the one with the best score, so the data is still fetched
+// if the nodes have recovered before their circuit breakers timed out.
+func (c TrackedApiClients) GetAvailable() []types.ApiClient {
+	available := make(TrackedApiClients, 0, len(c))
+	scores := make(map[*TrackedApiClient]float64, len(c))
+
+	for _, client := range c {
+		if client.Health.IsAvailable() {
+			available = append(available, client)
+			scores[client] = client.Health.Score()
+		}
+	}
+
+	if len(available) == 0 {
+		if best := c.getBest(); best != nil {
+			return []types.ApiClient{best}
+		}
+
+		return []types.ApiClient{}
+	}
+
+	// stable, so nodes with the same score are queried in the config order
+	sort.SliceStable(available, func(i, j int) bool {
+		return scores[available[i]] > scores[available[j]]
+	})
+
+	clients := make([]types.ApiClient, len(available))
+	for index, client := range available {
+		clients[index] = client
+	}
+
+	return clients
+}
+
+// getBest returns the client with the highest score regardless of its circuit breaker,
+// the first one in the config order if there are several of them.
+func (c TrackedApiClients) getBest() *TrackedApiClient {
+	var (
+		best      *TrackedApiClient
+		bestScore float64
+	)
+
+	for _, client := range c {
+		if score := client.Health.Score(); best == nil || score > bestScore {
+			best = client
+			bestScore = score
+		}
+	}
+
+	return best
+}
+
+func (c TrackedApiClients) GetStatuses() map[string]types.ApiNodeStatus {
+	statuses := make(map[string]types.ApiNodeStatus, len(c))
+	for _, client := range c {
+		statuses[client.Health.URL] = client.Health.Status()
+	}
+
+	return statuses
+}
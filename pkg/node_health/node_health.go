@@ -0,0 +1,101 @@
+package node_health
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"sync"
+	"time"
+)
+
+// NodeHealth tracks the latency and error rate of an API node queries, as exponential
+// moving averages, so the recent queries matter more than the older ones. After
+// constants.ApiNodeFailuresThreshold failures in a row, the circuit breaker opens, and
+// the node is not queried until CircuitBreakerTimeout passes. After that, the node
+// is given one more chance, and if it fails again, the circuit breaker opens again.
+type NodeHealth struct {
+	Chain                 string
+	URL                   string
+	CircuitBreakerTimeout time.Duration
+
+	mutex               sync.RWMutex
+	latency             time.Duration
+	errorRate           float64
+	queries             int64
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func NewNodeHealth(chain, url string) *NodeHealth {
+	return &NodeHealth{
+		Chain:                 chain,
+		URL:                   url,
+		CircuitBreakerTimeout: constants.ApiNodeCircuitBreakerTimeout,
+	}
+}
+
+func (h *NodeHealth) Record(success bool, latency time.Duration) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	failure := 0.0
+	if !success {
+		failure = 1
+	}
+
+	if h.queries == 0 {
+		h.latency = latency
+		h.errorRate = failure
+	} else {
+		factor := constants.ApiNodeHealthSmoothingFactor
+		h.latency = time.Duration(factor*float64(latency) + (1-factor)*float64(h.latency))
+		h.errorRate = factor*failure + (1-factor)*h.errorRate
+	}
+
+	h.queries++
+
+	if success {
+		h.consecutiveFailures = 0
+		h.openUntil = time.Time{}
+		return
+	}
+
+	h.consecutiveFailures++
+	if h.consecutiveFailures >= constants.ApiNodeFailuresThreshold {
+		h.openUntil = time.Now().Add(h.CircuitBreakerTimeout)
+	}
+}
+
+// IsAvailable returns false if the circuit breaker is open and the node should not be queried.
+func (h *NodeHealth) IsAvailable() bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return !time.Now().Before(h.openUntil)
+}
+
+// Score returns the node health from 0 to 100, higher is better. It goes down
+// with errors and with latency: a node without errors and with 1s latency has score 50.
+// A node without queries yet has score 100, so it would be tried early.
+func (h *NodeHealth) Score() float64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return h.score()
+}
+
+func (h *NodeHealth) score() float64 {
+	return 100 * (1 - h.errorRate) / (1 + h.latency.Seconds())
+}
+
+func (h *NodeHealth) Status() types.ApiNodeStatus {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return types.ApiNodeStatus{
+		Score:       h.score(),
+		Latency:     h.latency,
+		ErrorRate:   h.errorRate,
+		Queries:     h.queries,
+		CircuitOpen: time.Now().Before(h.openUntil),
+	}
+}
@@ -0,0 +1,219 @@
+package node_health_test
+
+import (
+	"context"
+	"errors"
+	configPkg "main/pkg/config"
+	"main/pkg/http"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/node_health"
+	"main/pkg/types"
+	"main/pkg/types/responses"
+	"testing"
+	"time"
+
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNodeHealthRecord(t *testing.T) {
+	t.Parallel()
+
+	health := node_health.NewNodeHealth("chain", "https://example.com")
+	require.True(t, health.IsAvailable())
+	require.InDelta(t, 100, health.Score(), 0.001)
+
+	health.Record(true, time.Second)
+	status := health.Status()
+	require.Equal(t, int64(1), status.Queries)
+	require.Equal(t, time.Second, status.Latency)
+	require.InDelta(t, 0, status.ErrorRate, 0.001)
+	require.InDelta(t, 50, status.Score, 0.001)
+	require.False(t, status.CircuitOpen)
+
+	health.Record(false, 0)
+	status = health.Status()
+	require.Equal(t, int64(2), status.Queries)
+	require.Equal(t, 800*time.Millisecond, status.Latency)
+	require.InDelta(t, 0.2, status.ErrorRate, 0.001)
+	require.True(t, health.IsAvailable())
+}
+
+func TestNodeHealthCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	health := node_health.NewNodeHealth("chain", "https://example.com")
+	health.Record(false, 0)
+	health.Record(false, 0)
+	require.True(t, health.IsAvailable())
+
+	health.Record(false, 0)
+	require.False(t, health.IsAvailable())
+	require.True(t, health.Status().CircuitOpen)
+
+	health.Record(true, 0)
+	require.True(t, health.IsAvailable())
+	require.False(t, health.Status().CircuitOpen)
+}
+
+func TestNodeHealthCircuitBreakerRecovers(t *testing.T) {
+	t.Parallel()
+
+	health := node_health.NewNodeHealth("chain", "https://example.com")
+	health.CircuitBreakerTimeout = 0
+	health.Record(false, 0)
+	health.Record(false, 0)
+	health.Record(false, 0)
+	require.True(t, health.IsAvailable())
+}
+
+type StubApiClient struct {
+	types.ApiClient
+	Fail          bool
+	DenomTraceErr error
+}
+
+func (c *StubApiClient) GetStakingParams(ctx context.Context) (*responses.StakingParams, error) {
+	if c.Fail {
+		return nil, errors.New("custom error")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &responses.StakingParams{}, nil
+}
+
+func (c *StubApiClient) GetIbcDenomTrace(ctx context.Context, hash string) (*transferTypes.DenomTrace, error) {
+	if c.DenomTraceErr != nil {
+		return nil, c.DenomTraceErr
+	}
+
+	return nil, errors.New("custom error")
+}
+
+func TestTrackedApiClientsGetAvailable(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+
+	failing := node_health.NewTrackedApiClient(&StubApiClient{Fail: true}, "chain", "failing", metricsManager)
+	working := node_health.NewTrackedApiClient(&StubApiClient{}, "chain", "working", metricsManager)
+	fresh := node_health.NewTrackedApiClient(&StubApiClient{}, "chain", "fresh", metricsManager)
+	clients := node_health.TrackedApiClients{failing, working, fresh}
+
+	for i := 0; i < 3; i++ {
+		_, err := failing.GetStakingParams(context.Background())
+		require.Error(t, err)
+	}
+
+	params, err := working.GetStakingParams(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, params)
+
+	available := clients.GetAvailable()
+	require.Len(t, available, 2)
+	require.Equal(t, fresh, available[0])
+	require.Equal(t, working, available[1])
+
+	statuses := clients.GetStatuses()
+	require.Len(t, statuses, 3)
+	require.True(t, statuses["failing"].CircuitOpen)
+	require.Equal(t, int64(1), statuses["working"].Queries)
+	require.Equal(t, int64(0), statuses["fresh"].Queries)
+
+	_, err = working.GetIbcDenomTrace(context.Background(), "hash")
+	require.Error(t, err)
+	require.Equal(t, int64(2), working.Health.Status().Queries)
+}
+
+func TestTrackedApiClientsGetAvailableAllOpen(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+
+	require.Empty(t, node_health.TrackedApiClients{}.GetAvailable())
+
+	first := node_health.NewTrackedApiClient(&StubApiClient{Fail: true}, "chain", "first", metricsManager)
+	second := node_health.NewTrackedApiClient(&StubApiClient{Fail: true}, "chain", "second", metricsManager)
+	clients := node_health.TrackedApiClients{first, second}
+
+	for i := 0; i < 3; i++ {
+		_, err := first.GetStakingParams(context.Background())
+		require.Error(t, err)
+	}
+
+	// second node has a lower error rate, as it had a successful query before failing
+	second.Health.Record(true, 0)
+	for i := 0; i < 3; i++ {
+		_, err := second.GetStakingParams(context.Background())
+		require.Error(t, err)
+	}
+
+	require.False(t, first.Health.IsAvailable())
+	require.False(t, second.Health.IsAvailable())
+
+	available := clients.GetAvailable()
+	require.Len(t, available, 1)
+	require.Equal(t, second, available[0])
+}
+
+func TestTrackedApiClientCancelledNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := node_health.NewTrackedApiClient(&StubApiClient{}, "chain", "node", metricsManager)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetStakingParams(ctx)
+	require.Error(t, err)
+	require.Equal(t, int64(0), client.Health.Status().Queries)
+}
+
+func TestIsNodeFailure(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, node_health.IsNodeFailure(nil))
+	require.True(t, node_health.IsNodeFailure(errors.New("custom error")))
+	require.False(t, node_health.IsNodeFailure(&http.StatusError{StatusCode: 404}))
+	require.False(t, node_health.IsNodeFailure(&http.StatusError{StatusCode: 400}))
+	require.True(t, node_health.IsNodeFailure(&http.StatusError{StatusCode: 503}))
+	require.False(t, node_health.IsNodeFailure(status.Error(codes.NotFound, "not found")))
+	require.True(t, node_health.IsNodeFailure(status.Error(codes.Unavailable, "unavailable")))
+	require.True(t, node_health.IsNodeFailure(status.Error(codes.DeadlineExceeded, "timeout")))
+	require.True(t, node_health.IsNodeFailure(context.DeadlineExceeded))
+}
+
+func TestTrackedApiClientNotFoundNotFailure(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := node_health.NewTrackedApiClient(
+		&StubApiClient{DenomTraceErr: &http.StatusError{StatusCode: 404}},
+		"chain",
+		"node",
+		metricsManager,
+	)
+
+	// a missing denom trace is a valid answer, so it should not open the circuit breaker
+	for i := 0; i < 3; i++ {
+		_, err := client.GetIbcDenomTrace(context.Background(), "hash")
+		require.Error(t, err)
+	}
+
+	healthStatus := client.Health.Status()
+	require.Equal(t, int64(3), healthStatus.Queries)
+	require.InDelta(t, 0, healthStatus.ErrorRate, 0.001)
+	require.False(t, healthStatus.CircuitOpen)
+	require.True(t, client.Health.IsAvailable())
+}
@@ -0,0 +1,122 @@
+package nodes_manager
+
+import (
+	configTypes "main/pkg/config/types"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// StandbyNode is a node that can be listened to only when it's needed.
+type StandbyNode interface {
+	types.TendermintNode
+	ResetLastBlock()
+	SetStandby(standby bool)
+}
+
+// Failover listens only to the first chain.ActiveNodes nodes, keeping the rest as standby ones.
+// When an active node stalls, it is stopped and replaced with the next standby node,
+// going to the end of the standby nodes line itself.
+type Failover struct {
+	Logger         zerolog.Logger
+	Chain          *configTypes.Chain
+	MetricsManager *metricsPkg.Manager
+	Nodes          []StandbyNode
+	Active         []bool
+
+	// guards Active, as nodes are replaced from the stall detector goroutine
+	mutex sync.Mutex
+}
+
+func NewFailover(
+	logger *zerolog.Logger,
+	chain *configTypes.Chain,
+	nodes []StandbyNode,
+	metricsManager *metricsPkg.Manager,
+) *Failover {
+	return &Failover{
+		Logger: logger.With().
+			Str("component", "failover").
+			Str("chain", chain.Name).
+			Logger(),
+		Chain:          chain,
+		MetricsManager: metricsManager,
+		Nodes:          nodes,
+		Active:         make([]bool, len(nodes)),
+	}
+}
+
+func (f *Failover) Init() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for index := range f.Nodes {
+		if index < f.Chain.ActiveNodes {
+			f.promote(index)
+		} else {
+			f.Nodes[index].SetStandby(true)
+			f.MetricsManager.LogNodeStandby(f.Chain.Name, f.Nodes[index].GetURL(), true)
+		}
+	}
+}
+
+// Replace stops the given active node and starts the next standby one instead,
+// returning false if there are no standby nodes left.
+func (f *Failover) Replace(index int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	node := f.Nodes[index]
+
+	standbyIndex, found := f.findStandby(index)
+	if !found {
+		f.Logger.Warn().
+			Str("node", node.GetURL()).
+			Msg("Node has stalled, but there are no standby nodes to replace it")
+		return false
+	}
+
+	f.Logger.Warn().
+		Str("node", node.GetURL()).
+		Str("standby_node", f.Nodes[standbyIndex].GetURL()).
+		Msg("Node has stalled, replacing it with a standby one")
+
+	f.demote(index)
+	f.promote(standbyIndex)
+	f.MetricsManager.LogNodeFailover(f.Chain.Name, node.GetURL())
+	return true
+}
+
+// findStandby returns the first standby node after the given one, wrapping around,
+// so the nodes that have stalled recently are tried last.
+func (f *Failover) findStandby(after int) (int, bool) {
+	for offset := 1; offset < len(f.Nodes); offset++ {
+		index := (after + offset) % len(f.Nodes)
+		if !f.Active[index] {
+			return index, true
+		}
+	}
+
+	return 0, false
+}
+
+func (f *Failover) promote(index int) {
+	node := f.Nodes[index]
+	// so the node is not considered stalled before it starts listening
+	node.ResetLastBlock()
+	node.SetStandby(false)
+	f.Active[index] = true
+	f.MetricsManager.LogNodeStandby(f.Chain.Name, node.GetURL(), false)
+
+	go node.Listen()
+}
+
+func (f *Failover) demote(index int) {
+	node := f.Nodes[index]
+	node.Stop()
+	node.SetStandby(true)
+	f.Active[index] = false
+	f.MetricsManager.LogNodeStandby(f.Chain.Name, node.GetURL(), true)
+}
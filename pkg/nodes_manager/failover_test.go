@@ -0,0 +1,118 @@
+package nodes_manager
+
+import (
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type StubNode struct {
+	URL       string
+	Height    int64
+	BlockTime time.Time
+	Standby   bool
+	Channel   chan types.Report
+
+	mutex     sync.Mutex
+	listening bool
+}
+
+func (n *StubNode) Listen() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.listening = true
+}
+
+func (n *StubNode) Stop() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.listening = false
+}
+
+func (n *StubNode) IsListening() bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.listening
+}
+
+func (n *StubNode) Status() types.TendermintRPCStatus {
+	return types.TendermintRPCStatus{Standby: n.Standby}
+}
+
+func (n *StubNode) GetURL() string                   { return n.URL }
+func (n *StubNode) GetChannel() chan types.Report    { return n.Channel }
+func (n *StubNode) GetLastBlock() (int64, time.Time) { return n.Height, n.BlockTime }
+func (n *StubNode) ResetLastBlock()                  { n.BlockTime = time.Now() }
+func (n *StubNode) IsStandby() bool                  { return n.Standby }
+func (n *StubNode) SetStandby(standby bool)          { n.Standby = standby }
+
+func getFailover(nodes ...*StubNode) *Failover {
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+
+	standbyNodes := make([]StandbyNode, len(nodes))
+	for index, node := range nodes {
+		standbyNodes[index] = node
+	}
+
+	return NewFailover(
+		logger,
+		&configTypes.Chain{Name: "chain", ActiveNodes: 1, StallTimeout: time.Minute},
+		standbyNodes,
+		metricsManager,
+	)
+}
+
+func TestFailoverInit(t *testing.T) {
+	t.Parallel()
+
+	node1 := &StubNode{URL: "node1"}
+	node2 := &StubNode{URL: "node2"}
+	failover := getFailover(node1, node2)
+	failover.Init()
+
+	require.Eventually(t, node1.IsListening, time.Second, 10*time.Millisecond)
+	require.False(t, node1.Standby)
+	require.False(t, node1.BlockTime.IsZero())
+	require.False(t, node2.IsListening())
+	require.True(t, node2.Standby)
+	require.Equal(t, []bool{true, false}, failover.Active)
+}
+
+func TestFailoverReplace(t *testing.T) {
+	t.Parallel()
+
+	node1 := &StubNode{URL: "node1"}
+	node2 := &StubNode{URL: "node2"}
+	node3 := &StubNode{URL: "node3"}
+	failover := getFailover(node1, node2, node3)
+	failover.Init()
+
+	require.True(t, failover.Replace(0))
+	require.Equal(t, []bool{false, true, false}, failover.Active)
+	require.True(t, node1.Standby)
+	require.False(t, node2.Standby)
+	require.Eventually(t, node2.IsListening, time.Second, 10*time.Millisecond)
+
+	// the stalled node goes to the end of the line
+	require.True(t, failover.Replace(1))
+	require.Equal(t, []bool{false, false, true}, failover.Active)
+}
+
+func TestFailoverReplaceNoStandbyNodes(t *testing.T) {
+	t.Parallel()
+
+	node1 := &StubNode{URL: "node1"}
+	failover := getFailover(node1)
+	failover.Init()
+
+	require.False(t, failover.Replace(0))
+	require.Equal(t, []bool{true}, failover.Active)
+}
@@ -1,11 +1,13 @@
 package nodes_manager
 
 import (
+	"main/pkg/constants"
 	metricsPkg "main/pkg/metrics"
 	"main/pkg/types"
 	"sync"
 
 	"main/pkg/config"
+	"main/pkg/tendermint/poll"
 	"main/pkg/tendermint/ws"
 
 	"github.com/rs/zerolog"
@@ -13,7 +15,9 @@ import (
 
 type NodesManager struct {
 	Logger         zerolog.Logger
-	Nodes          map[string][]*ws.TendermintWebsocketClient
+	Nodes          map[string][]types.TendermintNode
+	Failovers      map[string]*Failover
+	StallDetectors map[string]*StallDetector
 	MetricsManager *metricsPkg.Manager
 
 	Channel chan types.Report
@@ -25,18 +29,54 @@ func NewNodesManager(
 	logger *zerolog.Logger,
 	config *config.AppConfig,
 	metricsManager *metricsPkg.Manager,
+	lastHeightProvider ws.LastHeightProvider,
 ) *NodesManager {
-	nodes := make(map[string][]*ws.TendermintWebsocketClient, len(config.Chains))
+	nodes := make(map[string][]types.TendermintNode, len(config.Chains))
+	failovers := make(map[string]*Failover)
+	stallDetectors := make(map[string]*StallDetector)
 
 	for _, chain := range config.Chains {
-		nodes[chain.Name] = make([]*ws.TendermintWebsocketClient, len(chain.TendermintNodes))
+		nodes[chain.Name] = make([]types.TendermintNode, len(chain.TendermintNodes))
 
 		for index, node := range chain.TendermintNodes {
+			if chain.IsPolling() {
+				nodes[chain.Name][index] = poll.NewTendermintPollClient(
+					logger,
+					node,
+					chain,
+					metricsManager,
+					config.Timeouts,
+					lastHeightProvider,
+				)
+				continue
+			}
+
 			nodes[chain.Name][index] = ws.NewTendermintClient(
 				logger,
 				node,
 				chain,
 				metricsManager,
+				config.Timeouts,
+				lastHeightProvider,
+			)
+		}
+
+		if chain.HasStandbyNodes() {
+			standbyNodes := make([]StandbyNode, len(nodes[chain.Name]))
+			for index, node := range nodes[chain.Name] {
+				standbyNodes[index], _ = node.(StandbyNode)
+			}
+
+			failovers[chain.Name] = NewFailover(logger, chain, standbyNodes, metricsManager)
+		}
+
+		if chain.StallTimeout > 0 {
+			stallDetectors[chain.Name] = NewStallDetector(
+				logger,
+				chain,
+				nodes[chain.Name],
+				failovers[chain.Name],
+				metricsManager,
 			)
 		}
 	}
@@ -45,13 +85,24 @@ func NewNodesManager(
 		Logger:         logger.With().Str("component", "nodes_manager").Logger(),
 		MetricsManager: metricsManager,
 		Nodes:          nodes,
-		Channel:        make(chan types.Report),
+		Failovers:      failovers,
+		StallDetectors: stallDetectors,
+		Channel:        make(chan types.Report, constants.ReportQueueSize),
 		Queue:          NewReportQueue(100),
 	}
 }
 
 func (m *NodesManager) Listen() {
-	for _, chain := range m.Nodes {
+	for chainName, chain := range m.Nodes {
+		if stallDetector, ok := m.StallDetectors[chainName]; ok {
+			go stallDetector.Start()
+		}
+
+		if failover, ok := m.Failovers[chainName]; ok {
+			failover.Init()
+			continue
+		}
+
 		for _, node := range chain {
 			go node.Listen()
 		}
@@ -71,17 +122,22 @@ func (m *NodesManager) Listen() {
 						continue
 					}
 
-					m.Channel <- msg
 					m.Queue.Add(msg)
-
 					m.Mutex.Unlock()
+
+					// Sending outside the lock, so a full channel blocks only this node.
+					m.Channel <- msg
 				}
-			}(node.Channel)
+			}(node.GetChannel())
 		}
 	}
 }
 
 func (m *NodesManager) Stop() {
+	for _, stallDetector := range m.StallDetectors {
+		stallDetector.Stop()
+	}
+
 	for _, chain := range m.Nodes {
 		for _, node := range chain {
 			node.Stop()
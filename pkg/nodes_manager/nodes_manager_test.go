@@ -5,8 +5,11 @@ import (
 	"main/pkg/config/types"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
+	"main/pkg/tendermint/poll"
+	"main/pkg/tendermint/ws"
 	types2 "main/pkg/types"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/require"
@@ -26,14 +29,14 @@ func TestNodesManagerReceive(t *testing.T) {
 
 	logger := loggerPkg.GetNopLogger()
 	metricsManager := metrics.NewManager(logger, config.Metrics)
-	nodesManager := NewNodesManager(logger, config, metricsManager)
+	nodesManager := NewNodesManager(logger, config, metricsManager, nil)
 
 	go nodesManager.Listen()
 	defer nodesManager.Stop()
 
 	reportable := &types2.Tx{Hash: types.Link{Value: "123"}}
 
-	nodesManager.Nodes["chain"][0].Channel <- types2.Report{
+	nodesManager.Nodes["chain"][0].GetChannel() <- types2.Report{
 		Chain:      config.Chains[0],
 		Reportable: reportable,
 	}
@@ -42,8 +45,67 @@ func TestNodesManagerReceive(t *testing.T) {
 
 	require.Equal(t, "123", received.Reportable.GetHash())
 
-	nodesManager.Nodes["chain"][0].Channel <- types2.Report{
+	nodesManager.Nodes["chain"][0].GetChannel() <- types2.Report{
 		Chain:      config.Chains[0],
 		Reportable: reportable,
 	}
 }
+
+func TestNodesManagerPollMode(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{Name: "chain", TendermintNodes: []string{"example"}},
+			{Name: "chain2", TendermintNodes: []string{"example"}, Mode: "poll"},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	nodesManager := NewNodesManager(logger, config, metricsManager, nil)
+
+	require.IsType(t, &ws.TendermintWebsocketClient{}, nodesManager.Nodes["chain"][0])
+	require.IsType(t, &poll.TendermintPollClient{}, nodesManager.Nodes["chain2"][0])
+}
+
+func TestNodesManagerStandbyNodes(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{Name: "chain", TendermintNodes: []string{"example1", "example2"}, ActiveNodes: 1},
+			{Name: "chain2", TendermintNodes: []string{"example1", "example2"}, ActiveNodes: 2},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	nodesManager := NewNodesManager(logger, config, metricsManager, nil)
+
+	require.Len(t, nodesManager.Failovers, 1)
+	require.Len(t, nodesManager.Failovers["chain"].Nodes, 2)
+	require.NotNil(t, nodesManager.Failovers["chain"].Nodes[0])
+	require.Empty(t, nodesManager.StallDetectors)
+}
+
+func TestNodesManagerStallDetectors(t *testing.T) {
+	t.Parallel()
+
+	config := &configPkg.AppConfig{
+		Chains: types.Chains{
+			{Name: "chain", TendermintNodes: []string{"example1"}, StallTimeout: time.Minute},
+			{Name: "chain2", TendermintNodes: []string{"example1"}},
+		},
+		Metrics: configPkg.MetricsConfig{Enabled: false},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.Metrics)
+	nodesManager := NewNodesManager(logger, config, metricsManager, nil)
+
+	require.Len(t, nodesManager.StallDetectors, 1)
+	require.Nil(t, nodesManager.StallDetectors["chain"].Failover)
+}
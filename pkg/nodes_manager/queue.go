@@ -5,30 +5,36 @@ import (
 	"sync"
 )
 
+// ReportQueue keeps hashes of the last reports received, to not send
+// the same report twice if it is received from multiple nodes.
 type ReportQueue struct {
-	Data  []types.Report
+	Data  []string
 	Size  int
 	Mutes sync.Mutex
 }
 
 func NewReportQueue(size int) ReportQueue {
-	return ReportQueue{Data: make([]types.Report, 0), Size: size}
+	return ReportQueue{Data: make([]string, 0), Size: size}
 }
 
 func (q *ReportQueue) Add(report types.Report) {
+	q.AddHash(report.Reportable.GetHash())
+}
+
+func (q *ReportQueue) AddHash(hash string) {
 	q.Mutes.Lock()
 
 	if len(q.Data) >= q.Size {
 		_, q.Data = q.Data[0], q.Data[1:]
 	}
 
-	q.Data = append(q.Data, report)
+	q.Data = append(q.Data, hash)
 	q.Mutes.Unlock()
 }
 
 func (q *ReportQueue) Has(msg types.Report) bool {
-	for _, elem := range q.Data {
-		if elem.Reportable.GetHash() == msg.Reportable.GetHash() {
+	for _, hash := range q.Data {
+		if hash == msg.Reportable.GetHash() {
 			return true
 		}
 	}
@@ -24,3 +24,13 @@ func TestQueueAdd(t *testing.T) {
 	require.True(t, queue.Has(report2))
 	require.False(t, queue.Has(report1))
 }
+
+func TestQueueAddHash(t *testing.T) {
+	t.Parallel()
+
+	queue := nodesManagerPkg.NewReportQueue(2)
+	queue.AddHash("123")
+
+	require.True(t, queue.Has(types.Report{Reportable: &types.Tx{Hash: configTypes.Link{Value: "123"}}}))
+	require.False(t, queue.Has(types.Report{Reportable: &types.Tx{Hash: configTypes.Link{Value: "456"}}}))
+}
@@ -0,0 +1,168 @@
+package nodes_manager
+
+import (
+	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// StallDetector checks whether the chain's nodes keep receiving new blocks.
+// A node is considered stalled if it had no new blocks for chain.StallTimeout,
+// or if it is more than chain.MaxBlocksBehind blocks behind the other nodes.
+// A NodeStalled report is sent once when a node stalls, and if the chain has
+// standby nodes, the stalled one is replaced with one of them.
+type StallDetector struct {
+	Logger         zerolog.Logger
+	Chain          *configTypes.Chain
+	MetricsManager *metricsPkg.Manager
+	Nodes          []types.TendermintNode
+	Failover       *Failover
+	CheckInterval  time.Duration
+
+	stalled     map[string]bool
+	stopChannel chan bool
+}
+
+func NewStallDetector(
+	logger *zerolog.Logger,
+	chain *configTypes.Chain,
+	nodes []types.TendermintNode,
+	failover *Failover,
+	metricsManager *metricsPkg.Manager,
+) *StallDetector {
+	return &StallDetector{
+		Logger: logger.With().
+			Str("component", "stall_detector").
+			Str("chain", chain.Name).
+			Logger(),
+		Chain:          chain,
+		MetricsManager: metricsManager,
+		Nodes:          nodes,
+		Failover:       failover,
+		CheckInterval:  constants.NodeStallCheckInterval,
+		stalled:        make(map[string]bool, len(nodes)),
+		stopChannel:    make(chan bool),
+	}
+}
+
+func (d *StallDetector) Start() {
+	ticker := time.NewTicker(d.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.Check()
+		case <-d.stopChannel:
+			return
+		}
+	}
+}
+
+func (d *StallDetector) Stop() {
+	close(d.stopChannel)
+}
+
+func (d *StallDetector) Check() {
+	var maxHeight int64
+	for _, node := range d.Nodes {
+		if height, _ := node.GetLastBlock(); height > maxHeight && !node.IsStandby() {
+			maxHeight = height
+		}
+	}
+
+	for index, node := range d.Nodes {
+		if node.IsStandby() {
+			d.SetStalled(node, false)
+			continue
+		}
+
+		stalled := d.GetStalled(node, maxHeight)
+		if stalled == nil {
+			if d.stalled[node.GetURL()] {
+				d.Logger.Info().Str("node", node.GetURL()).Msg("Node is not stalled anymore")
+			}
+
+			d.SetStalled(node, false)
+			continue
+		}
+
+		if !d.stalled[node.GetURL()] {
+			d.Logger.Warn().
+				Str("node", node.GetURL()).
+				Int64("height", stalled.Height).
+				Int64("blocks_behind", stalled.BlocksBehind).
+				Dur("since_last_block", stalled.SinceLastBlock).
+				Msg("Node has stalled")
+
+			d.SetStalled(node, true)
+			d.SendReport(node.GetChannel(), types.Report{
+				Chain:      d.Chain,
+				Node:       node.GetURL(),
+				Reportable: stalled,
+			})
+		}
+
+		// retrying on each check, as there might be no standby nodes available the first time
+		if d.Failover != nil && d.Failover.Replace(index) {
+			d.SetStalled(node, false)
+		}
+	}
+}
+
+// SendReport sends the report without blocking the checks: the node's channel is not read
+// while the app is busy or stopping, and the failover should not wait for it. If the report
+// cannot be sent right away, it is sent in the background, unless the detector is stopped.
+func (d *StallDetector) SendReport(channel chan types.Report, report types.Report) {
+	select {
+	case channel <- report:
+		return
+	default:
+	}
+
+	go func() {
+		select {
+		case channel <- report:
+		case <-d.stopChannel:
+		}
+	}()
+}
+
+// GetStalled returns a NodeStalled if the node has stalled, or nil otherwise.
+func (d *StallDetector) GetStalled(node types.TendermintNode, maxHeight int64) *types.NodeStalled {
+	height, lastBlockTime := node.GetLastBlock()
+	if lastBlockTime.IsZero() {
+		return nil
+	}
+
+	sinceLastBlock := time.Since(lastBlockTime)
+
+	// a node that has not received any blocks yet cannot be compared with others
+	var blocksBehind int64
+	if height > 0 {
+		blocksBehind = maxHeight - height
+	}
+
+	isBehind := d.Chain.MaxBlocksBehind > 0 && blocksBehind > d.Chain.MaxBlocksBehind
+	if sinceLastBlock < d.Chain.StallTimeout && !isBehind {
+		return nil
+	}
+
+	return &types.NodeStalled{
+		Chain:          d.Chain.GetName(),
+		URL:            node.GetURL(),
+		Height:         height,
+		LastBlockTime:  lastBlockTime,
+		BlocksBehind:   blocksBehind,
+		SinceLastBlock: sinceLastBlock,
+	}
+}
+
+func (d *StallDetector) SetStalled(node types.TendermintNode, stalled bool) {
+	d.stalled[node.GetURL()] = stalled
+	d.MetricsManager.LogNodeStalled(d.Chain.Name, node.GetURL(), stalled)
+}
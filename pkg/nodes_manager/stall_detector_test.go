@@ -0,0 +1,151 @@
+package nodes_manager
+
+import (
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func getStallDetector(failover *Failover, nodes ...*StubNode) *StallDetector {
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+
+	tendermintNodes := make([]types.TendermintNode, len(nodes))
+	for index, node := range nodes {
+		tendermintNodes[index] = node
+	}
+
+	return NewStallDetector(
+		logger,
+		&configTypes.Chain{Name: "chain", StallTimeout: time.Minute, MaxBlocksBehind: 10},
+		tendermintNodes,
+		failover,
+		metricsManager,
+	)
+}
+
+func TestStallDetectorGetStalled(t *testing.T) {
+	t.Parallel()
+
+	detector := getStallDetector(nil)
+
+	// not started yet
+	require.Nil(t, detector.GetStalled(&StubNode{}, 100))
+
+	// ok
+	require.Nil(t, detector.GetStalled(&StubNode{Height: 95, BlockTime: time.Now()}, 100))
+
+	// no blocks received yet after connecting
+	require.Nil(t, detector.GetStalled(&StubNode{BlockTime: time.Now()}, 100))
+
+	// too far behind
+	stalled := detector.GetStalled(&StubNode{URL: "node", Height: 80, BlockTime: time.Now()}, 100)
+	require.NotNil(t, stalled)
+	require.Equal(t, "node", stalled.URL)
+	require.Equal(t, int64(80), stalled.Height)
+	require.Equal(t, int64(20), stalled.BlocksBehind)
+
+	// no new blocks for too long
+	stalled = detector.GetStalled(&StubNode{Height: 100, BlockTime: time.Now().Add(-2 * time.Minute)}, 100)
+	require.NotNil(t, stalled)
+	require.Zero(t, stalled.BlocksBehind)
+	require.GreaterOrEqual(t, stalled.SinceLastBlock, 2*time.Minute)
+}
+
+func TestStallDetectorCheck(t *testing.T) {
+	t.Parallel()
+
+	node1 := &StubNode{URL: "node1", Height: 100, BlockTime: time.Now(), Channel: make(chan types.Report, 1)}
+	node2 := &StubNode{URL: "node2", Height: 80, BlockTime: time.Now(), Channel: make(chan types.Report, 1)}
+	node3 := &StubNode{URL: "node3", Height: 50, BlockTime: time.Now(), Standby: true}
+	detector := getStallDetector(nil, node1, node2, node3)
+
+	detector.Check()
+	require.Empty(t, node1.Channel)
+	require.Len(t, node2.Channel, 1)
+
+	report := <-node2.Channel
+	stalled, ok := report.Reportable.(*types.NodeStalled)
+	require.True(t, ok)
+	require.Equal(t, "node2", stalled.URL)
+	require.Equal(t, int64(20), stalled.BlocksBehind)
+	require.True(t, detector.stalled["node2"])
+	require.False(t, detector.stalled["node3"])
+
+	// reported only once
+	detector.Check()
+	require.Empty(t, node2.Channel)
+
+	node2.Height = 100
+	detector.Check()
+	require.False(t, detector.stalled["node2"])
+}
+
+func TestStallDetectorCheckWithFailover(t *testing.T) {
+	t.Parallel()
+
+	node1 := &StubNode{URL: "node1", Channel: make(chan types.Report, 1)}
+	node2 := &StubNode{URL: "node2", Channel: make(chan types.Report, 1)}
+	failover := getFailover(node1, node2)
+	failover.Init()
+
+	detector := getStallDetector(failover, node1, node2)
+	node1.BlockTime = time.Now().Add(-2 * time.Minute)
+	detector.Check()
+
+	require.Len(t, node1.Channel, 1)
+	require.True(t, node1.Standby)
+	require.False(t, node2.Standby)
+	require.False(t, detector.stalled["node1"])
+	require.Equal(t, []bool{false, true}, failover.Active)
+}
+
+func TestStallDetectorStartStop(t *testing.T) {
+	t.Parallel()
+
+	detector := getStallDetector(nil, &StubNode{URL: "node1"})
+	detector.CheckInterval = 10 * time.Millisecond
+
+	done := make(chan bool)
+	go func() {
+		detector.Start()
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	detector.Stop()
+	<-done
+}
+
+func TestStallDetectorCheckNotBlocking(t *testing.T) {
+	t.Parallel()
+
+	node1 := &StubNode{URL: "node1", Channel: make(chan types.Report)}
+	node2 := &StubNode{URL: "node2", Channel: make(chan types.Report)}
+	failover := getFailover(node1, node2)
+	failover.Init()
+
+	// nobody reads the channel, yet the failover is done
+	detector := getStallDetector(failover, node1, node2)
+	node1.BlockTime = time.Now().Add(-2 * time.Minute)
+	detector.Check()
+	require.True(t, node1.Standby)
+	require.False(t, node2.Standby)
+
+	// the report is sent once the channel is read
+	select {
+	case report := <-node1.Channel:
+		_, ok := report.Reportable.(*types.NodeStalled)
+		require.True(t, ok)
+	case <-time.After(time.Second):
+		require.Fail(t, "report was not sent")
+	}
+
+	detector.Stop()
+}
@@ -0,0 +1,357 @@
+package outbox
+
+import (
+	"io"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	metricsPkg "main/pkg/metrics"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+)
+
+// Message is a message that a reporter failed to send and that is going to be retried.
+type Message struct {
+	ID            int64     `yaml:"id"`
+	Text          string    `yaml:"text"`
+	CreatedAt     time.Time `yaml:"created-at"`
+	Attempts      int       `yaml:"attempts"`
+	NextAttemptAt time.Time `yaml:"next-attempt-at"`
+	LastError     string    `yaml:"last-error"`
+}
+
+// Outbox stores messages that reporters failed to send, by reporter name,
+// so they can be retried later, and persists them between restarts.
+type Outbox struct {
+	Logger         zerolog.Logger
+	Path           string
+	FS             fs.FS
+	MetricsManager *metricsPkg.Manager
+
+	messages map[string][]*Message
+	lastID   int64
+	dirty    bool
+	stopped  bool
+	mutex    sync.Mutex
+
+	stopChannel chan bool
+}
+
+func NewOutbox(
+	logger *zerolog.Logger,
+	config *config.AppConfig,
+	fs fs.FS,
+	metricsManager *metricsPkg.Manager,
+) *Outbox {
+	return &Outbox{
+		Logger:         logger.With().Str("component", "outbox").Logger(),
+		Path:           config.OutboxPath,
+		FS:             fs,
+		MetricsManager: metricsManager,
+		messages:       map[string][]*Message{},
+		stopChannel:    make(chan bool),
+	}
+}
+
+func (o *Outbox) Enabled() bool {
+	return o.Path != ""
+}
+
+// Load reads the pending messages from disk. If they cannot be read (for example,
+// on the first start), the app starts with an empty outbox.
+func (o *Outbox) Load() {
+	if !o.Enabled() {
+		o.Logger.Warn().Msg("Outbox path not set, messages failed to send would not survive restarts")
+		return
+	}
+
+	outboxBytes, err := o.FS.ReadFile(o.Path)
+	if err != nil {
+		o.Logger.Warn().Err(err).Msg("Could not load outbox, starting with an empty one")
+		return
+	}
+
+	var messages map[string][]*Message
+	if err = yaml.Unmarshal(outboxBytes, &messages); err != nil {
+		o.Logger.Error().Err(err).Msg("Could not decode outbox, starting with an empty one")
+		return
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	count := 0
+
+	for reporter, reporterMessages := range messages {
+		o.messages[reporter] = reporterMessages
+		count += len(reporterMessages)
+
+		for _, message := range reporterMessages {
+			if message.ID > o.lastID {
+				o.lastID = message.ID
+			}
+		}
+
+		o.MetricsManager.LogOutboxPending(reporter, len(reporterMessages))
+	}
+
+	o.Logger.Info().Int("messages", count).Msg("Outbox loaded")
+}
+
+// Save writes the pending messages to disk if they have changed since they were last saved.
+func (o *Outbox) Save() error {
+	if !o.Enabled() {
+		return nil
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.dirty {
+		return nil
+	}
+
+	if err := fs.WriteFileAtomically(o.FS, o.Path, func(writer io.Writer) error {
+		return yaml.NewEncoder(writer).Encode(o.messages)
+	}); err != nil {
+		o.Logger.Error().Err(err).Msg("Could not save outbox")
+		return err
+	}
+
+	o.dirty = false
+	return nil
+}
+
+// Listen periodically saves the outbox, so that it is not written on each change.
+func (o *Outbox) Listen() {
+	ticker := time.NewTicker(constants.OutboxSaveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = o.Save()
+		case <-o.stopChannel:
+			return
+		}
+	}
+}
+
+// Stop stops periodic saving and saves the outbox for the last time. Messages added
+// after it are lost, as they would not be saved anyway.
+func (o *Outbox) Stop() {
+	o.mutex.Lock()
+	o.stopped = true
+	o.mutex.Unlock()
+
+	close(o.stopChannel)
+	_ = o.Save()
+}
+
+// Add stores a message a reporter failed to send. If retryAfter is set (for example,
+// when the reporter was rate-limited), the message is not retried before it passes.
+func (o *Outbox) Add(reporter string, text string, sendErr error, retryAfter time.Duration) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.stopped {
+		o.logLost(reporter, sendErr)
+		return
+	}
+
+	o.lastID++
+
+	now := time.Now()
+	message := &Message{
+		ID:        o.lastID,
+		Text:      text,
+		CreatedAt: now,
+		Attempts:  1,
+		LastError: sendErr.Error(),
+	}
+	message.NextAttemptAt = now.Add(GetRetryDelay(message.Attempts, retryAfter))
+
+	o.messages[reporter] = append(o.messages[reporter], message)
+	o.dirty = true
+
+	o.MetricsManager.LogOutboxPending(reporter, len(o.messages[reporter]))
+}
+
+// Queue stores a message a reporter has not tried to send yet, as there are older messages
+// waiting to be sent before it. It is sent as soon as they are.
+func (o *Outbox) Queue(reporter string, text string, reason error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.stopped {
+		o.logLost(reporter, reason)
+		return
+	}
+
+	o.lastID++
+
+	now := time.Now()
+	o.messages[reporter] = append(o.messages[reporter], &Message{
+		ID:            o.lastID,
+		Text:          text,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+		LastError:     reason.Error(),
+	})
+	o.dirty = true
+
+	o.MetricsManager.LogOutboxPending(reporter, len(o.messages[reporter]))
+}
+
+// HasPending returns whether there are messages waiting to be sent by a reporter.
+func (o *Outbox) HasPending(reporter string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return len(o.messages[reporter]) > 0
+}
+
+// GetPending returns all the messages waiting to be sent by a reporter, oldest first.
+func (o *Outbox) GetPending(reporter string) []Message {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	messages := make([]Message, len(o.messages[reporter]))
+	for index, message := range o.messages[reporter] {
+		messages[index] = *message
+	}
+
+	return messages
+}
+
+// GetDue returns the messages of a reporter that should be retried now, oldest first,
+// up to the first one that should not, so messages are sent in the order they were added.
+// Messages that were not sent within constants.OutboxMaxAge are dropped.
+func (o *Outbox) GetDue(reporter string) []Message {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	now := time.Now()
+	messages := make([]Message, 0)
+	pending := make([]*Message, 0, len(o.messages[reporter]))
+	blocked := false
+
+	for _, message := range o.messages[reporter] {
+		if now.Sub(message.CreatedAt) > constants.OutboxMaxAge {
+			o.Logger.Warn().
+				Str("reporter", reporter).
+				Int64("id", message.ID).
+				Int("attempts", message.Attempts).
+				Str("last_error", message.LastError).
+				Msg("Could not send message for too long, dropping it")
+			o.dirty = true
+			continue
+		}
+
+		pending = append(pending, message)
+
+		if message.NextAttemptAt.After(now) {
+			blocked = true
+		} else if !blocked {
+			messages = append(messages, *message)
+		}
+	}
+
+	o.setPending(reporter, pending)
+	return messages
+}
+
+// MarkSent removes a message that was sent successfully.
+func (o *Outbox) MarkSent(reporter string, id int64) {
+	o.remove(reporter, id)
+}
+
+// Drop removes a message that is never going to be sent, so it should not be retried.
+func (o *Outbox) Drop(reporter string, id int64) {
+	o.remove(reporter, id)
+}
+
+func (o *Outbox) remove(reporter string, id int64) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	pending := make([]*Message, 0, len(o.messages[reporter]))
+	for _, message := range o.messages[reporter] {
+		if message.ID != id {
+			pending = append(pending, message)
+		}
+	}
+
+	o.setPending(reporter, pending)
+	o.dirty = true
+}
+
+// MarkFailed schedules the next attempt to send a message that failed to be sent again.
+func (o *Outbox) MarkFailed(reporter string, id int64, sendErr error, retryAfter time.Duration) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	for _, message := range o.messages[reporter] {
+		if message.ID == id {
+			message.Attempts++
+			message.LastError = sendErr.Error()
+			message.NextAttemptAt = time.Now().Add(GetRetryDelay(message.Attempts, retryAfter))
+			o.dirty = true
+			return
+		}
+	}
+}
+
+// Flush makes all the messages of a reporter to be retried right away,
+// returning the count of them.
+func (o *Outbox) Flush(reporter string) int {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	now := time.Now()
+	for _, message := range o.messages[reporter] {
+		message.NextAttemptAt = now
+	}
+
+	o.dirty = true
+	return len(o.messages[reporter])
+}
+
+func (o *Outbox) logLost(reporter string, sendErr error) {
+	o.Logger.Error().
+		Err(sendErr).
+		Str("reporter", reporter).
+		Msg("Could not send message, and the outbox is stopped, so it is lost")
+}
+
+func (o *Outbox) setPending(reporter string, pending []*Message) {
+	if len(pending) == 0 {
+		delete(o.messages, reporter)
+	} else {
+		o.messages[reporter] = pending
+	}
+
+	o.MetricsManager.LogOutboxPending(reporter, len(pending))
+}
+
+// GetRetryDelay returns how long to wait before the next attempt to send a message,
+// doubling with each attempt, but not less than retryAfter if it is set.
+func GetRetryDelay(attempts int, retryAfter time.Duration) time.Duration {
+	delay := constants.OutboxRetryMinDelay
+	for attempt := 1; attempt < attempts && delay < constants.OutboxRetryMaxDelay; attempt++ {
+		delay *= 2
+	}
+
+	if delay > constants.OutboxRetryMaxDelay {
+		delay = constants.OutboxRetryMaxDelay
+	}
+
+	if retryAfter > delay {
+		return retryAfter
+	}
+
+	return delay
+}
@@ -0,0 +1,186 @@
+package outbox_test
+
+import (
+	"bytes"
+	"errors"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/outbox"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type BufferFile struct {
+	bytes.Buffer
+}
+
+func (file *BufferFile) Sync() error {
+	return nil
+}
+
+func (file *BufferFile) Close() error {
+	return nil
+}
+
+type BufferFs struct {
+	File *BufferFile
+}
+
+func (filesystem *BufferFs) ReadFile(name string) ([]byte, error) {
+	return filesystem.File.Bytes(), nil
+}
+
+func (filesystem *BufferFs) Create(path string) (fs.File, error) {
+	filesystem.File = &BufferFile{}
+	return filesystem.File, nil
+}
+
+func (filesystem *BufferFs) Rename(oldPath, newPath string) error {
+	return nil
+}
+
+func newOutbox(path string, filesystem fs.FS) *outbox.Outbox {
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{OutboxPath: path}
+	return outbox.NewOutbox(logger, config, filesystem, metricsPkg.NewManager(logger, config.Metrics))
+}
+
+func TestOutboxLoadDisabled(t *testing.T) {
+	t.Parallel()
+
+	outbox := newOutbox("", &fs.MockFs{})
+	require.False(t, outbox.Enabled())
+
+	outbox.Load()
+	require.Empty(t, outbox.GetPending("reporter"))
+
+	outbox.Add("reporter", "message", errors.New("error"), 0)
+	require.NoError(t, outbox.Save())
+}
+
+func TestOutboxLoadFailed(t *testing.T) {
+	t.Parallel()
+
+	outbox := newOutbox("nonexistent.yml", &fs.MockFs{})
+	outbox.Load()
+	require.Empty(t, outbox.GetPending("reporter"))
+}
+
+func TestOutboxLoadInvalidYaml(t *testing.T) {
+	t.Parallel()
+
+	outbox := newOutbox("invalid-yaml.yml", &fs.MockFs{})
+	outbox.Load()
+	require.Empty(t, outbox.GetPending("reporter"))
+}
+
+func TestOutboxLoadOk(t *testing.T) {
+	t.Parallel()
+
+	outbox := newOutbox("outbox.yml", &fs.MockFs{})
+	outbox.Load()
+
+	messages := outbox.GetPending("reporter")
+	require.Len(t, messages, 2)
+	require.Equal(t, int64(1), messages[0].ID)
+	require.Equal(t, "first message", messages[0].Text)
+	require.Equal(t, 3, messages[0].Attempts)
+	require.Empty(t, outbox.GetPending("another-reporter"))
+
+	// IDs continue from the loaded ones.
+	outbox.Add("reporter", "third message", errors.New("error"), 0)
+	require.Equal(t, int64(3), outbox.GetPending("reporter")[2].ID)
+
+	// Messages that were not sent for too long are dropped.
+	require.Empty(t, outbox.GetDue("reporter"))
+	require.Len(t, outbox.GetPending("reporter"), 1)
+}
+
+func TestOutboxSaveFailed(t *testing.T) {
+	t.Parallel()
+
+	outbox := newOutbox("outbox.yml", &fs.MockFs{FailCreate: true})
+	outbox.Add("reporter", "message", errors.New("error"), 0)
+	require.Error(t, outbox.Save())
+
+	outbox = newOutbox("outbox.yml", &fs.MockFs{FailWrite: true})
+	outbox.Add("reporter", "message", errors.New("error"), 0)
+	require.Error(t, outbox.Save())
+
+	outbox = newOutbox("outbox.yml", &fs.MockFs{FailClose: true})
+	outbox.Add("reporter", "message", errors.New("error"), 0)
+	require.Error(t, outbox.Save())
+
+	outbox = newOutbox("outbox.yml", &fs.MockFs{FailRename: true})
+	outbox.Add("reporter", "message", errors.New("error"), 0)
+	require.Error(t, outbox.Save())
+}
+
+func TestOutboxSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	filesystem := &BufferFs{File: &BufferFile{}}
+
+	outbox := newOutbox("outbox.yml", filesystem)
+	outbox.Add("reporter", "message", errors.New("error"), 0)
+	outbox.Stop()
+
+	// not saved anymore, so not added
+	outbox.Add("reporter", "late", errors.New("error"), 0)
+	outbox.Queue("reporter", "queued", errors.New("error"))
+	require.Len(t, outbox.GetPending("reporter"), 1)
+
+	savedOutbox := newOutbox("outbox.yml", filesystem)
+	savedOutbox.Load()
+
+	messages := savedOutbox.GetPending("reporter")
+	require.Len(t, messages, 1)
+	require.Equal(t, "message", messages[0].Text)
+	require.Equal(t, "error", messages[0].LastError)
+}
+
+func TestOutboxRetry(t *testing.T) {
+	t.Parallel()
+
+	outbox := newOutbox("", &fs.MockFs{})
+	outbox.Add("reporter", "first", errors.New("error"), 0)
+	outbox.Add("reporter", "second", errors.New("error"), time.Minute)
+
+	// Not retried before the backoff passes.
+	require.Empty(t, outbox.GetDue("reporter"))
+
+	pending := outbox.GetPending("reporter")
+	require.WithinDuration(t, time.Now().Add(constants.OutboxRetryMinDelay), pending[0].NextAttemptAt, time.Second)
+	require.WithinDuration(t, time.Now().Add(time.Minute), pending[1].NextAttemptAt, time.Second)
+
+	require.Equal(t, 2, outbox.Flush("reporter"))
+	due := outbox.GetDue("reporter")
+	require.Len(t, due, 2)
+	require.Equal(t, "first", due[0].Text)
+
+	outbox.MarkFailed("reporter", due[0].ID, errors.New("another error"), 0)
+	outbox.MarkSent("reporter", due[1].ID)
+
+	pending = outbox.GetPending("reporter")
+	require.Len(t, pending, 1)
+	require.Equal(t, 2, pending[0].Attempts)
+	require.Equal(t, "another error", pending[0].LastError)
+
+	outbox.MarkSent("reporter", due[0].ID)
+	require.Empty(t, outbox.GetPending("reporter"))
+	require.Zero(t, outbox.Flush("reporter"))
+}
+
+func TestOutboxGetRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, constants.OutboxRetryMinDelay, outbox.GetRetryDelay(1, 0))
+	require.Equal(t, 4*constants.OutboxRetryMinDelay, outbox.GetRetryDelay(3, 0))
+	require.Equal(t, constants.OutboxRetryMaxDelay, outbox.GetRetryDelay(100, 0))
+	require.Equal(t, time.Hour, outbox.GetRetryDelay(1, time.Hour))
+}
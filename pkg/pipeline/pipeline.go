@@ -0,0 +1,258 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// EnrichFunc fetches additional data for a report that is going to be sent
+// by a reporter and returns the report to send, or false if it should not be sent.
+// Once the context is done, it should stop fetching and return what it has got so far.
+type EnrichFunc func(ctx context.Context, reporterName string, report types.Report) (types.Report, bool)
+
+// DeliverFunc sends an enriched report with a reporter, returning an error if it was not sent.
+type DeliverFunc func(reporterName string, report types.Report) error
+
+// CompleteFunc is called once a raw report was sent (or skipped) by all the reporters it was for.
+// delivered is false if any of the reporters failed to send it.
+type CompleteFunc func(rawReport types.Report, delivered bool)
+
+// Job is a raw report with the reports built from it for each reporter.
+// A worker fetches additional data for each of these reports, then each reporter's
+// sender sends its report when the job reaches the head of its queue.
+type Job struct {
+	RawReport  types.Report
+	Reports    map[string]types.Report
+	ReceivedAt time.Time
+
+	enriched chan struct{}
+	pending  atomic.Int32
+	failed   atomic.Bool
+}
+
+// Pipeline fetches additional data for reports in parallel with a bounded pool of workers,
+// and sends them with a separate ordered queue per reporter, so a slow reporter or a slow
+// node of one chain does not stall reports for other reporters and chains.
+type Pipeline struct {
+	Logger         zerolog.Logger
+	MetricsManager *metricsPkg.Manager
+
+	WorkersCount      int
+	EnrichmentTimeout time.Duration
+
+	Enrich   EnrichFunc
+	Deliver  DeliverFunc
+	Complete CompleteFunc
+
+	jobs   chan *Job
+	queues map[string]chan *Job
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	workersGroup sync.WaitGroup
+	sendersGroup sync.WaitGroup
+}
+
+func NewPipeline(
+	logger *zerolog.Logger,
+	metricsManager *metricsPkg.Manager,
+	reporterNames []string,
+	workersCount int,
+	queueSize int,
+	enrichmentTimeout time.Duration,
+	enrich EnrichFunc,
+	deliver DeliverFunc,
+	complete CompleteFunc,
+) *Pipeline {
+	queues := make(map[string]chan *Job, len(reporterNames))
+	for _, reporterName := range reporterNames {
+		queues[reporterName] = make(chan *Job, queueSize)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Pipeline{
+		Logger:            logger.With().Str("component", "pipeline").Logger(),
+		MetricsManager:    metricsManager,
+		WorkersCount:      workersCount,
+		EnrichmentTimeout: enrichmentTimeout,
+		Enrich:            enrich,
+		Deliver:           deliver,
+		Complete:          complete,
+		jobs:              make(chan *Job, queueSize),
+		queues:            queues,
+		ctx:               ctx,
+		cancel:            cancel,
+	}
+}
+
+func (p *Pipeline) Start() {
+	for index := 0; index < p.WorkersCount; index++ {
+		p.workersGroup.Add(1)
+		go p.work()
+	}
+
+	for reporterName, queue := range p.queues {
+		p.sendersGroup.Add(1)
+		go p.send(reporterName, queue)
+	}
+}
+
+// Submit puts a raw report and the reports for each reporter built from it
+// into the pipeline. If a reporter's queue is full, its report is dropped instead
+// of waiting, so a slow or rate-limited reporter does not stall the intake for others.
+// It blocks if the enrichment queue is full, so the intake slows down instead
+// of the memory growing. It should be called from a single goroutine,
+// as this is what keeps reports order.
+func (p *Pipeline) Submit(rawReport types.Report, reports map[string]types.Report) {
+	job := &Job{
+		RawReport:  rawReport,
+		Reports:    make(map[string]types.Report, len(reports)),
+		ReceivedAt: time.Now(),
+		enriched:   make(chan struct{}),
+	}
+
+	for reporterName, report := range reports {
+		if _, ok := p.queues[reporterName]; !ok {
+			p.Logger.Warn().
+				Str("reporter", reporterName).
+				Str("hash", report.Reportable.GetHash()).
+				Msg("Got report for unknown reporter, skipping")
+			continue
+		}
+
+		job.Reports[reporterName] = report
+	}
+
+	if len(job.Reports) == 0 {
+		p.Complete(rawReport, true)
+		return
+	}
+
+	// senders wait till the job is enriched, so they do not read it before it is submitted
+	for reporterName, report := range job.Reports {
+		select {
+		case p.queues[reporterName] <- job:
+			p.MetricsManager.LogReporterQueueSize(reporterName, len(p.queues[reporterName]))
+		default:
+			p.Logger.Warn().
+				Str("chain", report.Chain.Name).
+				Str("reporter", reporterName).
+				Str("hash", report.Reportable.GetHash()).
+				Msg("Reporter queue is full, dropping report")
+			p.MetricsManager.LogReportDropped(reporterName)
+			delete(job.Reports, reporterName)
+			job.failed.Store(true)
+		}
+	}
+
+	if len(job.Reports) == 0 {
+		p.Complete(rawReport, false)
+		return
+	}
+
+	job.pending.Store(int32(len(job.Reports)))
+
+	p.jobs <- job
+	p.MetricsManager.LogEnrichmentQueueSize(len(p.jobs))
+}
+
+// Stop waits till all the submitted reports are sent. Submit should not be called after it.
+func (p *Pipeline) Stop() {
+	close(p.jobs)
+	p.workersGroup.Wait()
+
+	for _, queue := range p.queues {
+		close(queue)
+	}
+
+	p.sendersGroup.Wait()
+	p.cancel()
+}
+
+// Cancel stops fetching additional data for the reports being enriched, so they are sent
+// with the data fetched so far. It is used on shutdown, to not wait for slow nodes.
+func (p *Pipeline) Cancel() {
+	p.cancel()
+}
+
+func (p *Pipeline) work() {
+	defer p.workersGroup.Done()
+
+	for job := range p.jobs {
+		p.MetricsManager.LogEnrichmentQueueSize(len(p.jobs))
+		p.enrich(job)
+	}
+}
+
+// enrich fetches additional data for all reports of a job sequentially,
+// as reports for different reporters may share the same reportable.
+func (p *Pipeline) enrich(job *Job) {
+	defer close(job.enriched)
+
+	startTime := time.Now()
+
+	for reporterName, report := range job.Reports {
+		if enrichedReport, ok := p.enrichReport(reporterName, report); ok {
+			job.Reports[reporterName] = enrichedReport
+		} else {
+			delete(job.Reports, reporterName)
+		}
+	}
+
+	p.MetricsManager.LogEnrichmentTime(job.RawReport.Chain.Name, time.Since(startTime))
+}
+
+// enrichReport fetches additional data for a report, but not longer than EnrichmentTimeout,
+// if it is set.
+func (p *Pipeline) enrichReport(reporterName string, report types.Report) (types.Report, bool) {
+	ctx := p.ctx
+	if p.EnrichmentTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(p.ctx, p.EnrichmentTimeout)
+		defer cancel()
+	}
+
+	enrichedReport, ok := p.Enrich(ctx, reporterName, report)
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		p.Logger.Warn().
+			Str("chain", report.Chain.Name).
+			Str("reporter", reporterName).
+			Str("hash", report.Reportable.GetHash()).
+			Dur("timeout", p.EnrichmentTimeout).
+			Msg("Could not fetch all the additional data for report in time")
+	}
+
+	return enrichedReport, ok
+}
+
+func (p *Pipeline) send(reporterName string, queue chan *Job) {
+	defer p.sendersGroup.Done()
+
+	for job := range queue {
+		p.MetricsManager.LogReporterQueueSize(reporterName, len(queue))
+
+		<-job.enriched
+
+		if report, ok := job.Reports[reporterName]; ok {
+			if err := p.Deliver(reporterName, report); err != nil {
+				job.failed.Store(true)
+			}
+
+			p.MetricsManager.LogReportDeliveryTime(reporterName, time.Since(job.ReceivedAt))
+		}
+
+		if job.pending.Add(-1) == 0 {
+			p.Complete(job.RawReport, !job.failed.Load())
+		}
+	}
+}
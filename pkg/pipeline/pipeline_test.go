@@ -0,0 +1,294 @@
+package pipeline_test
+
+import (
+	"context"
+	"errors"
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/pipeline"
+	"main/pkg/types"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	mutex     sync.Mutex
+	delivered map[string][]string
+	completed []string
+	failed    []string
+}
+
+func newRecorder() *recorder {
+	return &recorder{delivered: map[string][]string{}}
+}
+
+func (r *recorder) Deliver(reporterName string, report types.Report) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.delivered[reporterName] = append(r.delivered[reporterName], report.Reportable.GetHash())
+	return nil
+}
+
+func (r *recorder) Complete(rawReport types.Report, delivered bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.completed = append(r.completed, rawReport.Reportable.GetHash())
+	if !delivered {
+		r.failed = append(r.failed, rawReport.Reportable.GetHash())
+	}
+}
+
+func getReport(hash string) types.Report {
+	return types.Report{
+		Chain:      &configTypes.Chain{Name: "chain"},
+		Reportable: &types.Tx{Hash: configTypes.Link{Value: hash}},
+	}
+}
+
+func newPipeline(
+	reporterNames []string,
+	enrichmentTimeout time.Duration,
+	enrich pipeline.EnrichFunc,
+	deliver pipeline.DeliverFunc,
+	complete pipeline.CompleteFunc,
+) *pipeline.Pipeline {
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+
+	return pipeline.NewPipeline(logger, metricsManager, reporterNames, 4, 10, enrichmentTimeout, enrich, deliver, complete)
+}
+
+func passThrough(ctx context.Context, reporterName string, report types.Report) (types.Report, bool) {
+	return report, true
+}
+
+func TestPipelineKeepsOrderPerReporter(t *testing.T) {
+	t.Parallel()
+
+	recorder := newRecorder()
+
+	// Earlier reports take longer to enrich, so they are enriched after the later ones.
+	enrich := func(ctx context.Context, reporterName string, report types.Report) (types.Report, bool) {
+		index, _ := strconv.Atoi(report.Reportable.GetHash())
+		time.Sleep(time.Duration(10-index) * time.Millisecond)
+		return report, true
+	}
+
+	p := newPipeline([]string{"first", "second"}, 0, enrich, recorder.Deliver, recorder.Complete)
+	p.Start()
+
+	expected := make([]string, 10)
+	for index := 0; index < 10; index++ {
+		hash := strconv.Itoa(index)
+		expected[index] = hash
+		p.Submit(getReport(hash), map[string]types.Report{
+			"first":  getReport(hash),
+			"second": getReport(hash),
+		})
+	}
+
+	p.Stop()
+
+	require.Equal(t, expected, recorder.delivered["first"])
+	require.Equal(t, expected, recorder.delivered["second"])
+	require.ElementsMatch(t, expected, recorder.completed)
+}
+
+func TestPipelineSlowReporterDoesNotBlockOthers(t *testing.T) {
+	t.Parallel()
+
+	recorder := newRecorder()
+	release := make(chan struct{})
+	completed := make(chan string, 2)
+
+	deliver := func(reporterName string, report types.Report) error {
+		if reporterName == "slow" {
+			<-release
+		}
+
+		return recorder.Deliver(reporterName, report)
+	}
+
+	complete := func(rawReport types.Report, delivered bool) {
+		recorder.Complete(rawReport, delivered)
+		completed <- rawReport.Reportable.GetHash()
+	}
+
+	p := newPipeline([]string{"slow", "fast"}, 0, passThrough, deliver, complete)
+	p.Start()
+
+	p.Submit(getReport("1"), map[string]types.Report{
+		"slow": getReport("1"),
+		"fast": getReport("1"),
+	})
+	p.Submit(getReport("2"), map[string]types.Report{
+		"fast": getReport("2"),
+	})
+
+	require.Equal(t, "2", <-completed)
+
+	recorder.mutex.Lock()
+	require.Equal(t, []string{"1", "2"}, recorder.delivered["fast"])
+	require.Empty(t, recorder.delivered["slow"])
+	require.Equal(t, []string{"2"}, recorder.completed)
+	recorder.mutex.Unlock()
+
+	close(release)
+	p.Stop()
+
+	require.Equal(t, []string{"1"}, recorder.delivered["slow"])
+	require.Equal(t, []string{"2", "1"}, recorder.completed)
+}
+
+func TestPipelineFullReporterQueueDropsReports(t *testing.T) {
+	t.Parallel()
+
+	recorder := newRecorder()
+	release := make(chan struct{})
+
+	deliver := func(reporterName string, report types.Report) error {
+		if reporterName == "slow" {
+			<-release
+		}
+
+		return recorder.Deliver(reporterName, report)
+	}
+
+	p := newPipeline([]string{"slow", "fast"}, 0, passThrough, deliver, recorder.Complete)
+	p.Start()
+
+	// more reports than the slow reporter's queue can hold, yet submitting does not block
+	submitted := make(chan struct{})
+	expected := make([]string, 15)
+
+	go func() {
+		for index := 0; index < 15; index++ {
+			hash := strconv.Itoa(index)
+			expected[index] = hash
+			p.Submit(getReport(hash), map[string]types.Report{"slow": getReport(hash)})
+		}
+
+		p.Submit(getReport("fast"), map[string]types.Report{"fast": getReport("fast")})
+		close(submitted)
+	}()
+
+	select {
+	case <-submitted:
+	case <-time.After(time.Second):
+		require.Fail(t, "submitting reports is blocked by the slow reporter")
+	}
+
+	close(release)
+	p.Stop()
+
+	slowDelivered := recorder.delivered["slow"]
+	require.Equal(t, []string{"fast"}, recorder.delivered["fast"])
+	require.Less(t, len(slowDelivered), 15)
+	require.Equal(t, expected[:len(slowDelivered)], slowDelivered)
+	require.Len(t, recorder.failed, 15-len(slowDelivered))
+	require.Len(t, recorder.completed, 16)
+}
+
+func TestPipelineFilteredAndUnknownReporters(t *testing.T) {
+	t.Parallel()
+
+	recorder := newRecorder()
+
+	enrich := func(ctx context.Context, reporterName string, report types.Report) (types.Report, bool) {
+		return report, reporterName != "filtered"
+	}
+
+	p := newPipeline([]string{"reporter", "filtered"}, 0, enrich, recorder.Deliver, recorder.Complete)
+	p.Start()
+
+	p.Submit(getReport("nowhere"), map[string]types.Report{})
+	p.Submit(getReport("unknown"), map[string]types.Report{"unknown": getReport("unknown")})
+	p.Submit(getReport("hash"), map[string]types.Report{
+		"reporter": getReport("hash"),
+		"filtered": getReport("hash"),
+	})
+
+	p.Stop()
+
+	require.Equal(t, []string{"hash"}, recorder.delivered["reporter"])
+	require.Empty(t, recorder.delivered["filtered"])
+	require.Equal(t, []string{"nowhere", "unknown", "hash"}, recorder.completed)
+	require.Empty(t, recorder.failed)
+}
+
+func TestPipelineDeliveryFailed(t *testing.T) {
+	t.Parallel()
+
+	recorder := newRecorder()
+
+	deliver := func(reporterName string, report types.Report) error {
+		if reporterName == "failing" && report.Reportable.GetHash() == "1" {
+			return errors.New("custom error")
+		}
+
+		return recorder.Deliver(reporterName, report)
+	}
+
+	p := newPipeline([]string{"reporter", "failing"}, 0, passThrough, deliver, recorder.Complete)
+	p.Start()
+
+	for _, hash := range []string{"1", "2"} {
+		p.Submit(getReport(hash), map[string]types.Report{
+			"reporter": getReport(hash),
+			"failing":  getReport(hash),
+		})
+	}
+
+	p.Stop()
+
+	require.Equal(t, []string{"1", "2"}, recorder.delivered["reporter"])
+	require.Equal(t, []string{"2"}, recorder.delivered["failing"])
+	require.ElementsMatch(t, []string{"1", "2"}, recorder.completed)
+	require.Equal(t, []string{"1"}, recorder.failed)
+}
+
+// waitForCancel is an enrich func that fetches nothing till its context is done.
+func waitForCancel(ctx context.Context, reporterName string, report types.Report) (types.Report, bool) {
+	<-ctx.Done()
+	return report, true
+}
+
+func TestPipelineEnrichmentTimeout(t *testing.T) {
+	t.Parallel()
+
+	recorder := newRecorder()
+
+	p := newPipeline([]string{"reporter"}, 10*time.Millisecond, waitForCancel, recorder.Deliver, recorder.Complete)
+	p.Start()
+
+	p.Submit(getReport("hash"), map[string]types.Report{"reporter": getReport("hash")})
+	p.Stop()
+
+	require.Equal(t, []string{"hash"}, recorder.delivered["reporter"])
+	require.Equal(t, []string{"hash"}, recorder.completed)
+}
+
+func TestPipelineCancel(t *testing.T) {
+	t.Parallel()
+
+	recorder := newRecorder()
+
+	p := newPipeline([]string{"reporter"}, 0, waitForCancel, recorder.Deliver, recorder.Complete)
+	p.Start()
+
+	p.Submit(getReport("1"), map[string]types.Report{"reporter": getReport("1")})
+	p.Submit(getReport("2"), map[string]types.Report{"reporter": getReport("2")})
+	p.Cancel()
+	p.Stop()
+
+	require.Equal(t, []string{"1", "2"}, recorder.delivered["reporter"])
+	require.ElementsMatch(t, []string{"1", "2"}, recorder.completed)
+}
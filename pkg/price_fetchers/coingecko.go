@@ -1,13 +1,20 @@
 package price_fetchers
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"main/pkg/config"
 	configTypes "main/pkg/config/types"
 	"main/pkg/http"
 	"main/pkg/metrics"
+	"main/pkg/types/amount"
 	"main/pkg/types/query_info"
+	"main/pkg/types/responses"
 	"main/pkg/utils"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog"
 )
@@ -18,27 +25,36 @@ type CoingeckoPriceFetcher struct {
 	Logger         zerolog.Logger
 }
 
-func NewCoingeckoPriceFetcher(logger zerolog.Logger, metricsManager *metrics.Manager) *CoingeckoPriceFetcher {
+func NewCoingeckoPriceFetcher(
+	logger zerolog.Logger,
+	metricsManager *metrics.Manager,
+	timeouts config.TimeoutsConfig,
+) *CoingeckoPriceFetcher {
 	return &CoingeckoPriceFetcher{
-		Client:         http.NewClient(&logger, "https://api.coingecko.com", "coingecko"),
+		Client:         http.NewClient(&logger, "https://api.coingecko.com", "coingecko", timeouts),
 		MetricsManager: metricsManager,
 		Logger:         logger.With().Str("component", "coingecko_price_fetcher").Logger(),
 	}
 }
 
-func (c *CoingeckoPriceFetcher) GetPrices(denomInfos configTypes.DenomInfos) (map[*configTypes.DenomInfo]float64, error) {
+func (c *CoingeckoPriceFetcher) GetPrices(
+	ctx context.Context,
+	denomInfos configTypes.DenomInfos,
+) (map[*configTypes.DenomInfo]float64, error) {
 	currenciesToFetch := utils.Map(denomInfos, func(denomInfo *configTypes.DenomInfo) string {
 		return denomInfo.CoingeckoCurrency
 	})
 
 	var coingeckoResponse map[string]map[string]float64
 	err, queryInfo := c.Client.Get(
+		ctx,
 		fmt.Sprintf(
 			"/api/v3/simple/price?ids=%s&vs_currencies=%s",
 			strings.Join(currenciesToFetch, ","),
 			CoingeckoBaseCurrency,
 		),
 		&coingeckoResponse,
+		query_info.QueryTypePrices,
 	)
 	c.MetricsManager.LogQuery("coingecko", queryInfo, query_info.QueryTypePrices)
 	if err != nil {
@@ -71,3 +87,87 @@ func (c *CoingeckoPriceFetcher) GetPrices(denomInfos configTypes.DenomInfos) (ma
 func (c *CoingeckoPriceFetcher) Name() string {
 	return CoingeckoPriceFetcherName
 }
+
+// GetExchangeRates returns how much of each currency CoinGecko knows (fiat ones, and some tokens,
+// like BTC or ETH) one USD is worth.
+func (c *CoingeckoPriceFetcher) GetExchangeRates(ctx context.Context) (map[string]*amount.QuoteRate, error) {
+	var exchangeRatesResponse responses.CoingeckoExchangeRatesResponse
+	err, queryInfo := c.Client.Get(
+		ctx,
+		"/api/v3/exchange_rates",
+		&exchangeRatesResponse,
+		query_info.QueryTypeExchangeRates,
+	)
+	c.MetricsManager.LogQuery("coingecko", queryInfo, query_info.QueryTypeExchangeRates)
+	if err != nil {
+		c.Logger.Error().Err(err).Msg("Could not get exchange rates, probably rate-limiting")
+		return map[string]*amount.QuoteRate{}, err
+	}
+
+	// rates are relative to BTC, so converting them to be relative to USD
+	usdRate, ok := exchangeRatesResponse.Rates[CoingeckoBaseCurrency]
+	if !ok || usdRate.Value == 0 {
+		return map[string]*amount.QuoteRate{}, errors.New("no USD exchange rate")
+	}
+
+	result := make(map[string]*amount.QuoteRate, len(exchangeRatesResponse.Rates))
+	for currency, rate := range exchangeRatesResponse.Rates {
+		result[currency] = &amount.QuoteRate{
+			Currency: currency,
+			Rate:     rate.Value / usdRate.Value,
+			Fiat:     rate.Type == "fiat",
+		}
+	}
+
+	return result, nil
+}
+
+// GetHistoricalPrice returns the denom USD price closest to the given time, taken from the price chart
+// around it. CoinGecko returns 5-minute granularity data for ranges within a day.
+func (c *CoingeckoPriceFetcher) GetHistoricalPrice(
+	ctx context.Context,
+	denomInfo *configTypes.DenomInfo,
+	priceTime time.Time,
+) (float64, error) {
+	var marketChartResponse responses.CoingeckoMarketChartResponse
+	err, queryInfo := c.Client.Get(
+		ctx,
+		fmt.Sprintf(
+			"/api/v3/coins/%s/market_chart/range?vs_currency=%s&from=%d&to=%d",
+			denomInfo.CoingeckoCurrency,
+			CoingeckoBaseCurrency,
+			priceTime.Add(-CoingeckoHistoricalPriceRange).Unix(),
+			priceTime.Add(CoingeckoHistoricalPriceRange).Unix(),
+		),
+		&marketChartResponse,
+		query_info.QueryTypeHistoricalPrices,
+	)
+	c.MetricsManager.LogQuery("coingecko", queryInfo, query_info.QueryTypeHistoricalPrices)
+	if err != nil {
+		c.Logger.Error().
+			Err(err).
+			Str("currency", denomInfo.CoingeckoCurrency).
+			Msg("Could not get historical price, probably rate-limiting")
+		return 0, err
+	}
+
+	price, found := 0.0, false
+	closestDistance := math.Inf(1)
+
+	for _, point := range marketChartResponse.Prices {
+		if len(point) != 2 {
+			continue
+		}
+
+		distance := math.Abs(point[0] - float64(priceTime.UnixMilli()))
+		if distance < closestDistance {
+			price, found, closestDistance = point[1], true, distance
+		}
+	}
+
+	if !found {
+		return 0, fmt.Errorf("no prices for %s around %s", denomInfo.CoingeckoCurrency, priceTime)
+	}
+
+	return price, nil
+}
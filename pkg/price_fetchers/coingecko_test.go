@@ -1,6 +1,7 @@
 package price_fetchers
 
 import (
+	"context"
 	"errors"
 	"main/assets"
 	"main/pkg/config"
@@ -8,6 +9,7 @@ import (
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/require"
@@ -26,10 +28,10 @@ func TestCoingeckoQueryFail(t *testing.T) {
 
 	logger := loggerPkg.GetNopLogger()
 	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
-	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager)
+	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
 
 	denomInfos := types.DenomInfos{{Denom: "atom", CoingeckoCurrency: "cosmos"}}
-	currencies, err := coingecko.GetPrices(denomInfos)
+	currencies, err := coingecko.GetPrices(context.Background(), denomInfos)
 	require.Error(t, err)
 	require.ErrorContains(t, err, "custom error")
 	require.Empty(t, currencies)
@@ -48,16 +50,144 @@ func TestCoingeckoQuerySuccess(t *testing.T) {
 
 	logger := loggerPkg.GetNopLogger()
 	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
-	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager)
+	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
 
 	denomInfos := types.DenomInfos{
 		{Denom: "atom", CoingeckoCurrency: "cosmos"},
 		{Denom: "akt", CoingeckoCurrency: "akash-network"},
 		{Denom: "random", CoingeckoCurrency: "random"},
 	}
-	currencies, err := coingecko.GetPrices(denomInfos)
+	currencies, err := coingecko.GetPrices(context.Background(), denomInfos)
 	require.NoError(t, err)
 	require.Len(t, currencies, 2)
 	require.NotNil(t, currencies[denomInfos[0]])
 	require.NotNil(t, currencies[denomInfos[1]])
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestCoingeckoExchangeRatesQueryFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/exchange_rates",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	rates, err := coingecko.GetExchangeRates(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.Empty(t, rates)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestCoingeckoExchangeRatesNoUSD(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/exchange_rates",
+		httpmock.NewBytesResponder(200, []byte(`{"rates":{"btc":{"value":1,"type":"crypto"}}}`)),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	rates, err := coingecko.GetExchangeRates(context.Background())
+	require.Error(t, err)
+	require.Empty(t, rates)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestCoingeckoExchangeRatesQuerySuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/exchange_rates",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko-exchange-rates.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	rates, err := coingecko.GetExchangeRates(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+	require.InDelta(t, 1, rates["usd"].Rate, 0.0001)
+	require.InDelta(t, 0.92, rates["eur"].Rate, 0.0001)
+	require.True(t, rates["eur"].Fiat)
+	require.InDelta(t, 0.00004, rates["btc"].Rate, 0.0000001)
+	require.False(t, rates["btc"].Fiat)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestCoingeckoHistoricalPriceQueryFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/coins/cosmos/market_chart/range?vs_currency=usd&from=1672572600&to=1672576200",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	denomInfo := &types.DenomInfo{Denom: "atom", CoingeckoCurrency: "cosmos"}
+	_, err := coingecko.GetHistoricalPrice(context.Background(), denomInfo, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC))
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestCoingeckoHistoricalPriceNoPrices(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/coins/cosmos/market_chart/range?vs_currency=usd&from=1672572600&to=1672576200",
+		httpmock.NewBytesResponder(200, []byte(`{"prices":[]}`)),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	denomInfo := &types.DenomInfo{Denom: "atom", CoingeckoCurrency: "cosmos"}
+	_, err := coingecko.GetHistoricalPrice(context.Background(), denomInfo, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC))
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestCoingeckoHistoricalPriceQuerySuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/coins/cosmos/market_chart/range?vs_currency=usd&from=1672572600&to=1672576200",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko-market-chart.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	coingecko := NewCoingeckoPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	denomInfo := &types.DenomInfo{Denom: "atom", CoingeckoCurrency: "cosmos"}
+	price, err := coingecko.GetHistoricalPrice(context.Background(), denomInfo, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.InDelta(t, 10.2, price, 0.001)
+}
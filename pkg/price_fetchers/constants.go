@@ -1,7 +1,18 @@
 package price_fetchers
 
+import (
+	"main/pkg/constants"
+	"time"
+)
+
 const (
-	CoingeckoPriceFetcherName string = "coingecko"
+	CoingeckoPriceFetcherName string = constants.PriceSourceTypeCoingecko
+	OsmosisPriceFetcherName   string = constants.PriceSourceTypeOsmosis
+	StaticPriceFetcherName    string = constants.PriceSourceTypeStatic
+	JSONPriceFetcherName      string = constants.PriceSourceTypeJSON
 	MockPriceFetcherName      string = "mock"
-	CoingeckoBaseCurrency     string = "usd"
+	CoingeckoBaseCurrency     string = constants.QuoteCurrencyUSD
 )
+
+// CoingeckoHistoricalPriceRange is how far before and after the tx time to look for historical prices.
+const CoingeckoHistoricalPriceRange = 30 * time.Minute
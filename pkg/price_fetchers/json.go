@@ -0,0 +1,96 @@
+package price_fetchers
+
+import (
+	"context"
+	"fmt"
+	"main/pkg/config"
+	configTypes "main/pkg/config/types"
+	"main/pkg/http"
+	"main/pkg/metrics"
+	"main/pkg/types/query_info"
+	"main/pkg/utils"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// JSONPriceFetcher takes prices from any HTTP endpoint returning JSON,
+// selecting the price with a JSONPath.
+type JSONPriceFetcher struct {
+	Client         *http.Client
+	MetricsManager *metrics.Manager
+	Logger         zerolog.Logger
+}
+
+func NewJSONPriceFetcher(
+	logger zerolog.Logger,
+	metricsManager *metrics.Manager,
+	timeouts config.TimeoutsConfig,
+) *JSONPriceFetcher {
+	return &JSONPriceFetcher{
+		// URLs are absolute, so there's no host
+		Client:         http.NewClient(&logger, "", JSONPriceFetcherName, timeouts),
+		MetricsManager: metricsManager,
+		Logger:         logger.With().Str("component", "json_price_fetcher").Logger(),
+	}
+}
+
+func (f *JSONPriceFetcher) GetPrices(
+	ctx context.Context,
+	denomInfos configTypes.DenomInfos,
+) (map[*configTypes.DenomInfo]float64, error) {
+	result := make(map[*configTypes.DenomInfo]float64)
+
+	for _, denomInfo := range denomInfos {
+		source := denomInfo.PriceSources.Find(JSONPriceFetcherName)
+		if source == nil {
+			continue
+		}
+
+		price, err := f.GetPrice(ctx, source)
+		if err != nil {
+			f.Logger.Error().
+				Err(err).
+				Str("denom", denomInfo.Denom).
+				Str("url", source.URL).
+				Msg("Could not get price")
+			continue
+		}
+
+		result[denomInfo] = price
+	}
+
+	return result, nil
+}
+
+func (f *JSONPriceFetcher) GetPrice(ctx context.Context, source *configTypes.PriceSource) (float64, error) {
+	path, err := utils.ParseJSONPath(source.Path)
+	if err != nil {
+		return 0, err
+	}
+
+	var response interface{}
+	err, queryInfo := f.Client.Get(ctx, source.URL, &response, query_info.QueryTypePrices)
+	f.MetricsManager.LogQuery(JSONPriceFetcherName, queryInfo, query_info.QueryTypePrices)
+	if err != nil {
+		return 0, err
+	}
+
+	value, err := path.Get(response)
+	if err != nil {
+		return 0, err
+	}
+
+	switch typedValue := value.(type) {
+	case float64:
+		return typedValue, nil
+	case string:
+		return strconv.ParseFloat(typedValue, 64)
+	default:
+		return 0, fmt.Errorf("expected price to be a number or a string, got %T", value)
+	}
+}
+
+func (f *JSONPriceFetcher) Name() string {
+	return JSONPriceFetcherName
+}
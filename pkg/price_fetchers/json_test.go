@@ -0,0 +1,110 @@
+package price_fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func getJSONDenomInfos(path string) types.DenomInfos {
+	return types.DenomInfos{
+		{
+			Denom: "uatom",
+			PriceSources: types.PriceSources{{
+				Type: JSONPriceFetcherName,
+				URL:  "https://prices.example.com/tokens",
+				Path: path,
+			}},
+		},
+		{Denom: "uosmo", CoingeckoCurrency: "osmosis"},
+	}
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestJSONQueryFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://prices.example.com/tokens",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	fetcher := NewJSONPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+	require.Equal(t, "json", fetcher.Name())
+
+	prices, err := fetcher.GetPrices(context.Background(), getJSONDenomInfos("$.data.tokens[0].price"))
+	require.NoError(t, err)
+	require.Empty(t, prices)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestJSONQueryInvalidPath(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://prices.example.com/tokens",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("json-price.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	fetcher := NewJSONPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	for _, path := range []string{
+		"invalid",
+		"$.data.tokens[1].price",
+		"$.data.tokens[0].symbol",
+		"$.data.tokens[0]",
+	} {
+		prices, err := fetcher.GetPrices(context.Background(), getJSONDenomInfos(path))
+		require.NoError(t, err)
+		require.Empty(t, prices, path)
+	}
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestJSONQuerySuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://prices.example.com/tokens",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("json-price.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://prices.example.com/number",
+		httpmock.NewBytesResponder(200, []byte(`{"price":1.5}`)),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	fetcher := NewJSONPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	denomInfos := getJSONDenomInfos("$.data.tokens[0]['price']")
+	prices, err := fetcher.GetPrices(context.Background(), denomInfos)
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.InDelta(t, 8.12, prices[denomInfos[0]], 0.001)
+
+	denomInfos[0].PriceSources[0].URL = "https://prices.example.com/number"
+	denomInfos[0].PriceSources[0].Path = "$.price"
+	prices, err = fetcher.GetPrices(context.Background(), denomInfos)
+	require.NoError(t, err)
+	require.InDelta(t, 1.5, prices[denomInfos[0]], 0.001)
+}
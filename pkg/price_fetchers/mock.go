@@ -1,10 +1,16 @@
 package price_fetchers
 
-import configTypes "main/pkg/config/types"
+import (
+	"context"
+	configTypes "main/pkg/config/types"
+)
 
 type MockPriceFetcher struct{}
 
-func (f *MockPriceFetcher) GetPrices(denomInfos configTypes.DenomInfos) (map[*configTypes.DenomInfo]float64, error) {
+func (f *MockPriceFetcher) GetPrices(
+	ctx context.Context,
+	denomInfos configTypes.DenomInfos,
+) (map[*configTypes.DenomInfo]float64, error) {
 	return map[*configTypes.DenomInfo]float64{}, nil
 }
 
@@ -1,6 +1,7 @@
 package price_fetchers
 
 import (
+	"context"
 	"main/pkg/config/types"
 	"testing"
 
@@ -13,7 +14,7 @@ func TestMockPriceFetcher(t *testing.T) {
 	fetcher := MockPriceFetcher{}
 	require.Equal(t, "mock", fetcher.Name())
 
-	prices, err := fetcher.GetPrices(types.DenomInfos{})
+	prices, err := fetcher.GetPrices(context.Background(), types.DenomInfos{})
 	require.NoError(t, err)
 	require.Empty(t, prices)
 }
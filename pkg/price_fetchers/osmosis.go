@@ -0,0 +1,103 @@
+package price_fetchers
+
+import (
+	"context"
+	"fmt"
+	"main/pkg/config"
+	configTypes "main/pkg/config/types"
+	"main/pkg/http"
+	"main/pkg/metrics"
+	"main/pkg/types/query_info"
+	"main/pkg/types/responses"
+	"math"
+	"net/url"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// OsmosisPriceFetcher takes prices from Osmosis pools spot prices, assuming
+// the pool quote denom is a USD stablecoin.
+type OsmosisPriceFetcher struct {
+	MetricsManager *metrics.Manager
+	Logger         zerolog.Logger
+	Timeouts       config.TimeoutsConfig
+}
+
+func NewOsmosisPriceFetcher(
+	logger zerolog.Logger,
+	metricsManager *metrics.Manager,
+	timeouts config.TimeoutsConfig,
+) *OsmosisPriceFetcher {
+	return &OsmosisPriceFetcher{
+		MetricsManager: metricsManager,
+		Logger:         logger.With().Str("component", "osmosis_price_fetcher").Logger(),
+		Timeouts:       timeouts,
+	}
+}
+
+func (f *OsmosisPriceFetcher) GetPrices(
+	ctx context.Context,
+	denomInfos configTypes.DenomInfos,
+) (map[*configTypes.DenomInfo]float64, error) {
+	result := make(map[*configTypes.DenomInfo]float64)
+
+	for _, denomInfo := range denomInfos {
+		source := denomInfo.PriceSources.Find(OsmosisPriceFetcherName)
+		if source == nil {
+			continue
+		}
+
+		price, err := f.GetPrice(ctx, denomInfo, source)
+		if err != nil {
+			f.Logger.Error().
+				Err(err).
+				Str("denom", denomInfo.Denom).
+				Uint64("pool", source.PoolID).
+				Msg("Could not get spot price")
+			continue
+		}
+
+		result[denomInfo] = price
+	}
+
+	return result, nil
+}
+
+func (f *OsmosisPriceFetcher) GetPrice(
+	ctx context.Context,
+	denomInfo *configTypes.DenomInfo,
+	source *configTypes.PriceSource,
+) (float64, error) {
+	client := http.NewClient(&f.Logger, source.LCD, OsmosisPriceFetcherName, f.Timeouts)
+
+	var response responses.OsmosisSpotPriceResponse
+	err, queryInfo := client.Get(
+		ctx,
+		fmt.Sprintf(
+			"/osmosis/poolmanager/v1beta1/pools/%d/prices?base_asset_denom=%s&quote_asset_denom=%s",
+			source.PoolID,
+			url.QueryEscape(source.BaseDenom),
+			url.QueryEscape(source.QuoteDenom),
+		),
+		&response,
+		query_info.QueryTypePrices,
+	)
+	f.MetricsManager.LogQuery(OsmosisPriceFetcherName, queryInfo, query_info.QueryTypePrices)
+	if err != nil {
+		return 0, err
+	}
+
+	spotPrice, err := strconv.ParseFloat(response.SpotPrice, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing spot price: %s", err)
+	}
+
+	// spot price is how many quote denom base units are there per one base denom base unit,
+	// so it needs to be converted to display denoms
+	return spotPrice * math.Pow10(denomInfo.DenomExponent-source.QuoteExponent), nil
+}
+
+func (f *OsmosisPriceFetcher) Name() string {
+	return OsmosisPriceFetcherName
+}
@@ -0,0 +1,105 @@
+package price_fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const osmosisSpotPriceURL = "https://lcd.osmosis.zone/osmosis/poolmanager/v1beta1/pools/1/prices" +
+	"?base_asset_denom=uosmo&quote_asset_denom=ibc%2FUSDC"
+
+func getOsmosisDenomInfos() types.DenomInfos {
+	return types.DenomInfos{
+		{
+			Denom:         "uosmo",
+			DenomExponent: 6,
+			PriceSources: types.PriceSources{{
+				Type:          OsmosisPriceFetcherName,
+				LCD:           "https://lcd.osmosis.zone",
+				PoolID:        1,
+				BaseDenom:     "uosmo",
+				QuoteDenom:    "ibc/USDC",
+				QuoteExponent: 6,
+			}},
+		},
+		{Denom: "uatom", CoingeckoCurrency: "cosmos"},
+	}
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestOsmosisQueryFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		osmosisSpotPriceURL,
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	fetcher := NewOsmosisPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+	require.Equal(t, "osmosis", fetcher.Name())
+
+	prices, err := fetcher.GetPrices(context.Background(), getOsmosisDenomInfos())
+	require.NoError(t, err)
+	require.Empty(t, prices)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestOsmosisQueryInvalidPrice(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		osmosisSpotPriceURL,
+		httpmock.NewBytesResponder(200, []byte(`{"spot_price":"invalid"}`)),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	fetcher := NewOsmosisPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	prices, err := fetcher.GetPrices(context.Background(), getOsmosisDenomInfos())
+	require.NoError(t, err)
+	require.Empty(t, prices)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestOsmosisQuerySuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		osmosisSpotPriceURL,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("osmosis-spot-price.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, config.MetricsConfig{})
+	fetcher := NewOsmosisPriceFetcher(*logger, metricsManager, config.TimeoutsConfig{})
+
+	denomInfos := getOsmosisDenomInfos()
+	prices, err := fetcher.GetPrices(context.Background(), denomInfos)
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.InDelta(t, 0.512345, prices[denomInfos[0]], 0.000001)
+
+	// exponents differ, so spot price is converted
+	denomInfos[0].DenomExponent = 18
+	prices, err = fetcher.GetPrices(context.Background(), denomInfos)
+	require.NoError(t, err)
+	require.InDelta(t, 0.512345e12, prices[denomInfos[0]], 1)
+}
@@ -0,0 +1,45 @@
+package price_fetchers
+
+import (
+	"context"
+	"errors"
+	configTypes "main/pkg/config/types"
+	"time"
+)
+
+// StaticPriceFetcher returns prices set in config, useful for stablecoins
+// or tokens that are not traded anywhere.
+type StaticPriceFetcher struct{}
+
+func (f *StaticPriceFetcher) GetPrices(
+	ctx context.Context,
+	denomInfos configTypes.DenomInfos,
+) (map[*configTypes.DenomInfo]float64, error) {
+	result := make(map[*configTypes.DenomInfo]float64)
+
+	for _, denomInfo := range denomInfos {
+		if source := denomInfo.PriceSources.Find(StaticPriceFetcherName); source != nil {
+			result[denomInfo] = source.Price
+		}
+	}
+
+	return result, nil
+}
+
+func (f *StaticPriceFetcher) Name() string {
+	return StaticPriceFetcherName
+}
+
+// GetHistoricalPrice returns the price set in config, as it does not change over time.
+func (f *StaticPriceFetcher) GetHistoricalPrice(
+	ctx context.Context,
+	denomInfo *configTypes.DenomInfo,
+	priceTime time.Time,
+) (float64, error) {
+	source := denomInfo.PriceSources.Find(StaticPriceFetcherName)
+	if source == nil {
+		return 0, errors.New("no static price set")
+	}
+
+	return source.Price, nil
+}
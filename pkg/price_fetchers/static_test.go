@@ -0,0 +1,43 @@
+package price_fetchers
+
+import (
+	"context"
+	"main/pkg/config/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticPriceFetcher(t *testing.T) {
+	t.Parallel()
+
+	fetcher := StaticPriceFetcher{}
+	require.Equal(t, "static", fetcher.Name())
+
+	denomInfos := types.DenomInfos{
+		{Denom: "uusdc", PriceSources: types.PriceSources{{Type: StaticPriceFetcherName, Price: 1}}},
+		{Denom: "uatom", CoingeckoCurrency: "cosmos"},
+	}
+
+	prices, err := fetcher.GetPrices(context.Background(), denomInfos)
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.InDelta(t, 1, prices[denomInfos[0]], 0.001)
+}
+
+func TestStaticPriceFetcherHistoricalPrice(t *testing.T) {
+	t.Parallel()
+
+	fetcher := StaticPriceFetcher{}
+
+	price, err := fetcher.GetHistoricalPrice(context.Background(), &types.DenomInfo{
+		Denom:        "uusdc",
+		PriceSources: types.PriceSources{{Type: StaticPriceFetcherName, Price: 1}},
+	}, time.Now())
+	require.NoError(t, err)
+	require.InDelta(t, 1, price, 0.001)
+
+	_, err = fetcher.GetHistoricalPrice(context.Background(), &types.DenomInfo{Denom: "uatom"}, time.Now())
+	require.Error(t, err)
+}
@@ -0,0 +1,144 @@
+package registry
+
+import (
+	"fmt"
+	configTypes "main/pkg/config/types"
+	"main/pkg/messages"
+	"main/pkg/types"
+	"sort"
+	"sync"
+)
+
+// Entry is a message parser together with an optional Telegram template
+// used to render the message it produces. If the template is empty,
+// the one from templates/telegram/<type>.html is used.
+type Entry struct {
+	Parser   types.MessageParser
+	Template string
+}
+
+var (
+	mutex   sync.RWMutex
+	entries = map[string]Entry{
+		"/cosmos.authz.v1beta1.MsgExec":                               {Parser: messages.ParseMsgExec},
+		"/cosmos.authz.v1beta1.MsgGrant":                              {Parser: messages.ParseMsgGrant},
+		"/cosmos.authz.v1beta1.MsgRevoke":                             {Parser: messages.ParseMsgRevoke},
+		"/cosmos.bank.v1beta1.MsgSend":                                {Parser: messages.ParseMsgSend},
+		"/cosmos.bank.v1beta1.MsgMultiSend":                           {Parser: messages.ParseMsgMultiSend},
+		"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress":          {Parser: messages.ParseMsgSetWithdrawAddress},
+		"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":     {Parser: messages.ParseMsgWithdrawDelegatorReward},
+		"/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission": {Parser: messages.ParseMsgWithdrawValidatorCommission},
+		"/cosmos.gov.v1beta1.MsgVote":                                 {Parser: messages.ParseMsgVote},
+		"/cosmos.staking.v1beta1.MsgDelegate":                         {Parser: messages.ParseMsgDelegate},
+		"/cosmos.staking.v1beta1.MsgBeginRedelegate":                  {Parser: messages.ParseMsgBeginRedelegate},
+		"/cosmos.staking.v1beta1.MsgUndelegate":                       {Parser: messages.ParseMsgUndelegate},
+		"/ibc.applications.transfer.v1.MsgTransfer":                   {Parser: messages.ParseMsgTransfer},
+		"/ibc.core.channel.v1.MsgAcknowledgement":                     {Parser: messages.ParseMsgAcknowledgement},
+		"/ibc.core.channel.v1.MsgRecvPacket":                          {Parser: messages.ParseMsgRecvPacket},
+		"/ibc.core.channel.v1.MsgTimeout":                             {Parser: messages.ParseMsgTimeout},
+		"/ibc.core.client.v1.MsgUpdateClient":                         {Parser: messages.ParseMsgUpdateClient},
+	}
+)
+
+// RegisterParser adds a parser for the given type URL. It is meant to be called
+// from init() of external packages adding chain-specific messages, so they can be
+// supported without forking this repo. It returns an error if the parser is nil
+// or if there is already a parser registered for this type URL.
+// The message returned by the parser should return the same type URL from its Type()
+// method, as this is how the template is looked up when rendering.
+func RegisterParser(typeURL string, parser types.MessageParser, template string) error {
+	if typeURL == "" {
+		return fmt.Errorf("cannot register parser with empty type URL")
+	}
+
+	if parser == nil {
+		return fmt.Errorf("cannot register nil parser for '%s'", typeURL)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if _, ok := entries[typeURL]; ok {
+		return fmt.Errorf("parser for '%s' is already registered", typeURL)
+	}
+
+	entries[typeURL] = Entry{Parser: parser, Template: template}
+	return nil
+}
+
+func GetParser(typeURL string) (types.MessageParser, bool) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	entry, ok := entries[typeURL]
+	if !ok {
+		return nil, false
+	}
+
+	return entry.Parser, true
+}
+
+func GetTemplate(typeURL string) (string, bool) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	entry, ok := entries[typeURL]
+	if !ok || entry.Template == "" {
+		return "", false
+	}
+
+	return entry.Template, true
+}
+
+func HasParser(typeURL string) bool {
+	_, ok := GetParser(typeURL)
+	return ok
+}
+
+func GetTypeURLs() []string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	typeURLs := make([]string, 0, len(entries))
+	for typeURL := range entries {
+		typeURLs = append(typeURLs, typeURL)
+	}
+
+	sort.Strings(typeURLs)
+	return typeURLs
+}
+
+// ValidateParsersConfig checks that all parsers referenced in chain config are registered.
+// It cannot be done when loading config, as external parsers are registered
+// in runtime and the registry depends on config types.
+func ValidateParsersConfig(config configTypes.ParsersConfig) error {
+	for _, typeURL := range config.Enabled {
+		if !HasParser(typeURL) {
+			return fmt.Errorf("enabled parser '%s' is not registered", typeURL)
+		}
+	}
+
+	for _, typeURL := range config.Disabled {
+		if !HasParser(typeURL) {
+			return fmt.Errorf("disabled parser '%s' is not registered", typeURL)
+		}
+	}
+
+	for typeURL, parserName := range config.Overrides {
+		if !HasParser(parserName) {
+			return fmt.Errorf("parser '%s' overriding '%s' is not registered", parserName, typeURL)
+		}
+	}
+
+	return nil
+}
+
+func ValidateChainsParsersConfig(chains configTypes.Chains) error {
+	for _, chain := range chains {
+		if err := ValidateParsersConfig(chain.Parsers); err != nil {
+			return fmt.Errorf("error in chain %s parsers: %s", chain.Name, err)
+		}
+	}
+
+	return nil
+}
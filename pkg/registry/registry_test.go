@@ -0,0 +1,112 @@
+package registry_test
+
+import (
+	configTypes "main/pkg/config/types"
+	"main/pkg/messages"
+	"main/pkg/registry"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ParseTestMessage(data []byte, chain *configTypes.Chain, height int64) (types.Message, error) {
+	return &messages.MsgUnsupportedMessage{MsgType: "/test.registry.MsgTest"}, nil
+}
+
+func TestRegistryDefaultParsers(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, registry.HasParser("/cosmos.bank.v1beta1.MsgSend"))
+	require.False(t, registry.HasParser("/not.existing.MsgNotExisting"))
+	require.Contains(t, registry.GetTypeURLs(), "/cosmos.bank.v1beta1.MsgSend")
+
+	_, found := registry.GetTemplate("/cosmos.bank.v1beta1.MsgSend")
+	require.False(t, found)
+}
+
+func TestRegistryRegisterParser(t *testing.T) {
+	t.Parallel()
+
+	err := registry.RegisterParser("/test.registry.MsgTest", ParseTestMessage, "template")
+	require.NoError(t, err)
+
+	parser, found := registry.GetParser("/test.registry.MsgTest")
+	require.True(t, found)
+	require.NotNil(t, parser)
+
+	template, found := registry.GetTemplate("/test.registry.MsgTest")
+	require.True(t, found)
+	require.Equal(t, "template", template)
+}
+
+func TestRegistryRegisterParserNil(t *testing.T) {
+	t.Parallel()
+
+	err := registry.RegisterParser("/test.registry.MsgNil", nil, "")
+	require.Error(t, err)
+	require.False(t, registry.HasParser("/test.registry.MsgNil"))
+}
+
+func TestRegistryRegisterParserEmptyTypeURL(t *testing.T) {
+	t.Parallel()
+
+	err := registry.RegisterParser("", ParseTestMessage, "")
+	require.Error(t, err)
+}
+
+func TestRegistryRegisterParserDuplicate(t *testing.T) {
+	t.Parallel()
+
+	err := registry.RegisterParser("/cosmos.bank.v1beta1.MsgSend", ParseTestMessage, "template")
+	require.Error(t, err)
+
+	_, found := registry.GetTemplate("/cosmos.bank.v1beta1.MsgSend")
+	require.False(t, found)
+}
+
+func TestValidateParsersConfigUnknownEnabled(t *testing.T) {
+	t.Parallel()
+
+	err := registry.ValidateParsersConfig(configTypes.ParsersConfig{
+		Enabled: []string{"/not.existing.MsgNotExisting"},
+	})
+	require.Error(t, err)
+}
+
+func TestValidateParsersConfigUnknownDisabled(t *testing.T) {
+	t.Parallel()
+
+	err := registry.ValidateParsersConfig(configTypes.ParsersConfig{
+		Disabled: []string{"/not.existing.MsgNotExisting"},
+	})
+	require.Error(t, err)
+}
+
+func TestValidateParsersConfigUnknownOverride(t *testing.T) {
+	t.Parallel()
+
+	err := registry.ValidateParsersConfig(configTypes.ParsersConfig{
+		Overrides: map[string]string{"/cosmos.gov.v1.MsgVote": "/not.existing.MsgNotExisting"},
+	})
+	require.Error(t, err)
+}
+
+func TestValidateChainsParsersConfig(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, registry.ValidateChainsParsersConfig(configTypes.Chains{
+		{Name: "chain", Parsers: configTypes.ParsersConfig{Disabled: []string{"/not.existing.MsgNotExisting"}}},
+	}))
+
+	require.NoError(t, registry.ValidateChainsParsersConfig(configTypes.Chains{
+		{
+			Name: "chain",
+			Parsers: configTypes.ParsersConfig{
+				Enabled:   []string{"/cosmos.bank.v1beta1.MsgSend"},
+				Disabled:  []string{"/cosmos.gov.v1beta1.MsgVote"},
+				Overrides: map[string]string{"/cosmos.gov.v1.MsgVote": "/cosmos.gov.v1beta1.MsgVote"},
+			},
+		},
+	}))
+}
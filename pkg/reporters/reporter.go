@@ -8,6 +8,7 @@ import (
 	"main/pkg/data_fetcher"
 	"main/pkg/metrics"
 	nodesManager "main/pkg/nodes_manager"
+	"main/pkg/outbox"
 	"main/pkg/reporters/telegram"
 	"main/pkg/types"
 
@@ -20,6 +21,7 @@ type Reporter interface {
 	Name() string
 	Type() string
 	Send(report types.Report) error
+	Stop()
 }
 
 type Reporters []Reporter
@@ -42,6 +44,7 @@ func GetReporter(
 	aliasManager *alias_manager.AliasManager,
 	metricsManager *metrics.Manager,
 	dataFetcher *data_fetcher.DataFetcher,
+	outbox *outbox.Outbox,
 	version string,
 ) Reporter {
 	if reporterConfig.Type == constants.ReporterTypeTelegram {
@@ -53,6 +56,7 @@ func GetReporter(
 			aliasManager,
 			metricsManager,
 			dataFetcher,
+			outbox,
 			version,
 		)
 	}
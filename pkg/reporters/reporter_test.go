@@ -16,7 +16,7 @@ func TestGetReporterFail(t *testing.T) {
 		}
 	}()
 
-	GetReporter(&configTypes.Reporter{}, nil, nil, nil, nil, nil, nil, "1.2.3")
+	GetReporter(&configTypes.Reporter{}, nil, nil, nil, nil, nil, nil, nil, "1.2.3")
 }
 
 func TestFindReporterByName(t *testing.T) {
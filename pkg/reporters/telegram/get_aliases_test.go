@@ -53,6 +53,7 @@ func TestGetAliasesDisabled(t *testing.T) {
 		alias_manager.NewAliasManager(logger, &configPkg.AppConfig{}, &fs.MockFs{}),
 		metrics.NewManager(logger, configPkg.MetricsConfig{}),
 		nil,
+		nil,
 		"1.2.3",
 	)
 
@@ -110,6 +111,7 @@ func TestGetAliasesCouldNotFindSubscription(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		nil,
 		"1.2.3",
 	)
 
@@ -179,6 +181,7 @@ func TestGetAliasesOk(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		nil,
 		"1.2.3",
 	)
 
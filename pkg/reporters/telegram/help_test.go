@@ -50,6 +50,7 @@ func TestAppHelpOk(t *testing.T) {
 		nil,
 		metrics.NewManager(logger, configPkg.MetricsConfig{}),
 		nil,
+		nil,
 		"1.2.3",
 	)
 
@@ -22,16 +22,21 @@ func (reporter *Reporter) HandleListNodesStatus(c tele.Context) (string, error)
 		return "This reporter is not linked to any chains!", fmt.Errorf("no chains linked")
 	}
 
-	statuses := map[string]map[string]types.TendermintRPCStatus{}
+	statuses := map[string]types.ChainNodesStatus{}
 
 	for chain, chainNodes := range reporter.NodesManager.Nodes {
 		if !chains.HasChain(chain) {
 			continue
 		}
 
-		statuses[chain] = map[string]types.TendermintRPCStatus{}
+		tendermintNodes := map[string]types.TendermintRPCStatus{}
 		for _, node := range chainNodes {
-			statuses[chain][node.URL] = node.Status()
+			tendermintNodes[node.GetURL()] = node.Status()
+		}
+
+		statuses[chain] = types.ChainNodesStatus{
+			TendermintNodes: tendermintNodes,
+			ApiNodes:        reporter.DataFetcher.GetApiNodesStatuses(chain),
 		}
 	}
 
@@ -57,6 +57,7 @@ func TestListNodesCouldNotFindSubscription(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		nil,
 		"1.2.3",
 	)
 
@@ -115,7 +116,7 @@ func TestListNodesOk(t *testing.T) {
 	}
 	aliasManager := alias_manager.NewAliasManager(logger, config, &fs.MockFs{})
 	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{})
-	nodeManager := nodes_manager.NewNodesManager(logger, config, metricsManager)
+	nodeManager := nodes_manager.NewNodesManager(logger, config, metricsManager, nil)
 
 	reporter := NewReporter(
 		&configTypes.Reporter{
@@ -130,6 +131,7 @@ func TestListNodesOk(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		nil,
 		"1.2.3",
 	)
 
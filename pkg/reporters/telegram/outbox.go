@@ -0,0 +1,45 @@
+package telegram
+
+import (
+	"fmt"
+	"main/pkg/constants"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (reporter *Reporter) GetOutboxCommand() Command {
+	return Command{
+		Name:    "outbox",
+		Query:   constants.ReporterQueryOutbox,
+		Execute: reporter.HandleOutbox,
+	}
+}
+
+func (reporter *Reporter) GetFlushOutboxCommand() Command {
+	return Command{
+		Name:    "outbox_flush",
+		Query:   constants.ReporterQueryFlushOutbox,
+		Execute: reporter.HandleFlushOutbox,
+	}
+}
+
+func (reporter *Reporter) HandleOutbox(c tele.Context) (string, error) {
+	messages := reporter.Outbox.GetPending(reporter.Name())
+
+	render := OutboxRender{Total: len(messages), Messages: messages}
+	if len(messages) > constants.OutboxMaxShownEntries {
+		render.Messages = messages[:constants.OutboxMaxShownEntries]
+		render.Hidden = len(messages) - constants.OutboxMaxShownEntries
+	}
+
+	return reporter.TemplatesManager.Render("Outbox", render)
+}
+
+func (reporter *Reporter) HandleFlushOutbox(c tele.Context) (string, error) {
+	count := reporter.Outbox.Flush(reporter.Name())
+	if count == 0 {
+		return "No messages waiting to be sent!", nil
+	}
+
+	return fmt.Sprintf("Retrying sending %d message(s).", count), nil
+}
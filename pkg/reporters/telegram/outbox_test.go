@@ -0,0 +1,274 @@
+package telegram
+
+import (
+	"errors"
+	"main/assets"
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	outboxPkg "main/pkg/outbox"
+	"main/pkg/types"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+	tele "gopkg.in/telebot.v3"
+)
+
+func getOutboxTestReporter(t *testing.T, outboxPath string) *Reporter {
+	t.Helper()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	timezone, err := time.LoadLocation("Etc/GMT")
+	require.NoError(t, err)
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{OutboxPath: outboxPath}
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{})
+	outbox := outboxPkg.NewOutbox(logger, config, &fs.MockFs{}, metricsManager)
+	outbox.Load()
+
+	reporter := NewReporter(
+		&configTypes.Reporter{
+			Name:           "reporter",
+			Type:           "telegram",
+			TelegramConfig: &configTypes.TelegramConfig{Token: "xxx:yyy", Chat: 123, Admins: []int64{1}},
+			Timezone:       timezone,
+		},
+		config,
+		logger,
+		nil,
+		nil,
+		metricsManager,
+		nil,
+		outbox,
+		"1.2.3",
+	)
+
+	err = reporter.Init()
+	require.NoError(t, err)
+
+	return reporter
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestSendRateLimited(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reporter := getOutboxTestReporter(t, "")
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		httpmock.NewStringResponder(
+			429,
+			`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 30","parameters":{"retry_after":30}}`,
+		),
+	)
+
+	err := reporter.BotSend("first")
+	require.Error(t, err)
+	require.Equal(t, 30*time.Second, GetRetryAfter(err))
+
+	// While there are messages in the outbox, new ones are put after them without being sent.
+	err = reporter.BotSend("second")
+	require.ErrorIs(t, err, ErrQueued)
+	require.Equal(t, 1, httpmock.GetTotalCallCount()-1)
+
+	pending := reporter.Outbox.GetPending("reporter")
+	require.Len(t, pending, 2)
+	require.WithinDuration(t, time.Now().Add(30*time.Second), pending[0].NextAttemptAt, time.Second)
+	require.WithinDuration(t, time.Now(), pending[1].NextAttemptAt, time.Second)
+
+	// While rate-limited, messages are not even tried to be sent.
+	reporter.Outbox.Flush("reporter")
+	reporter.RetryPending()
+	require.Equal(t, 1, httpmock.GetTotalCallCount()-1)
+
+	pending = reporter.Outbox.GetPending("reporter")
+	require.Len(t, pending, 2)
+	require.Contains(t, pending[0].LastError, "rate limited by Telegram")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRetryPending(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reporter := getOutboxTestReporter(t, "")
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	require.Error(t, reporter.BotSend("first"))
+	require.Error(t, reporter.BotSend("second"))
+
+	reporter.Outbox.Flush("reporter")
+	reporter.RetryPending()
+
+	pending := reporter.Outbox.GetPending("reporter")
+	require.Len(t, pending, 2)
+	require.Equal(t, 2, pending[0].Attempts)
+	require.Equal(t, 0, pending[1].Attempts)
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")),
+	)
+
+	reporter.Outbox.Flush("reporter")
+	reporter.RetryPending()
+	require.Empty(t, reporter.Outbox.GetPending("reporter"))
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestSendRejected(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reporter := getOutboxTestReporter(t, "")
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		httpmock.NewStringResponder(
+			400,
+			`{"ok":false,"error_code":400,"description":"Bad Request: can't parse entities: unexpected end tag"}`,
+		),
+	)
+
+	// Rejected messages are not retried.
+	err := reporter.BotSend("first")
+	require.Error(t, err)
+	require.True(t, IsPermanentError(err))
+	require.Empty(t, reporter.Outbox.GetPending("reporter"))
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRetryPendingRejected(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reporter := getOutboxTestReporter(t, "")
+	reporter.Outbox.Add("reporter", "rejected", errors.New("error"), 0)
+	reporter.Outbox.Queue("reporter", "second", ErrQueued)
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(
+				400,
+				`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`,
+			),
+			httpmock.NewBytesResponse(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")),
+		}),
+	)
+
+	// The rejected message is dropped, and the next ones are sent after it.
+	reporter.Outbox.Flush("reporter")
+	reporter.RetryPending()
+	require.Empty(t, reporter.Outbox.GetPending("reporter"))
+	require.Equal(t, 2, httpmock.GetTotalCallCount()-1)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestOutboxEmpty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reporter := getOutboxTestReporter(t, "")
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("No messages waiting to be sent!"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")),
+	)
+
+	context := reporter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser"},
+			Text:   "/outbox",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err := reporter.Handler(reporter.GetOutboxCommand())(context)
+	require.NoError(t, err)
+
+	err = reporter.Handler(reporter.GetFlushOutboxCommand())(context)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestOutboxOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reporter := getOutboxTestReporter(t, "outbox.yml")
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/outbox.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")),
+	)
+
+	context := reporter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser"},
+			Text:   "/outbox",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err := reporter.Handler(reporter.GetOutboxCommand())(context)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestFlushOutboxOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reporter := getOutboxTestReporter(t, "outbox.yml")
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Retrying sending 2 message(s)."),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")),
+	)
+
+	context := reporter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser"},
+			Text:   "/outbox_flush",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err := reporter.Handler(reporter.GetFlushOutboxCommand())(context)
+	require.NoError(t, err)
+
+	for _, message := range reporter.Outbox.GetPending("reporter") {
+		require.False(t, message.NextAttemptAt.After(time.Now()))
+	}
+}
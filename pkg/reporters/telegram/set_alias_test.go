@@ -53,6 +53,7 @@ func TestSetAliasesInvalidInvocationFailedToSend(t *testing.T) {
 		alias_manager.NewAliasManager(logger, &configPkg.AppConfig{}, &fs.MockFs{}),
 		metrics.NewManager(logger, configPkg.MetricsConfig{}),
 		nil,
+		nil,
 		"1.2.3",
 	)
 
@@ -108,6 +109,7 @@ func TestSetAliasesInvalidInvocation(t *testing.T) {
 		alias_manager.NewAliasManager(logger, &configPkg.AppConfig{}, &fs.MockFs{}),
 		metrics.NewManager(logger, configPkg.MetricsConfig{}),
 		nil,
+		nil,
 		"1.2.3",
 	)
 
@@ -163,6 +165,7 @@ func TestSetAliasesDisabled(t *testing.T) {
 		alias_manager.NewAliasManager(logger, &configPkg.AppConfig{}, &fs.MockFs{}),
 		metrics.NewManager(logger, configPkg.MetricsConfig{}),
 		nil,
+		nil,
 		"1.2.3",
 	)
 
@@ -235,6 +238,7 @@ func TestSetAliasesCouldNotFindChain(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		nil,
 		"1.2.3",
 	)
 
@@ -295,6 +299,7 @@ func TestSetAliasesCouldNotFindSubscription(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		nil,
 		"1.2.3",
 	)
 
@@ -363,6 +368,7 @@ func TestSetAliasesCouldNotSaveAlias(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		nil,
 		"1.2.3",
 	)
 
@@ -431,6 +437,7 @@ func TestSetAliasesOk(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		nil,
 		"1.2.3",
 	)
 
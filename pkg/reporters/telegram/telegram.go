@@ -7,9 +7,12 @@ import (
 	"main/pkg/constants"
 	"main/pkg/data_fetcher"
 	"main/pkg/metrics"
+	"main/pkg/outbox"
 	"main/pkg/templates"
 	"main/pkg/types"
+	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"gopkg.in/telebot.v3/middleware"
@@ -38,16 +41,23 @@ type Reporter struct {
 	AliasManager     *alias_manager.AliasManager
 	MetricsManager   *metrics.Manager
 	DataFetcher      *data_fetcher.DataFetcher
+	Outbox           *outbox.Outbox
 	TemplatesManager templates.Manager
 
 	Version     string
 	StopChannel chan bool
+
+	// Telegram asks to not send anything until this time if it rate-limits the bot.
+	rateLimitedUntil time.Time
+	rateLimitMutex   sync.Mutex
 }
 
 const (
 	MaxMessageSize = 4096
 )
 
+// NewReporter creates a Telegram reporter. The outbox is required, as messages that could not
+// be sent are put there, and if it is disabled, it only keeps them until the app is stopped.
 func NewReporter(
 	reporterConfig *configTypes.Reporter,
 	config *config.AppConfig,
@@ -56,6 +66,7 @@ func NewReporter(
 	aliasManager *alias_manager.AliasManager,
 	metricsManager *metrics.Manager,
 	dataFetcher *data_fetcher.DataFetcher,
+	outbox *outbox.Outbox,
 	version string,
 ) *Reporter {
 	return &Reporter{
@@ -70,6 +81,7 @@ func NewReporter(
 		AliasManager:     aliasManager,
 		MetricsManager:   metricsManager,
 		DataFetcher:      dataFetcher,
+		Outbox:           outbox,
 		Version:          version,
 		StopChannel:      make(chan bool),
 	}
@@ -100,6 +112,8 @@ func (reporter *Reporter) Init() error {
 	reporter.AddCommand("/status", bot, reporter.GetListNodesCommand())
 	reporter.AddCommand("/alias", bot, reporter.GetSetAliasCommand())
 	reporter.AddCommand("/aliases", bot, reporter.GetGetAliasesCommand())
+	reporter.AddCommand("/outbox", bot, reporter.GetOutboxCommand())
+	reporter.AddCommand("/outbox_flush", bot, reporter.GetFlushOutboxCommand())
 
 	reporter.TelegramBot = bot
 
@@ -113,9 +127,55 @@ func (reporter *Reporter) Start() {
 
 	go reporter.TelegramBot.Start()
 
-	<-reporter.StopChannel
-	reporter.Logger.Info().Msg("Shutting down...")
-	reporter.TelegramBot.Stop()
+	ticker := time.NewTicker(constants.OutboxRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			reporter.RetryPending()
+		case <-reporter.StopChannel:
+			reporter.Logger.Info().Msg("Shutting down...")
+			reporter.TelegramBot.Stop()
+			return
+		}
+	}
+}
+
+// RetryPending tries to send the messages from the outbox that are due to be retried,
+// oldest first, stopping at the first one that fails, as the next ones would likely fail too.
+// Messages that Telegram has rejected and that would never be sent are dropped.
+func (reporter *Reporter) RetryPending() {
+	for _, message := range reporter.Outbox.GetDue(reporter.Name()) {
+		if err := reporter.BotSendMessage(message.Text); err != nil {
+			if IsPermanentError(err) {
+				reporter.Logger.Error().
+					Err(err).
+					Int64("id", message.ID).
+					Int("attempts", message.Attempts).
+					Msg("Telegram has rejected the message, dropping it")
+				reporter.Outbox.Drop(reporter.Name(), message.ID)
+				reporter.MetricsManager.LogOutboxRetry(reporter.Name(), false)
+				continue
+			}
+
+			reporter.Logger.Warn().
+				Err(err).
+				Int64("id", message.ID).
+				Int("attempts", message.Attempts).
+				Msg("Could not resend Telegram message")
+			reporter.Outbox.MarkFailed(reporter.Name(), message.ID, err, GetRetryAfter(err))
+			reporter.MetricsManager.LogOutboxRetry(reporter.Name(), false)
+			return
+		}
+
+		reporter.Logger.Info().
+			Int64("id", message.ID).
+			Int("attempts", message.Attempts).
+			Msg("Resent Telegram message")
+		reporter.Outbox.MarkSent(reporter.Name(), message.ID)
+		reporter.MetricsManager.LogOutboxRetry(reporter.Name(), true)
+	}
 }
 
 func (reporter *Reporter) AddCommand(query string, bot *tele.Bot, command Command) {
@@ -196,23 +256,107 @@ func (reporter *Reporter) Type() string {
 	return constants.ReporterTypeTelegram
 }
 
+// BotSend sends a message to the chat, splitting it if it's too long.
+// Parts that failed to be sent are put into the outbox to be retried later, unless
+// Telegram has rejected them, and if there are messages in the outbox already,
+// it is put there right away, so messages are sent in the order they were sent with.
 func (reporter *Reporter) BotSend(msg string) error {
 	messages := utils.SplitStringIntoChunks(msg, MaxMessageSize)
 
-	for _, message := range messages {
-		if _, err := reporter.TelegramBot.Send(
-			&tele.User{ID: reporter.Chat},
-			strings.TrimSpace(message),
-			tele.ModeHTML,
-			tele.NoPreview,
-		); err != nil {
-			reporter.Logger.Error().Err(err).Msg("Could not send Telegram message")
+	if reporter.Outbox.HasPending(reporter.Name()) {
+		for _, message := range messages {
+			reporter.Outbox.Queue(reporter.Name(), message, ErrQueued)
+		}
+
+		return ErrQueued
+	}
+
+	for index, message := range messages {
+		if err := reporter.BotSendMessage(message); err != nil {
+			if IsPermanentError(err) {
+				reporter.Logger.Error().
+					Err(err).
+					Int("parts", len(messages)-index).
+					Msg("Telegram has rejected the message, dropping it")
+				return err
+			}
+
+			reporter.Logger.Error().Err(err).Msg("Could not send Telegram message, will retry later")
+
+			retryAfter := GetRetryAfter(err)
+			for _, unsentMessage := range messages[index:] {
+				reporter.Outbox.Add(reporter.Name(), unsentMessage, err, retryAfter)
+			}
+
 			return err
 		}
 	}
 	return nil
 }
 
+// BotSendMessage sends a single message to the chat, unless Telegram has rate-limited the bot,
+// in which case it fails right away.
+func (reporter *Reporter) BotSendMessage(message string) error {
+	reporter.rateLimitMutex.Lock()
+	rateLimitedFor := time.Until(reporter.rateLimitedUntil)
+	reporter.rateLimitMutex.Unlock()
+
+	if rateLimitedFor > 0 {
+		return &RateLimitedError{RetryAfter: rateLimitedFor}
+	}
+
+	_, err := reporter.TelegramBot.Send(
+		&tele.User{ID: reporter.Chat},
+		strings.TrimSpace(message),
+		tele.ModeHTML,
+		tele.NoPreview,
+	)
+
+	if retryAfter := GetRetryAfter(err); retryAfter > 0 {
+		reporter.rateLimitMutex.Lock()
+		reporter.rateLimitedUntil = time.Now().Add(retryAfter)
+		reporter.rateLimitMutex.Unlock()
+	}
+
+	return err
+}
+
+// GetRetryAfter returns how long Telegram asked to wait before sending anything
+// if the error is caused by the bot being rate-limited, or 0 otherwise.
+func GetRetryAfter(err error) time.Duration {
+	var floodErr tele.FloodError
+	if errors.As(err, &floodErr) {
+		return time.Duration(floodErr.RetryAfter) * time.Second
+	}
+
+	var rateLimitedErr *RateLimitedError
+	if errors.As(err, &rateLimitedErr) {
+		return rateLimitedErr.RetryAfter
+	}
+
+	return 0
+}
+
+// IsPermanentError returns true if Telegram has rejected the message, so retrying it
+// would not help: for example, if it cannot be parsed, or the chat is not found.
+func IsPermanentError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var teleErr *tele.Error
+	if errors.As(err, &teleErr) {
+		return teleErr.Code == http.StatusBadRequest || teleErr.Code == http.StatusForbidden
+	}
+
+	// errors that telebot does not know are only returned as text,
+	// like "telegram: Bad Request: can't parse entities: ... (400)"
+	message := err.Error()
+	return strings.HasPrefix(message, "telegram: ") &&
+		(strings.HasSuffix(message, fmt.Sprintf("(%d)", http.StatusBadRequest)) ||
+			strings.HasSuffix(message, fmt.Sprintf("(%d)", http.StatusForbidden)))
+}
+
 func (reporter *Reporter) BotReply(c tele.Context, msg string) error {
 	messages := utils.SplitStringIntoChunks(msg, MaxMessageSize)
 
@@ -225,6 +369,7 @@ func (reporter *Reporter) BotReply(c tele.Context, msg string) error {
 	return nil
 }
 
+// Stop stops the bot. It does not block, so it can be called even if the reporter was not started.
 func (reporter *Reporter) Stop() {
-	reporter.StopChannel <- true
+	close(reporter.StopChannel)
 }
@@ -10,6 +10,7 @@ import (
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
+	outboxPkg "main/pkg/outbox"
 	"main/pkg/types"
 	"testing"
 	"time"
@@ -46,6 +47,7 @@ func TestTelegramReporterNoCredentials(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		"v1.2.3",
 	)
 
@@ -82,6 +84,7 @@ func TestTelegramReporterCannotFetchBot(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		"v1.2.3",
 	)
 
@@ -116,6 +119,7 @@ func TestTelegramReporterStartsOkay(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		"v1.2.3",
 	)
 
@@ -152,6 +156,7 @@ func TestTelegramReporterStartsDisabled(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		"v1.2.3",
 	)
 
@@ -192,6 +197,7 @@ func TestHandlerInternalError(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		outboxPkg.NewOutbox(logger, config, &fs.MockFs{}, metricsManager),
 		"1.2.3",
 	)
 
@@ -254,6 +260,7 @@ func TestSendReportFailToSerializeAndSend(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		outboxPkg.NewOutbox(logger, config, &fs.MockFs{}, metricsManager),
 		"1.2.3",
 	)
 
@@ -269,6 +276,7 @@ func TestSendReportFailToSerializeAndSend(t *testing.T) {
 	})
 	require.Error(t, err)
 	require.ErrorContains(t, err, "custom error")
+	require.Len(t, reporter.Outbox.GetPending("reporter"), 1)
 }
 
 //nolint:paralleltest // disabled
@@ -305,6 +313,7 @@ func TestSendReportFailToSerialize(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		outboxPkg.NewOutbox(logger, config, &fs.MockFs{}, metricsManager),
 		"1.2.3",
 	)
 
@@ -354,6 +363,7 @@ func TestSendReportFailToSend(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		outboxPkg.NewOutbox(logger, config, &fs.MockFs{}, metricsManager),
 		"1.2.3",
 	)
 
@@ -373,6 +383,7 @@ func TestSendReportFailToSend(t *testing.T) {
 	})
 	require.Error(t, err)
 	require.ErrorContains(t, err, "custom error")
+	require.Len(t, reporter.Outbox.GetPending("reporter"), 1)
 }
 
 //nolint:paralleltest // disabled
@@ -409,6 +420,7 @@ func TestSendReportOk(t *testing.T) {
 		aliasManager,
 		metricsManager,
 		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		outboxPkg.NewOutbox(logger, config, &fs.MockFs{}, metricsManager),
 		"1.2.3",
 	)
 
@@ -428,3 +440,60 @@ func TestSendReportOk(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+//nolint:paralleltest // disabled
+func TestSendReportNodeStalled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("⚠️ Node <code>https://example.com</code> on chain has stalled: no new blocks for 1m30s since block 123, 20 blocks behind other nodes"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{}
+	aliasManager := alias_manager.NewAliasManager(logger, config, &fs.MockFs{})
+	metricsManager := metrics.NewManager(logger, configPkg.MetricsConfig{})
+
+	reporter := NewReporter(
+		&configTypes.Reporter{
+			Name:           "reporter",
+			Type:           "telegram",
+			TelegramConfig: &configTypes.TelegramConfig{Token: "xxx:yyy", Chat: 123, Admins: []int64{1}},
+		},
+		config,
+		logger,
+		nil,
+		aliasManager,
+		metricsManager,
+		data_fetcher.NewDataFetcher(logger, config, aliasManager, metricsManager),
+		outboxPkg.NewOutbox(logger, config, &fs.MockFs{}, metricsManager),
+		"1.2.3",
+	)
+
+	err := reporter.Init()
+	require.NoError(t, err)
+
+	err = reporter.Send(types.Report{
+		Chain:             &configTypes.Chain{Name: "chain"},
+		Subscription:      &configTypes.Subscription{Name: "subscription"},
+		ChainSubscription: &configTypes.ChainSubscription{},
+		Node:              "https://example.com",
+		Reportable: &types.NodeStalled{
+			Chain:          "chain",
+			URL:            "https://example.com",
+			Height:         123,
+			BlocksBehind:   20,
+			SinceLastBlock: 90*time.Second + 300*time.Millisecond,
+		},
+	})
+	require.NoError(t, err)
+}
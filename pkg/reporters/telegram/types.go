@@ -1,8 +1,12 @@
 package telegram
 
 import (
+	"errors"
+	"fmt"
 	"main/pkg/config/types"
 	"main/pkg/constants"
+	"main/pkg/outbox"
+	"time"
 
 	tele "gopkg.in/telebot.v3"
 )
@@ -20,3 +24,23 @@ type SetAliasRender struct {
 	Address string
 	Chain   *types.Chain
 }
+
+type OutboxRender struct {
+	Total    int
+	Hidden   int
+	Messages []outbox.Message
+}
+
+// RateLimitedError is returned when a message is not sent because Telegram
+// has asked to not send anything for some time.
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limited by Telegram, retry after %s", e.RetryAfter.Round(time.Second))
+}
+
+// ErrQueued is returned when a message is not sent right away, but put into the outbox
+// after the messages waiting there, so it is not sent before them.
+var ErrQueued = errors.New("older messages are waiting to be sent, put into the outbox")
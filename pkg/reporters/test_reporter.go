@@ -10,6 +10,7 @@ type TestReporter struct {
 	FailToSend   bool
 	FailToInit   bool
 	ReporterName string
+	Stopped      bool
 }
 
 func (r *TestReporter) Init() error {
@@ -24,6 +25,10 @@ func (r *TestReporter) Start() {
 
 }
 
+func (r *TestReporter) Stop() {
+	r.Stopped = true
+}
+
 func (r *TestReporter) Name() string {
 	return r.ReporterName
 }
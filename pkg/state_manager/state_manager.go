@@ -0,0 +1,190 @@
+package state_manager
+
+import (
+	"io"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+)
+
+// State is what is persisted between restarts: last processed block height
+// per chain, so transactions that happened while the app was not running
+// can be backfilled, and hashes of recently sent transactions, so ones
+// that were already sent are not sent again.
+type State struct {
+	LastBlockHeights map[string]int64 `yaml:"last-block-heights"`
+	DeliveredHashes  []string         `yaml:"delivered-hashes"`
+}
+
+type StateManager struct {
+	Logger zerolog.Logger
+	Path   string
+	FS     fs.FS
+
+	state State
+	dirty bool
+	mutex sync.Mutex
+
+	stopChannel chan bool
+}
+
+func NewStateManager(
+	logger *zerolog.Logger,
+	config *config.AppConfig,
+	fs fs.FS,
+) *StateManager {
+	return &StateManager{
+		Logger: logger.With().Str("component", "state_manager").Logger(),
+		Path:   config.StatePath,
+		FS:     fs,
+		state: State{
+			LastBlockHeights: map[string]int64{},
+			DeliveredHashes:  []string{},
+		},
+		stopChannel: make(chan bool),
+	}
+}
+
+func (m *StateManager) Enabled() bool {
+	return m.Path != ""
+}
+
+// Load reads the state from disk. If it cannot be read (for example,
+// on the first start), the app starts with an empty state.
+func (m *StateManager) Load() {
+	if !m.Enabled() {
+		m.Logger.Warn().Msg("State path not set, not loading state")
+		return
+	}
+
+	stateBytes, err := m.FS.ReadFile(m.Path)
+	if err != nil {
+		m.Logger.Warn().Err(err).Msg("Could not load state, starting with an empty one")
+		return
+	}
+
+	var state State
+	if err = yaml.Unmarshal(stateBytes, &state); err != nil {
+		m.Logger.Error().Err(err).Msg("Could not decode state, starting with an empty one")
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if state.LastBlockHeights != nil {
+		m.state.LastBlockHeights = state.LastBlockHeights
+	}
+
+	if state.DeliveredHashes != nil {
+		m.state.DeliveredHashes = state.DeliveredHashes
+	}
+
+	m.Logger.Info().
+		Int("chains", len(m.state.LastBlockHeights)).
+		Int("hashes", len(m.state.DeliveredHashes)).
+		Msg("State loaded")
+}
+
+// Save writes the state to disk if it has changed since it was last saved.
+func (m *StateManager) Save() error {
+	if !m.Enabled() {
+		return nil
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.dirty {
+		return nil
+	}
+
+	if err := fs.WriteFileAtomically(m.FS, m.Path, func(writer io.Writer) error {
+		return yaml.NewEncoder(writer).Encode(m.state)
+	}); err != nil {
+		m.Logger.Error().Err(err).Msg("Could not save state")
+		return err
+	}
+
+	m.dirty = false
+	return nil
+}
+
+// Listen periodically saves the state, so that it is not written on each transaction.
+func (m *StateManager) Listen() {
+	ticker := time.NewTicker(constants.StateSaveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = m.Save()
+		case <-m.stopChannel:
+			return
+		}
+	}
+}
+
+// Stop stops periodic saving and saves the state for the last time.
+func (m *StateManager) Stop() {
+	close(m.stopChannel)
+	_ = m.Save()
+}
+
+func (m *StateManager) GetLastBlockHeights() map[string]int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	heights := make(map[string]int64, len(m.state.LastBlockHeights))
+	for chain, height := range m.state.LastBlockHeights {
+		heights[chain] = height
+	}
+
+	return heights
+}
+
+// SetLastBlockHeight stores the last processed height for a chain, only if it's
+// higher than the stored one.
+func (m *StateManager) SetLastBlockHeight(chain string, height int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if lastHeight, ok := m.state.LastBlockHeights[chain]; ok && lastHeight >= height {
+		return
+	}
+
+	m.state.LastBlockHeights[chain] = height
+	m.dirty = true
+}
+
+func (m *StateManager) GetDeliveredHashes() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return append([]string{}, m.state.DeliveredHashes...)
+}
+
+// AddDeliveredHash stores the hash of a sent transaction, keeping only
+// the last constants.DeliveredHashesCount ones.
+func (m *StateManager) AddDeliveredHash(hash string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, deliveredHash := range m.state.DeliveredHashes {
+		if deliveredHash == hash {
+			return
+		}
+	}
+
+	m.state.DeliveredHashes = append(m.state.DeliveredHashes, hash)
+	if len(m.state.DeliveredHashes) > constants.DeliveredHashesCount {
+		m.state.DeliveredHashes = m.state.DeliveredHashes[len(m.state.DeliveredHashes)-constants.DeliveredHashesCount:]
+	}
+
+	m.dirty = true
+}
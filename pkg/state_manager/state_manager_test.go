@@ -0,0 +1,152 @@
+package state_manager_test
+
+import (
+	"bytes"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/state_manager"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type BufferFile struct {
+	bytes.Buffer
+}
+
+func (file *BufferFile) Sync() error {
+	return nil
+}
+
+func (file *BufferFile) Close() error {
+	return nil
+}
+
+type BufferFs struct {
+	File *BufferFile
+}
+
+func (filesystem *BufferFs) ReadFile(name string) ([]byte, error) {
+	return filesystem.File.Bytes(), nil
+}
+
+func (filesystem *BufferFs) Create(path string) (fs.File, error) {
+	filesystem.File = &BufferFile{}
+	return filesystem.File, nil
+}
+
+func (filesystem *BufferFs) Rename(oldPath, newPath string) error {
+	return nil
+}
+
+func TestStateManagerLoadDisabled(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	manager := state_manager.NewStateManager(logger, &configPkg.AppConfig{}, &fs.MockFs{})
+	require.False(t, manager.Enabled())
+
+	manager.Load()
+	require.Empty(t, manager.GetLastBlockHeights())
+	require.Empty(t, manager.GetDeliveredHashes())
+
+	manager.SetLastBlockHeight("cosmos", 123)
+	require.NoError(t, manager.Save())
+}
+
+func TestStateManagerLoadFailed(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{StatePath: "nonexistent.yml"}
+	manager := state_manager.NewStateManager(logger, config, &fs.MockFs{})
+	manager.Load()
+	require.Empty(t, manager.GetLastBlockHeights())
+	require.Empty(t, manager.GetDeliveredHashes())
+}
+
+func TestStateManagerLoadInvalidYaml(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{StatePath: "invalid-yaml.yml"}
+	manager := state_manager.NewStateManager(logger, config, &fs.MockFs{})
+	manager.Load()
+	require.Empty(t, manager.GetLastBlockHeights())
+	require.Empty(t, manager.GetDeliveredHashes())
+}
+
+func TestStateManagerLoadOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{StatePath: "state.yml"}
+	manager := state_manager.NewStateManager(logger, config, &fs.MockFs{})
+	manager.Load()
+	require.Equal(t, map[string]int64{"cosmos": 123}, manager.GetLastBlockHeights())
+	require.Equal(t, []string{"hash1", "hash2"}, manager.GetDeliveredHashes())
+}
+
+func TestStateManagerSaveFailed(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{StatePath: "state.yml"}
+
+	for _, filesystem := range []*fs.MockFs{
+		{FailCreate: true},
+		{FailWrite: true},
+		{FailSync: true},
+		{FailClose: true},
+		{FailRename: true},
+	} {
+		manager := state_manager.NewStateManager(logger, config, filesystem)
+		manager.SetLastBlockHeight("cosmos", 123)
+		require.Error(t, manager.Save())
+	}
+}
+
+func TestStateManagerSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.AppConfig{StatePath: "state.yml"}
+	filesystem := &BufferFs{}
+
+	manager := state_manager.NewStateManager(logger, config, filesystem)
+
+	// nothing has changed, so nothing is written
+	require.NoError(t, manager.Save())
+	require.Nil(t, filesystem.File)
+
+	manager.SetLastBlockHeight("cosmos", 123)
+	manager.SetLastBlockHeight("cosmos", 100)
+	manager.AddDeliveredHash("hash1")
+	manager.AddDeliveredHash("hash1")
+	manager.Stop()
+	require.NotNil(t, filesystem.File)
+
+	loadedManager := state_manager.NewStateManager(logger, config, filesystem)
+	loadedManager.Load()
+	require.Equal(t, map[string]int64{"cosmos": 123}, loadedManager.GetLastBlockHeights())
+	require.Equal(t, []string{"hash1"}, loadedManager.GetDeliveredHashes())
+}
+
+func TestStateManagerDeliveredHashesLimit(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	manager := state_manager.NewStateManager(logger, &configPkg.AppConfig{}, &fs.MockFs{})
+
+	for index := 0; index < constants.DeliveredHashesCount+10; index++ {
+		manager.AddDeliveredHash(strconv.Itoa(index))
+	}
+
+	hashes := manager.GetDeliveredHashes()
+	require.Len(t, hashes, constants.DeliveredHashesCount)
+	require.Equal(t, "10", hashes[0])
+	require.Equal(t, strconv.Itoa(constants.DeliveredHashesCount+9), hashes[len(hashes)-1])
+}
@@ -0,0 +1,66 @@
+package state_manager
+
+import "sync"
+
+// Watermark tracks the heights of transactions that are being processed for each chain,
+// to know the height up to which all the transactions are processed. As transactions
+// are processed in parallel and may be done out of order, the last received height
+// cannot be stored as processed, otherwise transactions still in progress would not
+// be backfilled if the app is stopped before they are sent.
+type Watermark struct {
+	pending   map[string]map[int64]int
+	completed map[string]int64
+	mutex     sync.Mutex
+}
+
+func NewWatermark() *Watermark {
+	return &Watermark{
+		pending:   map[string]map[int64]int{},
+		completed: map[string]int64{},
+	}
+}
+
+// Add marks a transaction at the given height as being processed.
+func (w *Watermark) Add(chain string, height int64) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if _, ok := w.pending[chain]; !ok {
+		w.pending[chain] = map[int64]int{}
+	}
+
+	w.pending[chain][height]++
+}
+
+// Complete marks a transaction at the given height as processed and returns the height
+// up to which all the transactions on the chain are processed: the lowest height still
+// being processed minus one, or the highest processed height if nothing is in progress.
+func (w *Watermark) Complete(chain string, height int64) int64 {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if pending, ok := w.pending[chain]; ok {
+		if pending[height] <= 1 {
+			delete(pending, height)
+		} else {
+			pending[height]--
+		}
+	}
+
+	if height > w.completed[chain] {
+		w.completed[chain] = height
+	}
+
+	lowestPending := int64(-1)
+	for pendingHeight := range w.pending[chain] {
+		if lowestPending == -1 || pendingHeight < lowestPending {
+			lowestPending = pendingHeight
+		}
+	}
+
+	if lowestPending != -1 {
+		return lowestPending - 1
+	}
+
+	return w.completed[chain]
+}
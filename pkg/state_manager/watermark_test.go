@@ -0,0 +1,29 @@
+package state_manager_test
+
+import (
+	"main/pkg/state_manager"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatermarkComplete(t *testing.T) {
+	t.Parallel()
+
+	watermark := state_manager.NewWatermark()
+	watermark.Add("chain", 10)
+	watermark.Add("chain", 11)
+	watermark.Add("chain", 11)
+	watermark.Add("chain", 12)
+	watermark.Add("other-chain", 5)
+
+	// a later transaction is done first, but the earlier one is still in progress
+	require.Equal(t, int64(9), watermark.Complete("chain", 12))
+	require.Equal(t, int64(9), watermark.Complete("chain", 11))
+	require.Equal(t, int64(10), watermark.Complete("chain", 10))
+
+	// nothing is in progress, so all the transactions up to the highest one are done
+	require.Equal(t, int64(12), watermark.Complete("chain", 11))
+
+	require.Equal(t, int64(5), watermark.Complete("other-chain", 5))
+}
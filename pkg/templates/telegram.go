@@ -6,16 +6,23 @@ import (
 	"html"
 	"html/template"
 	configTypes "main/pkg/config/types"
+	"main/pkg/constants"
+	"main/pkg/registry"
 	"main/pkg/types"
 	"main/pkg/types/amount"
 	"main/pkg/utils"
 	"main/templates"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/rs/zerolog"
 )
 
+// usdQuoteRates are used to display amounts values if no quote rates are passed.
+var usdQuoteRates = []*amount.QuoteRate{{Currency: constants.QuoteCurrencyUSD, Rate: 1, Fiat: true}}
+
 type TelegramTemplateManager struct {
 	Logger    zerolog.Logger
 	Templates map[string]*template.Template
@@ -33,6 +40,23 @@ func NewTelegramTemplateManager(
 	}
 }
 
+// GetFuncs returns the functions available in templates. Amounts values are displayed
+// in the currencies of the quote rates passed, or in USD if there are none passed.
+func (m *TelegramTemplateManager) GetFuncs(quoteRates []*amount.QuoteRate) template.FuncMap {
+	return template.FuncMap{
+		"SerializeLink": m.SerializeLink,
+		"SerializeAmount": func(amount amount.Amount) template.HTML {
+			return m.SerializeAmount(amount, quoteRates)
+		},
+		"SerializeDate": m.SerializeDate,
+		"SerializeMessage": func(msg types.Message) template.HTML {
+			return m.SerializeMessage(msg, quoteRates)
+		},
+	}
+}
+
+// GetTemplate returns the parsed template. Cached templates are never executed, as each render
+// needs its own quote rates, so their clones with the functions bound to these are executed instead.
 func (m *TelegramTemplateManager) GetTemplate(name string) (*template.Template, error) {
 	if cachedTemplate, ok := m.Templates[name]; ok {
 		m.Logger.Trace().Str("type", name).Msg("Using cached template")
@@ -43,12 +67,18 @@ func (m *TelegramTemplateManager) GetTemplate(name string) (*template.Template,
 
 	filename := fmt.Sprintf("%s.html", utils.RemoveFirstSlash(name))
 
-	t, err := template.New(filename).Funcs(template.FuncMap{
-		"SerializeLink":    m.SerializeLink,
-		"SerializeAmount":  m.SerializeAmount,
-		"SerializeDate":    m.SerializeDate,
-		"SerializeMessage": m.SerializeMessage,
-	}).ParseFS(templates.TemplatesFs, "telegram/"+filename)
+	t := template.New(filename).Funcs(m.GetFuncs(nil))
+
+	var err error
+
+	// Templates for messages registered from outside take precedence
+	// over the embedded ones.
+	if registeredTemplate, ok := registry.GetTemplate(name); ok {
+		t, err = t.Parse(registeredTemplate)
+	} else {
+		t, err = t.ParseFS(templates.TemplatesFs, "telegram/"+filename)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +88,27 @@ func (m *TelegramTemplateManager) GetTemplate(name string) (*template.Template,
 	return t, nil
 }
 
+// Execute renders a clone of the template, so the cached one stays not executed and can be cloned again.
+func (m *TelegramTemplateManager) Execute(
+	t *template.Template,
+	data interface{},
+	quoteRates []*amount.QuoteRate,
+) (string, error) {
+	clone, err := t.Clone()
+	if err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	if err := clone.Funcs(m.GetFuncs(quoteRates)).Execute(&buffer, data); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// Render renders the template. Amounts values in reports are displayed with the quote rates
+// fetched along with the report's additional data, so nothing is fetched while rendering.
 func (m *TelegramTemplateManager) Render(templateName string, data interface{}) (string, error) {
 	reportTemplate, err := m.GetTemplate(templateName)
 	if err != nil {
@@ -65,14 +116,18 @@ func (m *TelegramTemplateManager) Render(templateName string, data interface{})
 		return "", err
 	}
 
-	var buffer bytes.Buffer
-	err = reportTemplate.Execute(&buffer, data)
+	var quoteRates []*amount.QuoteRate
+	if report, ok := data.(types.Report); ok {
+		quoteRates = report.QuoteRates
+	}
+
+	rendered, err := m.Execute(reportTemplate, data, quoteRates)
 	if err != nil {
 		m.Logger.Error().Err(err).Str("type", templateName).Msg("Error rendering template")
 		return "", err
 	}
 
-	return buffer.String(), err
+	return rendered, nil
 }
 
 func (m *TelegramTemplateManager) SerializeLink(link *configTypes.Link) template.HTML {
@@ -88,28 +143,73 @@ func (m *TelegramTemplateManager) SerializeLink(link *configTypes.Link) template
 	return template.HTML(value)
 }
 
-func (m *TelegramTemplateManager) SerializeAmount(amount amount.Amount) template.HTML {
+// SerializeAmount returns the amount with its value in the quote currencies, or in USD
+// if quoteRates is nil.
+func (m *TelegramTemplateManager) SerializeAmount(amount amount.Amount, quoteRates []*amount.QuoteRate) template.HTML {
+	serializedAmount := fmt.Sprintf(
+		"%s %s",
+		utils.StripTrailingDigits(humanize.BigCommaf(amount.Value), 6),
+		amount.Denom,
+	)
+
 	if amount.PriceUSD == nil {
-		return template.HTML(fmt.Sprintf(
-			"%s %s",
-			utils.StripTrailingDigits(humanize.BigCommaf(amount.Value), 6),
-			amount.Denom,
-		))
+		return template.HTML(serializedAmount)
+	}
+
+	if quoteRates == nil {
+		quoteRates = usdQuoteRates
+	}
+
+	if len(quoteRates) == 0 {
+		return template.HTML(serializedAmount)
+	}
+
+	prices := make([]string, len(quoteRates))
+	for index, quoteRate := range quoteRates {
+		prices[index] = SerializeQuotePrice(amount.GetQuotePrice(quoteRate), quoteRate)
+	}
+
+	if amount.HistoricalPriceUSD == nil {
+		return template.HTML(fmt.Sprintf("%s (%s)", serializedAmount, strings.Join(prices, ", ")))
+	}
+
+	historicalPrices := make([]string, len(quoteRates))
+	for index, quoteRate := range quoteRates {
+		historicalPrices[index] = SerializeQuotePrice(amount.GetHistoricalQuotePrice(quoteRate), quoteRate)
 	}
 
 	return template.HTML(fmt.Sprintf(
-		"%s %s ($%s)",
-		utils.StripTrailingDigits(humanize.BigCommaf(amount.Value), 6),
-		amount.Denom,
-		utils.StripTrailingDigits(humanize.BigCommaf(amount.PriceUSD), 3),
+		"%s (now: %s; at tx time: %s)",
+		serializedAmount,
+		strings.Join(prices, ", "),
+		strings.Join(historicalPrices, ", "),
 	))
 }
 
+// SerializeQuotePrice returns the price in a quote currency, like $1.23, 1.12 EUR or 0.000123 BTC.
+func SerializeQuotePrice(price *big.Float, quoteRate *amount.QuoteRate) string {
+	if quoteRate.Currency == constants.QuoteCurrencyUSD {
+		return "$" + utils.StripTrailingDigits(humanize.BigCommaf(price), 3)
+	}
+
+	// tokens can be worth a lot, so they are shown with more digits
+	digits := 6
+	if quoteRate.Fiat {
+		digits = 3
+	}
+
+	return fmt.Sprintf(
+		"%s %s",
+		utils.StripTrailingDigits(humanize.BigCommaf(price), digits),
+		strings.ToUpper(quoteRate.Currency),
+	)
+}
+
 func (m *TelegramTemplateManager) SerializeDate(date time.Time) template.HTML {
 	return template.HTML(date.In(m.Timezone).Format(time.RFC822))
 }
 
-func (m *TelegramTemplateManager) SerializeMessage(msg types.Message) template.HTML {
+func (m *TelegramTemplateManager) SerializeMessage(msg types.Message, quoteRates []*amount.QuoteRate) template.HTML {
 	msgType := msg.Type()
 
 	reporterTemplate, err := m.GetTemplate(msgType)
@@ -118,12 +218,11 @@ func (m *TelegramTemplateManager) SerializeMessage(msg types.Message) template.H
 		return template.HTML(fmt.Sprintf("Error loading template: <code>%s</code>", html.EscapeString(err.Error())))
 	}
 
-	var buffer bytes.Buffer
-	err = reporterTemplate.Execute(&buffer, msg)
+	rendered, err := m.Execute(reporterTemplate, msg, quoteRates)
 	if err != nil {
 		m.Logger.Error().Err(err).Str("type", msgType).Msg("Error rendering template")
 		return template.HTML(fmt.Sprintf("Error rendering template: <code>%s</code>", html.EscapeString(err.Error())))
 	}
 
-	return template.HTML(buffer.String())
+	return template.HTML(rendered)
 }
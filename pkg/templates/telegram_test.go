@@ -5,6 +5,8 @@ import (
 	"main/pkg/config/types"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/messages"
+	"main/pkg/registry"
+	typesPkg "main/pkg/types"
 	amountPkg "main/pkg/types/amount"
 	"math/big"
 	"testing"
@@ -52,6 +54,33 @@ func TestTelegramTemplateManagerGetTemplateOk(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTelegramTemplateManagerRenderStatusWithApiNodes(t *testing.T) {
+	t.Parallel()
+
+	timezone, err := time.LoadLocation("Etc/GMT")
+	require.NoError(t, err)
+
+	manager := NewTelegramTemplateManager(loggerPkg.GetNopLogger(), timezone)
+
+	rendered, err := manager.Render("Status", map[string]typesPkg.ChainNodesStatus{
+		"chain": {
+			TendermintNodes: map[string]typesPkg.TendermintRPCStatus{
+				"https://rpc.com": {Success: true},
+			},
+			ApiNodes: map[string]typesPkg.ApiNodeStatus{
+				"https://api1.com": {Score: 80, Latency: 250 * time.Millisecond, ErrorRate: 0.1, Queries: 10},
+				"https://api2.com": {ErrorRate: 1, Queries: 3, CircuitOpen: true},
+				"https://api3.com": {},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Contains(t, rendered, "<code>https://rpc.com</code> -> active")
+	require.Contains(t, rendered, "<code>https://api1.com</code> -> score 80.0, latency 250ms, error rate 10%")
+	require.Contains(t, rendered, "<code>https://api2.com</code> -> circuit open, error rate 100%")
+	require.Contains(t, rendered, "<code>https://api3.com</code> -> no queries yet")
+}
+
 func TestTelegramTemplateManagerGetTemplateSerializeLink(t *testing.T) {
 	t.Parallel()
 
@@ -87,13 +116,63 @@ func TestTelegramTemplateManagerGetTemplateSerializeAmount(t *testing.T) {
 	require.Equal(t, template.HTML("1.234567 DENOM"), manager.SerializeAmount(amountPkg.Amount{
 		Value: big.NewFloat(1.23456789),
 		Denom: "DENOM",
-	}))
+	}, nil))
 
 	require.Equal(t, template.HTML("1.234567 DENOM ($9.876)"), manager.SerializeAmount(amountPkg.Amount{
 		Value:    big.NewFloat(1.23456789),
 		Denom:    "DENOM",
 		PriceUSD: big.NewFloat(9.876543),
-	}))
+	}, nil))
+
+	require.Equal(t, template.HTML("1.234567 DENOM (now: $9.876; at tx time: $4.5)"), manager.SerializeAmount(amountPkg.Amount{
+		Value:              big.NewFloat(1.23456789),
+		Denom:              "DENOM",
+		PriceUSD:           big.NewFloat(9.876543),
+		HistoricalPriceUSD: big.NewFloat(4.5),
+	}, nil))
+}
+
+func TestTelegramTemplateManagerGetTemplateSerializeAmountQuoteCurrencies(t *testing.T) {
+	t.Parallel()
+
+	timezone, err := time.LoadLocation("Etc/GMT")
+	require.NoError(t, err)
+
+	manager := NewTelegramTemplateManager(loggerPkg.GetNopLogger(), timezone)
+	quoteRates := []*amountPkg.QuoteRate{
+		{Currency: "usd", Rate: 1, Fiat: true},
+		{Currency: "eur", Rate: 0.9, Fiat: true},
+		{Currency: "atom", Rate: 0.1},
+	}
+
+	require.Equal(t, template.HTML("1.234567 DENOM ($1,000, 900 EUR, 100 ATOM)"), manager.SerializeAmount(amountPkg.Amount{
+		Value:    big.NewFloat(1.23456789),
+		Denom:    "DENOM",
+		PriceUSD: big.NewFloat(1000),
+	}, quoteRates))
+
+	require.Equal(t, template.HTML("1 DENOM ($0.123, 0.111 EUR, 0.012345 ATOM)"), manager.SerializeAmount(amountPkg.Amount{
+		Value:    big.NewFloat(1),
+		Denom:    "DENOM",
+		PriceUSD: big.NewFloat(0.123456),
+	}, quoteRates))
+
+	require.Equal(
+		t,
+		template.HTML("1 DENOM (now: $1,000, 900 EUR, 100 ATOM; at tx time: $800, 720 EUR, 80 ATOM)"),
+		manager.SerializeAmount(amountPkg.Amount{
+			Value:              big.NewFloat(1),
+			Denom:              "DENOM",
+			PriceUSD:           big.NewFloat(1000),
+			HistoricalPriceUSD: big.NewFloat(800),
+		}, quoteRates),
+	)
+
+	require.Equal(t, template.HTML("1 DENOM"), manager.SerializeAmount(amountPkg.Amount{
+		Value:    big.NewFloat(1),
+		Denom:    "DENOM",
+		PriceUSD: big.NewFloat(1),
+	}, []*amountPkg.QuoteRate{}))
 }
 
 func TestTelegramTemplateManagerGetTemplateSerializeDate(t *testing.T) {
@@ -119,7 +198,7 @@ func TestTelegramTemplateManagerGetTemplateSerializeMessageFailedToLoad(t *testi
 	require.Equal(
 		t,
 		template.HTML("Error loading template: <code>template: pattern matches no files: `telegram/MsgNotExistingMessage.html`</code>"),
-		manager.SerializeMessage(&messages.MsgNotExistingMessage{}),
+		manager.SerializeMessage(&messages.MsgNotExistingMessage{}, nil),
 	)
 }
 
@@ -133,7 +212,7 @@ func TestTelegramTemplateManagerGetTemplateSerializeMessageFailedToRender(t *tes
 	require.Equal(
 		t,
 		template.HTML("Error rendering template: <code>template: cosmos.bank.v1beta1.MsgSend.html:2:9: executing &#34;cosmos.bank.v1beta1.MsgSend.html&#34; at &lt;SerializeLink .From&gt;: error calling SerializeLink: runtime error: invalid memory address or nil pointer dereference</code>"),
-		manager.SerializeMessage(&messages.MsgSend{}),
+		manager.SerializeMessage(&messages.MsgSend{}, nil),
 	)
 }
 
@@ -147,6 +226,66 @@ func TestTelegramTemplateManagerGetTemplateSerializeMessageOk(t *testing.T) {
 	require.Equal(
 		t,
 		template.HTML("❌ This message type is not supported yet: <code>random</code>\n"),
-		manager.SerializeMessage(&messages.MsgUnsupportedMessage{MsgType: "random"}),
+		manager.SerializeMessage(&messages.MsgUnsupportedMessage{MsgType: "random"}, nil),
+	)
+}
+
+func TestTelegramTemplateManagerGetTemplateRegistered(t *testing.T) {
+	t.Parallel()
+
+	timezone, err := time.LoadLocation("Etc/GMT")
+	require.NoError(t, err)
+
+	err = registry.RegisterParser(
+		"/test.templates.MsgTest",
+		func(data []byte, chain *types.Chain, height int64) (typesPkg.Message, error) {
+			return &messages.MsgUnsupportedMessage{MsgType: "/test.templates.MsgTest"}, nil
+		},
+		"<code>{{ . }}</code>",
 	)
+	require.NoError(t, err)
+	manager := NewTelegramTemplateManager(loggerPkg.GetNopLogger(), timezone)
+
+	rendered, err := manager.Render("/test.templates.MsgTest", "value")
+	require.NoError(t, err)
+	require.Equal(t, "<code>value</code>", rendered)
+}
+
+func TestTelegramTemplateManagerRenderReportQuoteRates(t *testing.T) {
+	t.Parallel()
+
+	timezone, err := time.LoadLocation("Etc/GMT")
+	require.NoError(t, err)
+
+	manager := NewTelegramTemplateManager(loggerPkg.GetNopLogger(), timezone)
+	report := typesPkg.Report{
+		Chain: &types.Chain{Name: "chain"},
+		Reportable: &typesPkg.Tx{
+			Hash:   types.Link{Value: "hash"},
+			Height: types.Link{Value: "100"},
+			Messages: []typesPkg.Message{
+				&messages.MsgDelegate{
+					DelegatorAddress: &types.Link{Value: "delegator"},
+					ValidatorAddress: &types.Link{Value: "validator"},
+					Amount: &amountPkg.Amount{
+						Value:    big.NewFloat(1),
+						Denom:    "DENOM",
+						PriceUSD: big.NewFloat(10),
+					},
+				},
+			},
+		},
+		ChainSubscription: &types.ChainSubscription{},
+	}
+
+	// no quote rates fetched, so the value is in USD
+	rendered, err := manager.Render("Tx", report)
+	require.NoError(t, err)
+	require.Contains(t, rendered, "1 DENOM ($10)")
+
+	// the same cached template is rendered with the report's quote rates
+	report.QuoteRates = []*amountPkg.QuoteRate{{Currency: "eur", Rate: 0.9, Fiat: true}}
+	rendered, err = manager.Render("Tx", report)
+	require.NoError(t, err)
+	require.Contains(t, rendered, "1 DENOM (9 EUR)")
 }
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"main/pkg/config"
 	"main/pkg/http"
 	"main/pkg/metrics"
 	"main/pkg/types/query_info"
@@ -27,23 +29,24 @@ func NewTendermintApiClient(
 	url string,
 	chain *configTypes.Chain,
 	metricsManager *metrics.Manager,
+	timeouts config.TimeoutsConfig,
 ) *TendermintApiClient {
 	return &TendermintApiClient{
 		Logger: logger.With().
 			Str("component", "tendermint_api_client").
 			Str("chain", chain.Name).
 			Logger(),
-		Client:         http.NewClient(logger, url, chain.Name),
+		Client:         http.NewClient(logger, url, chain.Name, timeouts),
 		ChainName:      chain.Name,
 		MetricsManager: metricsManager,
 	}
 }
 
-func (c *TendermintApiClient) GetValidator(address string) (*responses.Validator, error) {
+func (c *TendermintApiClient) GetValidator(ctx context.Context, address string) (*responses.Validator, error) {
 	url := fmt.Sprintf("/cosmos/staking/v1beta1/validators/%s", address)
 
 	var response *responses.ValidatorResponse
-	err, queryInfo := c.Client.Get(url, &response)
+	err, queryInfo := c.Client.Get(ctx, url, &response, query_info.QueryTypeValidator)
 	c.MetricsManager.LogQuery(c.ChainName, queryInfo, query_info.QueryTypeValidator)
 
 	if err != nil {
@@ -54,6 +57,7 @@ func (c *TendermintApiClient) GetValidator(address string) (*responses.Validator
 }
 
 func (c *TendermintApiClient) GetDelegatorsRewardsAtBlock(
+	ctx context.Context,
 	delegator string,
 	validator string,
 	block int64,
@@ -69,7 +73,7 @@ func (c *TendermintApiClient) GetDelegatorsRewardsAtBlock(
 	}
 
 	var response *responses.RewardsResponse
-	err, queryInfo := c.Client.GetWithHeaders(url, &response, headers)
+	err, queryInfo := c.Client.GetWithHeaders(ctx, url, &response, headers, query_info.QueryTypeRewards)
 	c.MetricsManager.LogQuery(c.ChainName, queryInfo, query_info.QueryTypeRewards)
 
 	if err != nil || response == nil {
@@ -80,6 +84,7 @@ func (c *TendermintApiClient) GetDelegatorsRewardsAtBlock(
 }
 
 func (c *TendermintApiClient) GetValidatorCommissionAtBlock(
+	ctx context.Context,
 	validator string,
 	block int64,
 ) ([]responses.Commission, error) {
@@ -93,7 +98,7 @@ func (c *TendermintApiClient) GetValidatorCommissionAtBlock(
 	}
 
 	var response *responses.CommissionResponse
-	err, queryInfo := c.Client.GetWithHeaders(url, &response, headers)
+	err, queryInfo := c.Client.GetWithHeaders(ctx, url, &response, headers, query_info.QueryTypeCommission)
 	c.MetricsManager.LogQuery(c.ChainName, queryInfo, query_info.QueryTypeCommission)
 
 	if err != nil || response == nil {
@@ -103,11 +108,11 @@ func (c *TendermintApiClient) GetValidatorCommissionAtBlock(
 	return response.Commission.Commission, nil
 }
 
-func (c *TendermintApiClient) GetProposal(id string) (*responses.Proposal, error) {
+func (c *TendermintApiClient) GetProposal(ctx context.Context, id string) (*responses.Proposal, error) {
 	url := fmt.Sprintf("/cosmos/gov/v1beta1/proposals/%s", id)
 
 	var response *responses.ProposalResponse
-	err, queryInfo := c.Client.Get(url, &response)
+	err, queryInfo := c.Client.Get(ctx, url, &response, query_info.QueryTypeProposal)
 	c.MetricsManager.LogQuery(c.ChainName, queryInfo, query_info.QueryTypeProposal)
 
 	if err != nil {
@@ -117,11 +122,11 @@ func (c *TendermintApiClient) GetProposal(id string) (*responses.Proposal, error
 	return &response.Proposal, nil
 }
 
-func (c *TendermintApiClient) GetStakingParams() (*responses.StakingParams, error) {
+func (c *TendermintApiClient) GetStakingParams(ctx context.Context) (*responses.StakingParams, error) {
 	url := fmt.Sprintf("/cosmos/staking/v1beta1/params")
 
 	var response *responses.StakingParamsResponse
-	err, queryInfo := c.Client.Get(url, &response)
+	err, queryInfo := c.Client.Get(ctx, url, &response, query_info.QueryTypeStakingParams)
 	c.MetricsManager.LogQuery(c.ChainName, queryInfo, query_info.QueryTypeStakingParams)
 
 	if err != nil {
@@ -132,13 +137,14 @@ func (c *TendermintApiClient) GetStakingParams() (*responses.StakingParams, erro
 }
 
 func (c *TendermintApiClient) GetIbcChannel(
+	ctx context.Context,
 	channel string,
 	port string,
 ) (*responses.IbcChannel, error) {
 	url := fmt.Sprintf("/ibc/core/channel/v1/channels/%s/ports/%s", channel, port)
 
 	var response *responses.IbcChannelResponse
-	err, queryInfo := c.Client.Get(url, &response)
+	err, queryInfo := c.Client.Get(ctx, url, &response, query_info.QueryTypeIbcChannel)
 	c.MetricsManager.LogQuery(c.ChainName, queryInfo, query_info.QueryTypeIbcChannel)
 
 	if err != nil {
@@ -149,12 +155,13 @@ func (c *TendermintApiClient) GetIbcChannel(
 }
 
 func (c *TendermintApiClient) GetIbcConnectionClientState(
+	ctx context.Context,
 	connectionID string,
 ) (*responses.IbcIdentifiedClientState, error) {
 	url := fmt.Sprintf("/ibc/core/connection/v1/connections/%s/client_state", connectionID)
 
 	var response *responses.IbcClientStateResponse
-	err, queryInfo := c.Client.Get(url, &response)
+	err, queryInfo := c.Client.Get(ctx, url, &response, query_info.QueryTypeIbcConnectionClientState)
 	c.MetricsManager.LogQuery(c.ChainName, queryInfo, query_info.QueryTypeIbcConnectionClientState)
 
 	if err != nil {
@@ -165,12 +172,13 @@ func (c *TendermintApiClient) GetIbcConnectionClientState(
 }
 
 func (c *TendermintApiClient) GetIbcDenomTrace(
+	ctx context.Context,
 	hash string,
 ) (*types.DenomTrace, error) {
 	url := fmt.Sprintf("/ibc/apps/transfer/v1/denom_traces/%s", hash)
 
 	var response *responses.IbcDenomTraceResponse
-	err, queryInfo := c.Client.Get(url, &response)
+	err, queryInfo := c.Client.Get(ctx, url, &response, query_info.QueryTypeIbcDenomTrace)
 	c.MetricsManager.LogQuery(c.ChainName, queryInfo, query_info.QueryTypeIbcDenomTrace)
 
 	if err != nil {
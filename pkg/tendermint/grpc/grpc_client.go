@@ -0,0 +1,424 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/metrics"
+	"main/pkg/types/query_info"
+	"strconv"
+	"strings"
+	"time"
+
+	configTypes "main/pkg/config/types"
+	"main/pkg/types/responses"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptoEd25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	grpcTypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clientTypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectionTypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channelTypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcSoloMachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
+	ibcTendermint "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/rs/zerolog"
+	grpcPkg "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
+)
+
+// TendermintGrpcClient fetches chain data via gRPC, as an alternative
+// to LCD REST for chains having unreliable or disabled REST gateways.
+type TendermintGrpcClient struct {
+	Logger         zerolog.Logger
+	Conn           *grpcPkg.ClientConn
+	MetricsManager *metrics.Manager
+	ChainName      string
+	URL            string
+	Timeouts       config.TimeoutsConfig
+	Error          error
+}
+
+// NewTendermintGrpcClient creates a client for a gRPC node. The URL can be either
+// "host:port" or "http://host:port" for plaintext connections, or "https://host:port"
+// for TLS ones. Connecting happens lazily on the first query.
+func NewTendermintGrpcClient(
+	logger *zerolog.Logger,
+	url string,
+	chain *configTypes.Chain,
+	metricsManager *metrics.Manager,
+	timeouts config.TimeoutsConfig,
+) *TendermintGrpcClient {
+	client := &TendermintGrpcClient{
+		Logger: logger.With().
+			Str("component", "tendermint_grpc_client").
+			Str("chain", chain.Name).
+			Str("url", url).
+			Logger(),
+		ChainName:      chain.Name,
+		URL:            url,
+		Timeouts:       timeouts,
+		MetricsManager: metricsManager,
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if strings.HasPrefix(url, "https://") {
+		transportCredentials = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	address := strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://")
+
+	conn, err := grpcPkg.Dial(
+		address,
+		grpcPkg.WithTransportCredentials(transportCredentials),
+		grpcPkg.WithDefaultCallOptions(grpcPkg.ForceCodec(GetCodec())),
+	)
+	if err != nil {
+		client.Logger.Error().Err(err).Msg("Could not create gRPC connection")
+		client.Error = err
+	}
+
+	client.Conn = conn
+	return client
+}
+
+// GetCodec returns a codec for Cosmos SDK messages, as they are not compatible
+// with the default gRPC one. IBC client states are registered, as responses
+// containing them cannot be decoded otherwise.
+func GetCodec() encoding.Codec {
+	registry := codecTypes.NewInterfaceRegistry()
+	clientTypes.RegisterInterfaces(registry)
+	ibcTendermint.RegisterInterfaces(registry)
+	ibcSoloMachine.RegisterInterfaces(registry)
+
+	return codec.NewProtoCodec(registry).GRPCCodec()
+}
+
+func (c *TendermintGrpcClient) GetValidator(ctx context.Context, address string) (*responses.Validator, error) {
+	var response *stakingTypes.QueryValidatorResponse
+	err := c.Query(ctx, query_info.QueryTypeValidator, 0, func(queryCtx context.Context) error {
+		var queryErr error
+		response, queryErr = stakingTypes.NewQueryClient(c.Conn).Validator(
+			queryCtx,
+			&stakingTypes.QueryValidatorRequest{ValidatorAddr: address},
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validator := response.Validator
+
+	return &responses.Validator{
+		OperatorAddress: validator.OperatorAddress,
+		ConsensusPubkey: ParseConsensusPubkey(validator.ConsensusPubkey),
+		Jailed:          validator.Jailed,
+		Status:          validator.Status.String(),
+		Tokens:          validator.Tokens.String(),
+		DelegatorShares: validator.DelegatorShares.String(),
+		Description: responses.ValidatorDescription{
+			Moniker:         validator.Description.Moniker,
+			Identity:        validator.Description.Identity,
+			Website:         validator.Description.Website,
+			SecurityContact: validator.Description.SecurityContact,
+			Details:         validator.Description.Details,
+		},
+		UnbondingHeight: strconv.FormatInt(validator.UnbondingHeight, 10),
+		UnbondingTime:   validator.UnbondingTime,
+		Commission: responses.ValidatorCommission{
+			CommissionRates: responses.ValidatorCommissionRates{
+				Rate:          validator.Commission.Rate.String(),
+				MaxRate:       validator.Commission.MaxRate.String(),
+				MaxChangeRate: validator.Commission.MaxChangeRate.String(),
+			},
+			UpdateTime: validator.Commission.UpdateTime,
+		},
+		MinSelfDelegation: validator.MinSelfDelegation.String(),
+	}, nil
+}
+
+func (c *TendermintGrpcClient) GetDelegatorsRewardsAtBlock(
+	ctx context.Context,
+	delegator string,
+	validator string,
+	block int64,
+) ([]responses.Reward, error) {
+	var response *distributionTypes.QueryDelegationRewardsResponse
+	err := c.Query(ctx, query_info.QueryTypeRewards, block, func(queryCtx context.Context) error {
+		var queryErr error
+		response, queryErr = distributionTypes.NewQueryClient(c.Conn).DelegationRewards(
+			queryCtx,
+			&distributionTypes.QueryDelegationRewardsRequest{
+				DelegatorAddress: delegator,
+				ValidatorAddress: validator,
+			},
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make([]responses.Reward, len(response.Rewards))
+	for index, reward := range response.Rewards {
+		rewards[index] = responses.Reward{
+			Amount: reward.Amount.String(),
+			Denom:  reward.Denom,
+		}
+	}
+
+	return rewards, nil
+}
+
+func (c *TendermintGrpcClient) GetValidatorCommissionAtBlock(
+	ctx context.Context,
+	validator string,
+	block int64,
+) ([]responses.Commission, error) {
+	var response *distributionTypes.QueryValidatorCommissionResponse
+	err := c.Query(ctx, query_info.QueryTypeCommission, block, func(queryCtx context.Context) error {
+		var queryErr error
+		response, queryErr = distributionTypes.NewQueryClient(c.Conn).ValidatorCommission(
+			queryCtx,
+			&distributionTypes.QueryValidatorCommissionRequest{ValidatorAddress: validator},
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commissions := make([]responses.Commission, len(response.Commission.Commission))
+	for index, commission := range response.Commission.Commission {
+		commissions[index] = responses.Commission{
+			Amount: commission.Amount.String(),
+			Denom:  commission.Denom,
+		}
+	}
+
+	return commissions, nil
+}
+
+func (c *TendermintGrpcClient) GetProposal(ctx context.Context, id string) (*responses.Proposal, error) {
+	proposalID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposal ID %s: %s", id, err)
+	}
+
+	var response *govTypes.QueryProposalResponse
+	err = c.Query(ctx, query_info.QueryTypeProposal, 0, func(queryCtx context.Context) error {
+		var queryErr error
+		response, queryErr = govTypes.NewQueryClient(c.Conn).Proposal(
+			queryCtx,
+			&govTypes.QueryProposalRequest{ProposalId: proposalID},
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &responses.Proposal{
+		ProposalID:    strconv.FormatUint(response.Proposal.ProposalId, 10),
+		Status:        response.Proposal.Status.String(),
+		VotingEndTime: response.Proposal.VotingEndTime,
+	}
+
+	if content := response.Proposal.Content; content != nil {
+		proposal.Content = ParseProposalContent(content)
+	}
+
+	return proposal, nil
+}
+
+func (c *TendermintGrpcClient) GetStakingParams(ctx context.Context) (*responses.StakingParams, error) {
+	var response *stakingTypes.QueryParamsResponse
+	err := c.Query(ctx, query_info.QueryTypeStakingParams, 0, func(queryCtx context.Context) error {
+		var queryErr error
+		response, queryErr = stakingTypes.NewQueryClient(c.Conn).Params(
+			queryCtx,
+			&stakingTypes.QueryParamsRequest{},
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.StakingParams{
+		UnbondingTime: responses.Duration{Duration: response.Params.UnbondingTime},
+	}, nil
+}
+
+func (c *TendermintGrpcClient) GetIbcChannel(
+	ctx context.Context,
+	channel string,
+	port string,
+) (*responses.IbcChannel, error) {
+	var response *channelTypes.QueryChannelResponse
+	err := c.Query(ctx, query_info.QueryTypeIbcChannel, 0, func(queryCtx context.Context) error {
+		var queryErr error
+		response, queryErr = channelTypes.NewQueryClient(c.Conn).Channel(
+			queryCtx,
+			&channelTypes.QueryChannelRequest{PortId: port, ChannelId: channel},
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Channel == nil {
+		return nil, fmt.Errorf("channel %s/%s not found", port, channel)
+	}
+
+	return &responses.IbcChannel{ConnectionHops: response.Channel.ConnectionHops}, nil
+}
+
+func (c *TendermintGrpcClient) GetIbcConnectionClientState(
+	ctx context.Context,
+	connectionID string,
+) (*responses.IbcIdentifiedClientState, error) {
+	var response *connectionTypes.QueryConnectionClientStateResponse
+	err := c.Query(ctx, query_info.QueryTypeIbcConnectionClientState, 0, func(queryCtx context.Context) error {
+		var queryErr error
+		response, queryErr = connectionTypes.NewQueryClient(c.Conn).ConnectionClientState(
+			queryCtx,
+			&connectionTypes.QueryConnectionClientStateRequest{ConnectionId: connectionID},
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IdentifiedClientState == nil || response.IdentifiedClientState.ClientState == nil {
+		return nil, fmt.Errorf("client state for connection %s not found", connectionID)
+	}
+
+	// only Tendermint light clients have chain ID
+	clientState, ok := response.IdentifiedClientState.ClientState.GetCachedValue().(*ibcTendermint.ClientState)
+	if !ok {
+		return nil, fmt.Errorf(
+			"unsupported client state type %s",
+			response.IdentifiedClientState.ClientState.TypeUrl,
+		)
+	}
+
+	return &responses.IbcIdentifiedClientState{
+		ClientState: responses.IbcClientState{ChainId: clientState.ChainId},
+	}, nil
+}
+
+func (c *TendermintGrpcClient) GetIbcDenomTrace(
+	ctx context.Context,
+	hash string,
+) (*transferTypes.DenomTrace, error) {
+	var response *transferTypes.QueryDenomTraceResponse
+	err := c.Query(ctx, query_info.QueryTypeIbcDenomTrace, 0, func(queryCtx context.Context) error {
+		var queryErr error
+		response, queryErr = transferTypes.NewQueryClient(c.Conn).DenomTrace(
+			queryCtx,
+			&transferTypes.QueryDenomTraceRequest{Hash: hash},
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.DenomTrace == nil {
+		return nil, fmt.Errorf("denom trace %s not found", hash)
+	}
+
+	return response.DenomTrace, nil
+}
+
+// Query does a gRPC query with the timeout set for its type, logging it in metrics. If block is positive,
+// the query is done at this block height, same as x-cosmos-block-height header in REST.
+func (c *TendermintGrpcClient) Query(
+	ctx context.Context,
+	queryType query_info.QueryType,
+	block int64,
+	query func(queryCtx context.Context) error,
+) error {
+	if c.Error != nil {
+		return c.Error
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.Timeouts.GetQueryTimeout(queryType))
+	defer cancel()
+
+	if block > 0 {
+		ctx = metadata.AppendToOutgoingContext(
+			ctx,
+			grpcTypes.GRPCBlockHeightHeader,
+			strconv.FormatInt(block, 10),
+		)
+	}
+
+	c.Logger.Trace().Str("type", string(queryType)).Msg("Doing a query...")
+
+	start := time.Now()
+	err := query(ctx)
+
+	queryInfo := query_info.QueryInfo{
+		Success: err == nil,
+		Node:    c.URL,
+		Time:    time.Since(start),
+	}
+	c.MetricsManager.LogQuery(c.ChainName, queryInfo, queryType)
+
+	if err != nil {
+		c.Logger.Warn().Str("type", string(queryType)).Err(err).Msg("Query failed")
+		return err
+	}
+
+	c.Logger.Debug().
+		Str("type", string(queryType)).
+		Dur("duration", queryInfo.Time).
+		Msg("Query is finished")
+
+	return nil
+}
+
+func ParseConsensusPubkey(pubkey *codecTypes.Any) responses.ConsensusPubkey {
+	if pubkey == nil {
+		return responses.ConsensusPubkey{}
+	}
+
+	consensusPubkey := responses.ConsensusPubkey{Type: pubkey.TypeUrl}
+
+	var ed25519Key cryptoEd25519.PubKey
+	if err := ed25519Key.Unmarshal(pubkey.Value); err == nil {
+		consensusPubkey.Key = base64.StdEncoding.EncodeToString(ed25519Key.Key)
+	}
+
+	return consensusPubkey
+}
+
+// ParseProposalContent takes title and description out of proposal content.
+// All the proposal content types have them as the first two fields, so it can
+// be decoded as a text proposal, ignoring the rest of fields, without
+// knowing the exact content type.
+func ParseProposalContent(content *codecTypes.Any) responses.ProposalContent {
+	proposalContent := responses.ProposalContent{Type: content.TypeUrl}
+
+	var textProposal govTypes.TextProposal
+	if err := textProposal.Unmarshal(content.Value); err == nil {
+		proposalContent.Title = textProposal.Title
+		proposalContent.Description = textProposal.Description
+	}
+
+	return proposalContent
+}
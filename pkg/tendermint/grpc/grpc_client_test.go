@@ -0,0 +1,259 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/tendermint/grpc"
+	"net"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	grpcTypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clientTypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectionTypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	ibcTendermint "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+	grpcPkg "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type StakingServer struct {
+	stakingTypes.UnimplementedQueryServer
+}
+
+func (s *StakingServer) Validator(
+	ctx context.Context,
+	request *stakingTypes.QueryValidatorRequest,
+) (*stakingTypes.QueryValidatorResponse, error) {
+	if request.ValidatorAddr != "cosmosvaloper1xxx" {
+		return nil, errors.New("validator not found")
+	}
+
+	return &stakingTypes.QueryValidatorResponse{
+		Validator: stakingTypes.Validator{
+			OperatorAddress: "cosmosvaloper1xxx",
+			Status:          stakingTypes.Bonded,
+			Tokens:          math.NewInt(1000),
+			DelegatorShares: math.LegacyNewDec(1000),
+			Description:     stakingTypes.Description{Moniker: "validator"},
+			Commission: stakingTypes.Commission{
+				CommissionRates: stakingTypes.CommissionRates{
+					Rate:          math.LegacyNewDecWithPrec(5, 2),
+					MaxRate:       math.LegacyNewDecWithPrec(2, 1),
+					MaxChangeRate: math.LegacyNewDecWithPrec(1, 2),
+				},
+			},
+			MinSelfDelegation: math.NewInt(1),
+		},
+	}, nil
+}
+
+func (s *StakingServer) Params(
+	ctx context.Context,
+	request *stakingTypes.QueryParamsRequest,
+) (*stakingTypes.QueryParamsResponse, error) {
+	return &stakingTypes.QueryParamsResponse{
+		Params: stakingTypes.Params{UnbondingTime: 21 * 24 * time.Hour},
+	}, nil
+}
+
+type DistributionServer struct {
+	distributionTypes.UnimplementedQueryServer
+}
+
+func (s *DistributionServer) DelegationRewards(
+	ctx context.Context,
+	request *distributionTypes.QueryDelegationRewardsRequest,
+) (*distributionTypes.QueryDelegationRewardsResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if heights := md.Get(grpcTypes.GRPCBlockHeightHeader); len(heights) != 1 || heights[0] != "123" {
+		return nil, errors.New("expected height to be passed")
+	}
+
+	return &distributionTypes.QueryDelegationRewardsResponse{
+		Rewards: sdkTypes.NewDecCoins(sdkTypes.NewDecCoin("uatom", math.NewInt(100))),
+	}, nil
+}
+
+type GovServer struct {
+	govTypes.UnimplementedQueryServer
+}
+
+func (s *GovServer) Proposal(
+	ctx context.Context,
+	request *govTypes.QueryProposalRequest,
+) (*govTypes.QueryProposalResponse, error) {
+	content, err := codecTypes.NewAnyWithValue(&govTypes.TextProposal{
+		Title:       "title",
+		Description: "description",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &govTypes.QueryProposalResponse{
+		Proposal: govTypes.Proposal{
+			ProposalId: request.ProposalId,
+			Content:    content,
+			Status:     govTypes.StatusVotingPeriod,
+		},
+	}, nil
+}
+
+type TransferServer struct {
+	transferTypes.UnimplementedQueryServer
+}
+
+func (s *TransferServer) DenomTrace(
+	ctx context.Context,
+	request *transferTypes.QueryDenomTraceRequest,
+) (*transferTypes.QueryDenomTraceResponse, error) {
+	return &transferTypes.QueryDenomTraceResponse{
+		DenomTrace: &transferTypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"},
+	}, nil
+}
+
+type ConnectionServer struct {
+	connectionTypes.UnimplementedQueryServer
+}
+
+func (s *ConnectionServer) ConnectionClientState(
+	ctx context.Context,
+	request *connectionTypes.QueryConnectionClientStateRequest,
+) (*connectionTypes.QueryConnectionClientStateResponse, error) {
+	clientState, err := codecTypes.NewAnyWithValue(&ibcTendermint.ClientState{ChainId: "cosmoshub-4"})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectionTypes.QueryConnectionClientStateResponse{
+		IdentifiedClientState: &clientTypes.IdentifiedClientState{
+			ClientId:    "07-tendermint-0",
+			ClientState: clientState,
+		},
+	}, nil
+}
+
+func getClient(t *testing.T) *grpc.TendermintGrpcClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpcPkg.NewServer(grpcPkg.ForceServerCodec(grpc.GetCodec()))
+	stakingTypes.RegisterQueryServer(server, &StakingServer{})
+	distributionTypes.RegisterQueryServer(server, &DistributionServer{})
+	govTypes.RegisterQueryServer(server, &GovServer{})
+	transferTypes.RegisterQueryServer(server, &TransferServer{})
+	connectionTypes.RegisterQueryServer(server, &ConnectionServer{})
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := grpc.NewTendermintGrpcClient(logger, "bufnet", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	conn, err := grpcPkg.Dial(
+		"bufnet",
+		grpcPkg.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpcPkg.WithTransportCredentials(insecure.NewCredentials()),
+		grpcPkg.WithDefaultCallOptions(grpcPkg.ForceCodec(grpc.GetCodec())),
+	)
+	require.NoError(t, err)
+
+	client.Conn = conn
+	return client
+}
+
+func TestGrpcClientGetValidator(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(t)
+
+	_, err := client.GetValidator(context.Background(), "cosmosvaloper1yyy")
+	require.Error(t, err)
+
+	validator, err := client.GetValidator(context.Background(), "cosmosvaloper1xxx")
+	require.NoError(t, err)
+	require.Equal(t, "cosmosvaloper1xxx", validator.OperatorAddress)
+	require.Equal(t, "BOND_STATUS_BONDED", validator.Status)
+	require.Equal(t, "1000", validator.Tokens)
+	require.Equal(t, "validator", validator.Description.Moniker)
+	require.Equal(t, "0.050000000000000000", validator.Commission.CommissionRates.Rate)
+}
+
+func TestGrpcClientGetRewards(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(t)
+
+	_, err := client.GetDelegatorsRewardsAtBlock(context.Background(), "cosmos1xxx", "cosmosvaloper1xxx", 0)
+	require.Error(t, err)
+
+	rewards, err := client.GetDelegatorsRewardsAtBlock(context.Background(), "cosmos1xxx", "cosmosvaloper1xxx", 123)
+	require.NoError(t, err)
+	require.Len(t, rewards, 1)
+	require.Equal(t, "uatom", rewards[0].Denom)
+	require.Equal(t, "100.000000000000000000", rewards[0].Amount)
+}
+
+func TestGrpcClientGetProposal(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(t)
+
+	_, err := client.GetProposal(context.Background(), "invalid")
+	require.Error(t, err)
+
+	proposal, err := client.GetProposal(context.Background(), "15")
+	require.NoError(t, err)
+	require.Equal(t, "15", proposal.ProposalID)
+	require.Equal(t, "PROPOSAL_STATUS_VOTING_PERIOD", proposal.Status)
+	require.Equal(t, "/cosmos.gov.v1beta1.TextProposal", proposal.Content.Type)
+	require.Equal(t, "title", proposal.Content.Title)
+	require.Equal(t, "description", proposal.Content.Description)
+}
+
+func TestGrpcClientGetStakingParams(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(t)
+
+	params, err := client.GetStakingParams(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 21*24*time.Hour, params.UnbondingTime.Duration)
+}
+
+func TestGrpcClientGetIbcInfo(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(t)
+
+	denomTrace, err := client.GetIbcDenomTrace(context.Background(), "hash")
+	require.NoError(t, err)
+	require.Equal(t, "uatom", denomTrace.BaseDenom)
+
+	clientState, err := client.GetIbcConnectionClientState(context.Background(), "connection-0")
+	require.NoError(t, err)
+	require.Equal(t, "cosmoshub-4", clientState.ClientState.ChainId)
+
+	// not implemented on the server
+	_, err = client.GetIbcChannel(context.Background(), "channel-0", "transfer")
+	require.Error(t, err)
+}
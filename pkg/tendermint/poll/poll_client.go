@@ -0,0 +1,271 @@
+package poll
+
+import (
+	"context"
+	"main/pkg/config"
+	configTypes "main/pkg/config/types"
+	"main/pkg/converter"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/tendermint/rpc"
+	"main/pkg/tendermint/ws"
+	"main/pkg/types"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// maxBlocksPerPoll limits the amount of blocks fetched at once,
+// so catching up after a long downtime is done in smaller steps.
+const maxBlocksPerPoll = 100
+
+// TendermintPollClient is an alternative to the websocket client, which
+// walks through blocks sequentially, querying node's /tx_search for transactions
+// in new blocks. Unlike websocket subscriptions, which can be dropped silently,
+// it does not move forward unless all transactions in a block are fetched.
+type TendermintPollClient struct {
+	Logger             zerolog.Logger
+	Chain              *configTypes.Chain
+	MetricsManager     *metricsPkg.Manager
+	URL                string
+	RPCClient          *rpc.TendermintRPCClient
+	LastHeightProvider ws.LastHeightProvider
+	Converter          *converter.Converter
+	LastBlock          *types.LastBlock
+
+	// guarded by the mutex, as the status is read from other goroutines while polling
+	mutex  sync.RWMutex
+	active bool
+	err    error
+
+	// the last height transactions were fetched for, 0 if not polled yet
+	Height int64
+
+	Channel chan types.Report
+
+	// cancelled when the node is stopped, so the queries in progress are not waited for
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func NewTendermintPollClient(
+	logger *zerolog.Logger,
+	url string,
+	chain *configTypes.Chain,
+	metricsManager *metricsPkg.Manager,
+	timeouts config.TimeoutsConfig,
+	lastHeightProvider ws.LastHeightProvider,
+) *TendermintPollClient {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &TendermintPollClient{
+		Logger: logger.With().
+			Str("component", "tendermint_poll_client").
+			Str("url", url).
+			Str("chain", chain.Name).
+			Logger(),
+		MetricsManager:     metricsManager,
+		URL:                url,
+		Chain:              chain,
+		Channel:            make(chan types.Report),
+		Converter:          converter.NewConverter(logger, chain),
+		RPCClient:          rpc.NewTendermintRPCClient(logger, url, chain, metricsManager, timeouts),
+		LastHeightProvider: lastHeightProvider,
+		LastBlock:          &types.LastBlock{},
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+}
+
+func (t *TendermintPollClient) GetURL() string {
+	return t.URL
+}
+
+func (t *TendermintPollClient) GetChannel() chan types.Report {
+	return t.Channel
+}
+
+func (t *TendermintPollClient) Status() types.TendermintRPCStatus {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return types.TendermintRPCStatus{
+		Success: t.active,
+		Error:   t.err,
+	}
+}
+
+func (t *TendermintPollClient) GetLastBlock() (int64, time.Time) {
+	return t.LastBlock.Get()
+}
+
+// IsStandby always returns false, as poll clients do not support standby nodes.
+func (t *TendermintPollClient) IsStandby() bool {
+	return false
+}
+
+func (t *TendermintPollClient) Listen() {
+	t.LastBlock.Reset()
+
+	ticker := time.NewTicker(t.Chain.PollInterval)
+	defer ticker.Stop()
+
+	t.Poll(t.ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			t.Poll(t.ctx)
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *TendermintPollClient) Stop() {
+	t.Logger.Info().Msg("Stopping the node...")
+	t.cancel()
+}
+
+// Poll fetches transactions in all blocks since the last polled one.
+// If fetching fails, it is retried from the same height on the next poll.
+// Once the context is done, it stops without moving forward.
+func (t *TendermintPollClient) Poll(ctx context.Context) {
+	latestHeight, err := t.RPCClient.GetLatestHeight(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err != nil {
+		t.SetError(err)
+		return
+	}
+
+	t.SetActive()
+
+	if t.LastBlock.Update(latestHeight) {
+		t.MetricsManager.LogNodeLastBlock(t.Chain.Name, t.URL, latestHeight)
+	}
+
+	if t.Height == 0 {
+		t.Height = t.GetStartHeight(latestHeight)
+		t.Logger.Info().
+			Int64("height", t.Height+1).
+			Msg("Polling for transactions starting from height")
+	}
+
+	for t.Height < latestHeight {
+		toHeight := t.Height + maxBlocksPerPoll
+		if toHeight > latestHeight {
+			toHeight = latestHeight
+		}
+
+		resultTxs, err := t.RPCClient.SearchTxsInRange(ctx, t.Chain.Queries, t.Height+1, toHeight)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			t.SetError(err)
+			return
+		}
+
+		blockTimes := map[int64]time.Time{}
+
+		for _, resultTx := range resultTxs {
+			if tx := t.Converter.ParseResultTx(resultTx); tx != nil {
+				// polled transactions come in order, same as the ones received via websocket
+				tx.Backfilled = false
+				tx.Time = t.GetBlockTime(ctx, resultTx.Height, blockTimes)
+				t.Channel <- t.MakeReport(tx)
+			}
+		}
+
+		t.Logger.Trace().
+			Int64("from", t.Height+1).
+			Int64("to", toHeight).
+			Int("count", len(resultTxs)).
+			Msg("Polled transactions")
+
+		t.Height = toHeight
+	}
+}
+
+// GetBlockTime returns the block time, reusing the already fetched ones, as there can be
+// multiple txs in a block. If it cannot be fetched, it returns zero time.
+func (t *TendermintPollClient) GetBlockTime(
+	ctx context.Context,
+	height int64,
+	blockTimes map[int64]time.Time,
+) time.Time {
+	if blockTime, ok := blockTimes[height]; ok {
+		return blockTime
+	}
+
+	blockTime, err := t.RPCClient.GetBlockTime(ctx, height)
+	if err != nil {
+		t.Logger.Warn().Err(err).Int64("height", height).Msg("Could not get block time")
+	}
+
+	blockTimes[height] = blockTime
+	return blockTime
+}
+
+// GetStartHeight returns the height to start polling after. If there's a last processed height
+// (for example, restored after restart), it starts from the block at it, as this block
+// might have been only partly delivered (the transactions already delivered are deduplicated
+// later), but not going back more than max-backfill-blocks, otherwise it starts from the latest block.
+func (t *TendermintPollClient) GetStartHeight(latestHeight int64) int64 {
+	if t.LastHeightProvider == nil {
+		return latestHeight
+	}
+
+	lastHeight, found := t.LastHeightProvider.GetLastBlockHeight(t.Chain.Name)
+	if !found || lastHeight > latestHeight {
+		return latestHeight
+	}
+
+	startHeight := lastHeight - 1
+	if latestHeight-startHeight > t.Chain.MaxBackfillBlocks {
+		return latestHeight - t.Chain.MaxBackfillBlocks
+	}
+
+	return startHeight
+}
+
+func (t *TendermintPollClient) SetActive() {
+	t.mutex.Lock()
+	wasActive := t.active
+	t.active = true
+	t.err = nil
+	t.mutex.Unlock()
+
+	if !wasActive {
+		t.Logger.Info().Msg("Connected to a node")
+		t.MetricsManager.LogNodeConnection(t.Chain.Name, t.URL, true)
+	}
+}
+
+func (t *TendermintPollClient) SetError(err error) {
+	t.Logger.Warn().Err(err).Msg("Error polling node")
+
+	t.mutex.Lock()
+	// only reporting an error once when the node goes down, not on every poll
+	shouldReport := t.active || t.err == nil
+	t.active = false
+	t.err = err
+	t.mutex.Unlock()
+
+	if shouldReport {
+		t.MetricsManager.LogNodeConnection(t.Chain.Name, t.URL, false)
+		t.Channel <- t.MakeReport(&types.NodeConnectError{Error: err, URL: t.URL, Chain: t.Chain.GetName()})
+	}
+}
+
+func (t *TendermintPollClient) MakeReport(reportable types.Reportable) types.Report {
+	return types.Report{
+		Chain:      t.Chain,
+		Node:       t.URL,
+		Reportable: reportable,
+	}
+}
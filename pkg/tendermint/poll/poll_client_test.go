@@ -0,0 +1,255 @@
+package poll_test
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/tendermint/poll"
+	"main/pkg/types"
+	"sync"
+	"testing"
+	"time"
+
+	queryPkg "github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+type StaticHeightProvider struct {
+	Height int64
+}
+
+func (p *StaticHeightProvider) GetLastBlockHeight(chain string) (int64, bool) {
+	return p.Height, p.Height > 0
+}
+
+func getClient(maxBackfillBlocks int64, lastHeight int64) *poll.TendermintPollClient {
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	chain := &configTypes.Chain{
+		Name:              "chain",
+		Queries:           []queryPkg.Query{*queryPkg.MustParse("tx.height > 1")},
+		MaxBackfillBlocks: maxBackfillBlocks,
+	}
+
+	return poll.NewTendermintPollClient(
+		logger,
+		"https://example.com",
+		chain,
+		metricsManager,
+		configPkg.TimeoutsConfig{},
+		&StaticHeightProvider{Height: lastHeight},
+	)
+}
+
+func collectReports(client *poll.TendermintPollClient, callback func()) []types.Report {
+	reports := make([]types.Report, 0)
+	done := make(chan bool)
+
+	go func() {
+		for report := range client.Channel {
+			reports = append(reports, report)
+		}
+
+		done <- true
+	}()
+
+	callback()
+	close(client.Channel)
+	<-done
+
+	return reports
+}
+
+func TestPollClientGetStartHeight(t *testing.T) {
+	t.Parallel()
+
+	// no last height, starting from the latest block
+	require.Equal(t, int64(110), getClient(100, 0).GetStartHeight(110))
+
+	// last height is too far behind, going back only max-backfill-blocks
+	require.Equal(t, int64(105), getClient(5, 100).GetStartHeight(110))
+
+	// backfilling disabled
+	require.Equal(t, int64(110), getClient(0, 100).GetStartHeight(110))
+
+	// last height is not behind
+	require.Equal(t, int64(110), getClient(100, 120).GetStartHeight(110))
+
+	// last height is within max-backfill-blocks, polling the block at it again
+	require.Equal(t, int64(99), getClient(100, 100).GetStartHeight(110))
+
+	// last height is the latest block, which might have been only partly delivered
+	require.Equal(t, int64(109), getClient(100, 110).GetStartHeight(110))
+
+	client := getClient(100, 100)
+	client.LastHeightProvider = nil
+	require.Equal(t, int64(110), client.GetStartHeight(110))
+}
+
+func TestPollClientStatus(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(100, 0)
+	require.Equal(t, "https://example.com", client.GetURL())
+	require.NotNil(t, client.GetChannel())
+	require.False(t, client.Status().Success)
+
+	client.SetActive()
+	require.True(t, client.Status().Success)
+	require.NoError(t, client.Status().Error)
+}
+
+func TestPollClientStatusConcurrent(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(100, 0)
+
+	// the status is read by the nodes manager while the node is polled
+	reports := collectReports(client, func() {
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for index := 0; index < 100; index++ {
+				client.Status()
+			}
+		}()
+
+		for index := 0; index < 100; index++ {
+			client.SetActive()
+			client.SetError(errors.New("custom error"))
+		}
+
+		wg.Wait()
+	})
+
+	require.Len(t, reports, 100)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPollClientStatusError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client := getClient(100, 100)
+
+	reports := collectReports(client, func() {
+		client.Poll(context.Background())
+		client.Poll(context.Background())
+	})
+
+	// the error is only reported once
+	require.Len(t, reports, 1)
+	_, ok := reports[0].Reportable.(*types.NodeConnectError)
+	require.True(t, ok)
+	require.False(t, client.Status().Success)
+	require.Error(t, client.Status().Error)
+	require.Equal(t, int64(0), client.Height)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPollClientSearchError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-status.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/tx_search?query=%22tx.height+%3E+1+AND+tx.height+%3E%3D+100+AND+tx.height+%3C%3D+110%22&page=1&per_page=100&order_by=%22asc%22",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	client := getClient(100, 100)
+
+	reports := collectReports(client, func() {
+		client.Poll(context.Background())
+	})
+	require.Len(t, reports, 1)
+	_, ok := reports[0].Reportable.(*types.NodeConnectError)
+	require.True(t, ok)
+
+	// not moving forward, so the blocks would be fetched on next poll
+	require.Equal(t, int64(99), client.Height)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPollClientPollOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-status.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/tx_search?query=%22tx.height+%3E+1+AND+tx.height+%3E%3D+100+AND+tx.height+%3C%3D+110%22&page=1&per_page=100&order_by=%22asc%22",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("tx-search.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/blockchain?minHeight=101&maxHeight=101",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-blockchain.json")),
+	)
+
+	client := getClient(100, 100)
+
+	reports := collectReports(client, func() {
+		client.Poll(context.Background())
+
+		// nothing new, so nothing is fetched
+		client.Poll(context.Background())
+	})
+
+	require.Len(t, reports, 2)
+	require.Equal(t, int64(110), client.Height)
+
+	lastBlockHeight, _ := client.GetLastBlock()
+	require.Equal(t, int64(110), lastBlockHeight)
+	require.True(t, client.Status().Success)
+
+	firstTx, ok := reports[0].Reportable.(*types.Tx)
+	require.True(t, ok)
+	require.False(t, firstTx.Backfilled)
+	require.Equal(t, "101", firstTx.Height.Value)
+	require.Equal(t, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC), firstTx.Time.UTC())
+
+	// block time could not be fetched, so it's left empty
+	secondTx, ok := reports[1].Reportable.(*types.Tx)
+	require.True(t, ok)
+	require.Equal(t, "102", secondTx.Height.Value)
+	require.True(t, secondTx.Time.IsZero())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPollClientStopped(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-status.json")),
+	)
+
+	client := getClient(100, 100)
+	client.Chain.PollInterval = time.Second
+	client.Stop()
+
+	// queries are cancelled, which is not reported as a node error
+	reports := collectReports(client, client.Listen)
+	require.Empty(t, reports)
+	require.Equal(t, int64(0), client.Height)
+}
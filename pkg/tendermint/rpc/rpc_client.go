@@ -0,0 +1,168 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/http"
+	"main/pkg/metrics"
+	"main/pkg/types/query_info"
+	"net/url"
+	"sort"
+	"time"
+
+	configTypes "main/pkg/config/types"
+
+	"github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	coreTypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonRpcTypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/rs/zerolog"
+)
+
+const searchPageSize = 100
+
+type TendermintRPCClient struct {
+	Logger         zerolog.Logger
+	Client         *http.Client
+	MetricsManager *metrics.Manager
+	ChainName      string
+}
+
+func NewTendermintRPCClient(
+	logger *zerolog.Logger,
+	url string,
+	chain *configTypes.Chain,
+	metricsManager *metrics.Manager,
+	timeouts config.TimeoutsConfig,
+) *TendermintRPCClient {
+	return &TendermintRPCClient{
+		Logger: logger.With().
+			Str("component", "tendermint_rpc_client").
+			Str("chain", chain.Name).
+			Logger(),
+		Client:         http.NewClient(logger, url, chain.Name, timeouts),
+		ChainName:      chain.Name,
+		MetricsManager: metricsManager,
+	}
+}
+
+func (c *TendermintRPCClient) GetLatestHeight(ctx context.Context) (int64, error) {
+	var response coreTypes.ResultStatus
+	if err := c.Get(ctx, "/status", &response, query_info.QueryTypeStatus); err != nil {
+		return 0, err
+	}
+
+	return response.SyncInfo.LatestBlockHeight, nil
+}
+
+// GetBlockTime returns the time of the block at the given height. It's taken from /blockchain,
+// as it only returns block headers, unlike /block returning the whole block with all its txs.
+func (c *TendermintRPCClient) GetBlockTime(ctx context.Context, height int64) (time.Time, error) {
+	relativeURL := fmt.Sprintf("/blockchain?minHeight=%d&maxHeight=%d", height, height)
+
+	var response coreTypes.ResultBlockchainInfo
+	if err := c.Get(ctx, relativeURL, &response, query_info.QueryTypeBlockchain); err != nil {
+		return time.Time{}, err
+	}
+
+	if len(response.BlockMetas) == 0 {
+		return time.Time{}, fmt.Errorf("block %d not found", height)
+	}
+
+	return response.BlockMetas[0].Header.Time, nil
+}
+
+func (c *TendermintRPCClient) SearchTxs(
+	ctx context.Context,
+	query string,
+	page, perPage int,
+) (*coreTypes.ResultTxSearch, error) {
+	relativeURL := fmt.Sprintf(
+		"/tx_search?query=%s&page=%d&per_page=%d&order_by=%s",
+		url.QueryEscape("\""+query+"\""),
+		page,
+		perPage,
+		url.QueryEscape("\"asc\""),
+	)
+
+	var response coreTypes.ResultTxSearch
+	if err := c.Get(ctx, relativeURL, &response, query_info.QueryTypeTxSearch); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// SearchTxsInRange returns transactions in blocks [fromHeight, toHeight] matching any of the queries,
+// deduplicated and ordered by height and index.
+func (c *TendermintRPCClient) SearchTxsInRange(
+	ctx context.Context,
+	queries []query.Query,
+	fromHeight, toHeight int64,
+) ([]*coreTypes.ResultTx, error) {
+	txsByHash := map[string]*coreTypes.ResultTx{}
+
+	for _, nodeQuery := range queries {
+		searchQuery := fmt.Sprintf(
+			"%s AND tx.height >= %d AND tx.height <= %d",
+			nodeQuery.String(),
+			fromHeight,
+			toHeight,
+		)
+
+		for page := 1; ; page++ {
+			result, err := c.SearchTxs(ctx, searchQuery, page, searchPageSize)
+			if err != nil {
+				return nil, fmt.Errorf("error searching for transactions with query %s: %s", searchQuery, err)
+			}
+
+			for _, resultTx := range result.Txs {
+				txsByHash[resultTx.Hash.String()] = resultTx
+			}
+
+			if len(result.Txs) == 0 || page*searchPageSize >= result.TotalCount {
+				break
+			}
+		}
+	}
+
+	txs := make([]*coreTypes.ResultTx, 0, len(txsByHash))
+	for _, tx := range txsByHash {
+		txs = append(txs, tx)
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Height != txs[j].Height {
+			return txs[i].Height < txs[j].Height
+		}
+
+		return txs[i].Index < txs[j].Index
+	})
+
+	return txs, nil
+}
+
+// Get does a JSON-RPC query, which is cancelled once the context is done, for example,
+// when the node is stopped. Results are decoded with the Tendermint JSON decoder,
+// as RPC returns int64 values as strings.
+func (c *TendermintRPCClient) Get(
+	ctx context.Context,
+	relativeURL string,
+	target interface{},
+	queryType query_info.QueryType,
+) error {
+	var response jsonRpcTypes.RPCResponse
+	err, queryInfo := c.Client.Get(ctx, relativeURL, &response, queryType)
+	c.MetricsManager.LogQuery(c.ChainName, queryInfo, queryType)
+
+	if err != nil {
+		return err
+	}
+
+	if response.Error != nil {
+		return response.Error
+	}
+
+	return json.Unmarshal(response.Result, target)
+}
@@ -0,0 +1,187 @@
+package rpc_test
+
+import (
+	"context"
+	"main/assets"
+	configTypes "main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/tendermint/rpc"
+	"testing"
+	"time"
+
+	configPkg "main/pkg/config"
+
+	queryPkg "github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientGetLatestHeightOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-status.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	height, err := client.GetLatestHeight(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(110), height)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientGetLatestHeightRPCError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, []byte(`{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error"}}`)),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	_, err := client.GetLatestHeight(context.Background())
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientSearchTxsFailed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	_, err := client.SearchTxs(context.Background(), "tx.height > 1", 1, 100)
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientSearchTxsOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/tx_search?query=%22tx.height+%3E+1%22&page=1&per_page=100&order_by=%22asc%22",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("tx-search.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	result, err := client.SearchTxs(context.Background(), "tx.height > 1", 1, 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.TotalCount)
+	require.Len(t, result.Txs, 2)
+	require.Equal(t, int64(102), result.Txs[0].Height)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientSearchTxsInRangeFailed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	_, err := client.SearchTxsInRange(context.Background(), []queryPkg.Query{*queryPkg.MustParse("tx.height > 1")}, 100, 110)
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientSearchTxsInRangeOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/tx_search?query=%22tx.height+%3E+1+AND+tx.height+%3E%3D+100+AND+tx.height+%3C%3D+110%22&page=1&per_page=100&order_by=%22asc%22",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("tx-search.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/tx_search?query=%22tx.height+%3E+2+AND+tx.height+%3E%3D+100+AND+tx.height+%3C%3D+110%22&page=1&per_page=100&order_by=%22asc%22",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("tx-search.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	txs, err := client.SearchTxsInRange(context.Background(), []queryPkg.Query{
+		*queryPkg.MustParse("tx.height > 1"),
+		*queryPkg.MustParse("tx.height > 2"),
+	}, 100, 110)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, int64(101), txs[0].Height)
+	require.Equal(t, int64(102), txs[1].Height)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientGetBlockTimeFailed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	_, err := client.GetBlockTime(context.Background(), 100)
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientGetBlockTimeNotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/blockchain?minHeight=100&maxHeight=100",
+		httpmock.NewBytesResponder(200, []byte(`{"jsonrpc":"2.0","id":-1,"result":{"last_height":"110","block_metas":[]}}`)),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	_, err := client.GetBlockTime(context.Background(), 100)
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCClientGetBlockTimeOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/blockchain?minHeight=100&maxHeight=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-blockchain.json")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	client := rpc.NewTendermintRPCClient(logger, "https://example.com", &configTypes.Chain{Name: "chain"}, metricsManager, configPkg.TimeoutsConfig{})
+
+	blockTime, err := client.GetBlockTime(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC), blockTime.UTC())
+}
@@ -3,13 +3,16 @@ package ws
 import (
 	"context"
 	metricsPkg "main/pkg/metrics"
+	"main/pkg/tendermint/rpc"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 	"unsafe"
 
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 
+	"main/pkg/config"
 	configTypes "main/pkg/config/types"
 	"main/pkg/converter"
 	"main/pkg/types"
@@ -19,19 +22,39 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// LastHeightProvider returns the height of the last transaction processed on a chain,
+// so the transactions after it can be backfilled after reconnecting.
+type LastHeightProvider interface {
+	GetLastBlockHeight(chain string) (int64, bool)
+}
+
+const newBlockHeaderQuery = "tm.event = 'NewBlockHeader'"
+
 type TendermintWebsocketClient struct {
-	Logger         zerolog.Logger
-	Chain          *configTypes.Chain
-	MetricsManager *metricsPkg.Manager
-	URL            string
-	Queries        []query.Query
-	Client         *tmClient.WSClient
-	Converter      *converter.Converter
-	Active         bool
-	Error          error
+	Logger             zerolog.Logger
+	Chain              *configTypes.Chain
+	MetricsManager     *metricsPkg.Manager
+	URL                string
+	Queries            []query.Query
+	RPCClient          *rpc.TendermintRPCClient
+	LastHeightProvider LastHeightProvider
+	Converter          *converter.Converter
+	LastBlock          *types.LastBlock
 
 	Parsers map[string]types.MessageParser
 	Channel chan types.Report
+
+	// The fields below are guarded by the mutex, as the node can be stopped
+	// and started again by the failover from another goroutine.
+	mutex   sync.RWMutex
+	client  *tmClient.WSClient
+	active  bool
+	standby bool
+	stopped bool
+	err     error
+
+	// cancels the backfill queries of the current connection once the node is stopped
+	cancel context.CancelFunc
 }
 
 func NewTendermintClient(
@@ -39,6 +62,8 @@ func NewTendermintClient(
 	url string,
 	chain *configTypes.Chain,
 	metricsManager *metricsPkg.Manager,
+	timeouts config.TimeoutsConfig,
+	lastHeightProvider LastHeightProvider,
 ) *TendermintWebsocketClient {
 	return &TendermintWebsocketClient{
 		Logger: logger.With().
@@ -46,49 +71,76 @@ func NewTendermintClient(
 			Str("url", url).
 			Str("chain", chain.Name).
 			Logger(),
-		MetricsManager: metricsManager,
-		URL:            url,
-		Chain:          chain,
-		Queries:        chain.Queries,
-		Active:         false,
-		Channel:        make(chan types.Report),
-		Converter:      converter.NewConverter(logger, chain),
+		MetricsManager:     metricsManager,
+		URL:                url,
+		Chain:              chain,
+		Queries:            chain.Queries,
+		Channel:            make(chan types.Report),
+		Converter:          converter.NewConverter(logger, chain),
+		RPCClient:          rpc.NewTendermintRPCClient(logger, url, chain, metricsManager, timeouts),
+		LastHeightProvider: lastHeightProvider,
+		LastBlock:          &types.LastBlock{},
 	}
 }
 
 func (t *TendermintWebsocketClient) Status() types.TendermintRPCStatus {
-	if t.Client == nil {
-		return types.TendermintRPCStatus{
-			Success: false,
-			Error:   t.Error,
-		}
-	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 
 	return types.TendermintRPCStatus{
-		Success: t.Active,
-		Error:   t.Error,
+		Success: t.client != nil && t.active,
+		Standby: t.standby,
+		Error:   t.err,
 	}
 }
 
+func (t *TendermintWebsocketClient) GetURL() string {
+	return t.URL
+}
+
+func (t *TendermintWebsocketClient) GetChannel() chan types.Report {
+	return t.Channel
+}
+
 func SetUnexportedField(field reflect.Value, value interface{}) {
 	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).
 		Elem().
 		Set(reflect.ValueOf(value))
 }
 
+// Listen connects to the node and processes the events it sends until the node is stopped.
+// If the node is stopped while connecting, it disconnects right away.
 func (t *TendermintWebsocketClient) Listen() {
+	if t.isStopped() {
+		return
+	}
+
+	t.LastBlock.Reset()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
 	client, err := tmClient.NewWS(
 		t.URL,
 		"/websocket",
 		tmClient.OnReconnect(func() {
 			t.Logger.Info().Msg("Reconnecting...")
+			t.MetricsManager.LogNodeReconnect(t.Chain.Name, t.URL)
+
+			// taking the height before subscribing, as new transactions
+			// received after it would move it forward
+			lastHeight, found := t.GetLastHeight()
 			t.SubscribeToUpdates()
+
+			if found {
+				go t.Backfill(ctx, lastHeight)
+			}
 		}),
 		tmClient.PingPeriod(1*time.Second),
 	)
 	if err != nil {
+		cancel()
 		t.Logger.Error().Err(err).Msg("Failed to create a client")
-		t.Error = err
+		t.setError(err)
 		t.Channel <- t.MakeReport(&types.NodeConnectError{Error: err, URL: t.URL, Chain: t.Chain.GetName()})
 		t.MetricsManager.LogNodeConnection(t.Chain.Name, t.URL, false)
 		return
@@ -100,43 +152,142 @@ func (t *TendermintWebsocketClient) Listen() {
 		SetUnexportedField(field, "wss")
 	}
 
-	t.Client = client
+	t.mutex.Lock()
+	if t.stopped {
+		t.mutex.Unlock()
+		cancel()
+		return
+	}
+	t.client = client
+	t.cancel = cancel
+	t.mutex.Unlock()
 
 	t.Logger.Trace().Msg("Connecting to a node...")
 
 	if err = client.Start(); err != nil {
-		t.Error = err
+		t.setError(err)
 		t.Channel <- t.MakeReport(&types.NodeConnectError{Error: err, URL: t.URL, Chain: t.Chain.GetName()})
 		t.Logger.Warn().Err(err).Msg("Error connecting to node")
 		t.MetricsManager.LogNodeConnection(t.Chain.Name, t.URL, false)
 	} else {
 		t.Logger.Debug().Msg("Connected to a node")
-		t.Active = true
+		t.setActive(true)
 		t.MetricsManager.LogNodeConnection(t.Chain.Name, t.URL, true)
 	}
 
+	// Stop might have been called while the client was starting, before it could be stopped.
+	if t.isStopped() {
+		t.stopClient(client)
+		t.setActive(false)
+		return
+	}
+
+	lastHeight, found := t.GetLastHeight()
 	t.SubscribeToUpdates()
 
-	for {
-		select {
-		case result := <-client.ResponsesCh:
-			t.ProcessEvent(result)
-		}
+	if found && t.IsActive() {
+		go t.Backfill(ctx, lastHeight)
+	}
+
+	// the channel is closed when the node is stopped
+	for result := range client.ResponsesCh {
+		t.ProcessEvent(result)
 	}
 }
 
+// Stop disconnects from the node. The node can be listened to again only after
+// it's taken out of standby with SetStandby(false).
 func (t *TendermintWebsocketClient) Stop() {
 	t.Logger.Info().Msg("Stopping the node...")
 
-	if t.Client != nil && t.Client.ResponsesCh != nil {
-		if err := t.Client.Stop(); err != nil {
-			t.Logger.Warn().Err(err).Msg("Error stopping the node")
-		}
+	t.mutex.Lock()
+	t.stopped = true
+	client := t.client
+	cancel := t.cancel
+	t.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	t.stopClient(client)
+	t.setActive(false)
+}
+
+func (t *TendermintWebsocketClient) stopClient(client *tmClient.WSClient) {
+	if client == nil || client.ResponsesCh == nil || !client.IsRunning() {
+		return
+	}
+
+	if err := client.Stop(); err != nil {
+		t.Logger.Warn().Err(err).Msg("Error stopping the node")
+	}
+}
+
+func (t *TendermintWebsocketClient) GetLastBlock() (int64, time.Time) {
+	return t.LastBlock.Get()
+}
+
+func (t *TendermintWebsocketClient) ResetLastBlock() {
+	t.LastBlock.Reset()
+}
+
+func (t *TendermintWebsocketClient) IsStandby() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.standby
+}
+
+// SetStandby marks the node as a standby one. Taking the node out of standby
+// also allows it to be listened to again after it was stopped.
+func (t *TendermintWebsocketClient) SetStandby(standby bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.standby = standby
+	if !standby {
+		t.stopped = false
 	}
 }
 
+func (t *TendermintWebsocketClient) IsActive() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.active
+}
+
+func (t *TendermintWebsocketClient) setActive(active bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.active = active
+}
+
+func (t *TendermintWebsocketClient) setError(err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.err = err
+}
+
+func (t *TendermintWebsocketClient) isStopped() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.stopped
+}
+
+func (t *TendermintWebsocketClient) getClient() *tmClient.WSClient {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.client
+}
+
 func (t *TendermintWebsocketClient) Resubscribe() {
-	if err := t.Client.UnsubscribeAll(context.Background()); err != nil {
+	if err := t.getClient().UnsubscribeAll(context.Background()); err != nil {
 		t.Logger.Error().Err(err).Msg("Error unsubscribing from queries")
 	}
 
@@ -146,17 +297,32 @@ func (t *TendermintWebsocketClient) Resubscribe() {
 func (t *TendermintWebsocketClient) SubscribeToUpdates() {
 	t.Logger.Trace().Msg("Subscribing to updates...")
 
+	client := t.getClient()
+
 	for _, nodeQuery := range t.Queries {
-		if err := t.Client.Subscribe(context.Background(), nodeQuery.String()); err != nil {
+		if err := client.Subscribe(context.Background(), nodeQuery.String()); err != nil {
 			t.Logger.Error().Err(err).Str("query", nodeQuery.String()).Msg("Failed to subscribe to query")
 		} else {
 			t.Logger.Info().Str("query", nodeQuery.String()).Msg("Listening for incoming transactions")
 		}
 	}
+
+	// new blocks are not reported, but are used to detect whether the node has stalled
+	if err := client.Subscribe(context.Background(), newBlockHeaderQuery); err != nil {
+		t.Logger.Error().Err(err).Msg("Failed to subscribe to new blocks")
+	}
 }
 
 func (t *TendermintWebsocketClient) ProcessEvent(event jsonRpcTypes.RPCResponse) {
 	reportable := t.Converter.ParseEvent(event, t.URL)
+	if newBlock, ok := reportable.(*types.NewBlock); ok {
+		if t.LastBlock.Update(newBlock.Height) {
+			t.MetricsManager.LogNodeLastBlock(t.Chain.Name, t.URL, newBlock.Height)
+		}
+
+		return
+	}
+
 	if reportable != nil {
 		t.MetricsManager.LogWSEvent(t.Chain.Name, t.URL)
 		t.Channel <- t.MakeReport(reportable)
@@ -174,3 +340,109 @@ func (t *TendermintWebsocketClient) MakeReport(reportable types.Reportable) type
 		Reportable: reportable,
 	}
 }
+
+func (t *TendermintWebsocketClient) GetLastHeight() (int64, bool) {
+	if t.LastHeightProvider == nil {
+		return 0, false
+	}
+
+	return t.LastHeightProvider.GetLastBlockHeight(t.Chain.Name)
+}
+
+// Backfill fetches transactions committed since the last processed height,
+// which were missed while the node was disconnected, and sends them
+// the same way as the ones received via websocket. The block at the last processed height
+// is fetched again, as it might have been only partly delivered, and the transactions
+// already delivered are deduplicated later. If there are more blocks
+// than max-backfill-blocks since the last height, only the latest ones are fetched.
+// It stops once the context is done, for example, when the node is stopped.
+func (t *TendermintWebsocketClient) Backfill(ctx context.Context, lastHeight int64) {
+	if t.Chain.MaxBackfillBlocks <= 0 {
+		return
+	}
+
+	latestHeight, err := t.RPCClient.GetLatestHeight(ctx)
+	if err != nil {
+		t.Logger.Error().Err(err).Msg("Error getting latest height, cannot backfill transactions")
+		return
+	}
+
+	if latestHeight < lastHeight {
+		return
+	}
+
+	fromHeight := lastHeight
+	if latestHeight-lastHeight >= t.Chain.MaxBackfillBlocks {
+		fromHeight = latestHeight - t.Chain.MaxBackfillBlocks + 1
+		t.Logger.Warn().
+			Int64("last_height", lastHeight).
+			Int64("latest_height", latestHeight).
+			Int64("max_backfill_blocks", t.Chain.MaxBackfillBlocks).
+			Msg("Too many blocks since the last processed height, some transactions would be missed")
+	}
+
+	t.Logger.Info().
+		Int64("from", fromHeight).
+		Int64("to", latestHeight).
+		Msg("Backfilling missed transactions")
+
+	txs := t.SearchTxs(ctx, fromHeight, latestHeight)
+	if ctx.Err() != nil {
+		t.Logger.Info().Msg("Node is stopped, not backfilling transactions")
+		return
+	}
+
+	for _, tx := range txs {
+		select {
+		case t.Channel <- t.MakeReport(tx):
+		case <-ctx.Done():
+			t.Logger.Info().Msg("Node is stopped, not backfilling transactions")
+			return
+		}
+	}
+
+	t.MetricsManager.LogBackfilledTxs(t.Chain.Name, t.URL, len(txs))
+	t.Logger.Info().Int("count", len(txs)).Msg("Backfilled missed transactions")
+}
+
+// SearchTxs returns transactions in blocks [fromHeight, toHeight] matching any of the queries,
+// ordered by height and index.
+func (t *TendermintWebsocketClient) SearchTxs(ctx context.Context, fromHeight, toHeight int64) []*types.Tx {
+	resultTxs, err := t.RPCClient.SearchTxsInRange(ctx, t.Queries, fromHeight, toHeight)
+	if err != nil {
+		t.Logger.Error().Err(err).Msg("Error searching for transactions to backfill")
+		return []*types.Tx{}
+	}
+
+	txs := make([]*types.Tx, 0, len(resultTxs))
+	blockTimes := map[int64]time.Time{}
+
+	for _, resultTx := range resultTxs {
+		if tx := t.Converter.ParseResultTx(resultTx); tx != nil {
+			tx.Time = t.GetBlockTime(ctx, resultTx.Height, blockTimes)
+			txs = append(txs, tx)
+		}
+	}
+
+	return txs
+}
+
+// GetBlockTime returns the block time, reusing the already fetched ones, as there can be
+// multiple txs in a block. If it cannot be fetched, it returns zero time.
+func (t *TendermintWebsocketClient) GetBlockTime(
+	ctx context.Context,
+	height int64,
+	blockTimes map[int64]time.Time,
+) time.Time {
+	if blockTime, ok := blockTimes[height]; ok {
+		return blockTime
+	}
+
+	blockTime, err := t.RPCClient.GetBlockTime(ctx, height)
+	if err != nil {
+		t.Logger.Warn().Err(err).Int64("height", height).Msg("Could not get block time")
+	}
+
+	blockTimes[height] = blockTime
+	return blockTime
+}
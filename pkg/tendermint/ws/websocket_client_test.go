@@ -0,0 +1,230 @@
+package ws_test
+
+import (
+	"context"
+	"main/assets"
+	configPkg "main/pkg/config"
+	configTypes "main/pkg/config/types"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/tendermint/ws"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	queryPkg "github.com/cometbft/cometbft/libs/pubsub/query"
+	jsonRpcTypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+type StaticHeightProvider struct {
+	Height int64
+}
+
+func (p *StaticHeightProvider) GetLastBlockHeight(chain string) (int64, bool) {
+	return p.Height, p.Height > 0
+}
+
+func getClient(maxBackfillBlocks int64, lastHeight int64) *ws.TendermintWebsocketClient {
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metricsPkg.NewManager(logger, configPkg.MetricsConfig{Enabled: false})
+	chain := &configTypes.Chain{
+		Name:              "chain",
+		Queries:           []queryPkg.Query{*queryPkg.MustParse("tx.height > 1")},
+		MaxBackfillBlocks: maxBackfillBlocks,
+	}
+
+	return ws.NewTendermintClient(
+		logger,
+		"https://example.com",
+		chain,
+		metricsManager,
+		configPkg.TimeoutsConfig{},
+		&StaticHeightProvider{Height: lastHeight},
+	)
+}
+
+func TestWebsocketClientGetLastHeight(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(100, 100)
+	height, found := client.GetLastHeight()
+	require.True(t, found)
+	require.Equal(t, int64(100), height)
+
+	client.LastHeightProvider = nil
+	_, found = client.GetLastHeight()
+	require.False(t, found)
+}
+
+func TestWebsocketClientProcessNewBlock(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(100, 100)
+
+	// would block on sending to channel if new block is reported
+	client.ProcessEvent(jsonRpcTypes.RPCResponse{
+		Result: []byte("{\"data\":{\"type\":\"tendermint/event/NewBlockHeader\",\"value\":{\"header\":{\"height\":\"123\"}}}}"),
+	})
+
+	height, blockTime := client.GetLastBlock()
+	require.Equal(t, int64(123), height)
+	require.False(t, blockTime.IsZero())
+}
+
+func TestWebsocketClientBackfillDisabled(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(0, 100)
+
+	// would block on sending to channel if anything is backfilled
+	client.Backfill(context.Background(), 100)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestWebsocketClientBackfillStatusError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client := getClient(100, 100)
+	client.Backfill(context.Background(), 100)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestWebsocketClientBackfillNothingMissed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-status.json")),
+	)
+
+	client := getClient(100, 111)
+	client.Backfill(context.Background(), 111)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestWebsocketClientBackfillStopped(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-status.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/tx_search?query=%22tx.height+%3E+1+AND+tx.height+%3E%3D+106+AND+tx.height+%3C%3D+110%22&page=1&per_page=100&order_by=%22asc%22",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("tx-search.json")),
+	)
+
+	client := getClient(5, 100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// would block on sending to channel if anything is backfilled
+	client.Backfill(ctx, 100)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestWebsocketClientBackfillOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-status.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/tx_search?query=%22tx.height+%3E+1+AND+tx.height+%3E%3D+106+AND+tx.height+%3C%3D+110%22&page=1&per_page=100&order_by=%22asc%22",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("tx-search.json")),
+	)
+
+	client := getClient(5, 100)
+
+	reports := make([]types.Report, 0)
+	done := make(chan bool)
+
+	go func() {
+		for report := range client.Channel {
+			reports = append(reports, report)
+		}
+
+		done <- true
+	}()
+
+	client.Backfill(context.Background(), 100)
+	close(client.Channel)
+	<-done
+
+	require.Len(t, reports, 2)
+
+	firstTx, ok := reports[0].Reportable.(*types.Tx)
+	require.True(t, ok)
+	require.True(t, firstTx.Backfilled)
+	require.Equal(t, "101", firstTx.Height.Value)
+
+	secondTx, ok := reports[1].Reportable.(*types.Tx)
+	require.True(t, ok)
+	require.Equal(t, "102", secondTx.Height.Value)
+	require.Equal(t, []string{"sent1signer"}, secondTx.Signers)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestWebsocketClientBackfillStoppedWhileSending(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("rpc-status.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/tx_search?query=%22tx.height+%3E+1+AND+tx.height+%3E%3D+106+AND+tx.height+%3C%3D+110%22&page=1&per_page=100&order_by=%22asc%22",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("tx-search.json")),
+	)
+
+	client := getClient(5, 100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan bool)
+
+	// nothing reads from the channel, so it would block forever if not stopped
+	go func() {
+		client.Backfill(ctx, 100)
+		done <- true
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "backfill is not stopped")
+	}
+}
+
+func TestWebsocketClientListenAfterStop(t *testing.T) {
+	t.Parallel()
+
+	client := getClient(100, 100)
+	client.Stop()
+
+	// would block on sending the connection error to channel if it tried to connect
+	client.Listen()
+	require.False(t, client.Status().Success)
+	require.False(t, client.IsActive())
+
+	client.SetStandby(true)
+	require.True(t, client.Status().Standby)
+}
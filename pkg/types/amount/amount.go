@@ -29,6 +29,9 @@ type Amount struct {
 	Denom     Denom
 	BaseDenom Denom
 	PriceUSD  *big.Float
+
+	// HistoricalPriceUSD is the amount value at the time of the transaction, if known.
+	HistoricalPriceUSD *big.Float
 }
 
 func AmountFrom(coin cosmosTypes.Coin) *Amount {
@@ -67,6 +70,10 @@ func (a *Amount) AddUSDPrice(usdPrice float64) {
 	a.PriceUSD = new(big.Float).Mul(tokenPriceBigFloat, amountValueBigFloat)
 }
 
+func (a *Amount) AddHistoricalUSDPrice(usdPrice float64) {
+	a.HistoricalPriceUSD = new(big.Float).Mul(a.Value, new(big.Float).SetFloat64(usdPrice))
+}
+
 func (a *Amount) String() string {
 	value, _ := a.Value.Int(nil)
 	return fmt.Sprintf("%d%s", value, a.Denom)
@@ -79,3 +86,40 @@ func (a Amounts) String() string {
 		return a.String()
 	}), ",")
 }
+
+// HasPrices returns whether any of the amounts has its USD price known.
+func (a Amounts) HasPrices() bool {
+	for _, amount := range a {
+		if amount.PriceUSD != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QuoteRate is how much of a quote currency one USD is worth.
+type QuoteRate struct {
+	Currency string
+	Rate     float64
+	Fiat     bool
+}
+
+// GetQuotePrice returns the amount value in the quote currency, or nil if its USD price is not known.
+func (a *Amount) GetQuotePrice(rate *QuoteRate) *big.Float {
+	if a.PriceUSD == nil {
+		return nil
+	}
+
+	return new(big.Float).Mul(a.PriceUSD, new(big.Float).SetFloat64(rate.Rate))
+}
+
+// GetHistoricalQuotePrice returns the amount value at the time of the transaction in the quote currency,
+// or nil if it's not known. The quote rate is the current one.
+func (a *Amount) GetHistoricalQuotePrice(rate *QuoteRate) *big.Float {
+	if a.HistoricalPriceUSD == nil {
+		return nil
+	}
+
+	return new(big.Float).Mul(a.HistoricalPriceUSD, new(big.Float).SetFloat64(rate.Rate))
+}
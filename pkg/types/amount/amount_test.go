@@ -3,6 +3,7 @@ package amount_test
 import (
 	"fmt"
 	amountPkg "main/pkg/types/amount"
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -66,6 +67,29 @@ func TestAmountAddUsdPrice(t *testing.T) {
 	require.Equal(t, "1.230000", fmt.Sprintf("%.6f", amount.PriceUSD))
 }
 
+func TestAmountGetQuotePrice(t *testing.T) {
+	t.Parallel()
+
+	amount := &amountPkg.Amount{Value: big.NewFloat(2)}
+	rate := &amountPkg.QuoteRate{Currency: "eur", Rate: 0.5, Fiat: true}
+	require.Nil(t, amount.GetQuotePrice(rate))
+
+	amount.AddUSDPrice(3)
+	require.Equal(t, "3", amount.GetQuotePrice(rate).String())
+}
+
+func TestAmountGetHistoricalQuotePrice(t *testing.T) {
+	t.Parallel()
+
+	amount := &amountPkg.Amount{Value: big.NewFloat(2)}
+	rate := &amountPkg.QuoteRate{Currency: "eur", Rate: 0.5, Fiat: true}
+	require.Nil(t, amount.GetHistoricalQuotePrice(rate))
+
+	amount.AddHistoricalUSDPrice(4)
+	require.Equal(t, "8", amount.HistoricalPriceUSD.String())
+	require.Equal(t, "4", amount.GetHistoricalQuotePrice(rate).String())
+}
+
 func TestAmountToString(t *testing.T) {
 	t.Parallel()
 
@@ -90,3 +114,16 @@ func TestAmountsToString(t *testing.T) {
 
 	require.Equal(t, "123stake,345yield", amounts.String())
 }
+
+func TestAmountsHasPrices(t *testing.T) {
+	t.Parallel()
+
+	amount1 := amountPkg.AmountFromString("123.456", "stake")
+	amount2 := amountPkg.AmountFromString("345.678", "yield")
+
+	require.False(t, amountPkg.Amounts{}.HasPrices())
+	require.False(t, amountPkg.Amounts{amount1, amount2}.HasPrices())
+
+	amount2.AddUSDPrice(1.23)
+	require.True(t, amountPkg.Amounts{amount1, amount2}.HasPrices())
+}
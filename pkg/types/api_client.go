@@ -0,0 +1,21 @@
+package types
+
+import (
+	"context"
+	"main/pkg/types/responses"
+
+	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// ApiClient fetches chain data (validators, proposals, IBC info etc.) from a single node,
+// either via LCD REST or gRPC.
+type ApiClient interface {
+	GetValidator(ctx context.Context, address string) (*responses.Validator, error)
+	GetDelegatorsRewardsAtBlock(ctx context.Context, delegator string, validator string, block int64) ([]responses.Reward, error)
+	GetValidatorCommissionAtBlock(ctx context.Context, validator string, block int64) ([]responses.Commission, error)
+	GetProposal(ctx context.Context, id string) (*responses.Proposal, error)
+	GetStakingParams(ctx context.Context) (*responses.StakingParams, error)
+	GetIbcChannel(ctx context.Context, channel string, port string) (*responses.IbcChannel, error)
+	GetIbcConnectionClientState(ctx context.Context, connectionID string) (*responses.IbcIdentifiedClientState, error)
+	GetIbcDenomTrace(ctx context.Context, hash string) (*transferTypes.DenomTrace, error)
+}
@@ -1,9 +1,11 @@
 package types
 
 import (
+	"context"
 	configTypes "main/pkg/config/types"
 	"main/pkg/types/amount"
 	"main/pkg/types/responses"
+	"time"
 
 	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
@@ -12,30 +14,38 @@ import (
 // DataFetcher -> MetricsManager -> types -> DataFetcher.
 
 type DataFetcher interface {
-	GetPriceFetcher(info *configTypes.DenomInfo) PriceFetcher
-	PopulateAmount(chainID string, amount *amount.Amount)
-	PopulateAmounts(chainID string, amount amount.Amounts)
+	GetPriceFetcher(sourceType string) PriceFetcher
+	PopulateAmount(ctx context.Context, chainID string, amount *amount.Amount)
+	PopulateAmounts(ctx context.Context, chainID string, amount amount.Amounts)
+	PopulateHistoricalPrices(ctx context.Context, chainID string, amounts amount.Amounts, txTime time.Time)
+	PrefetchPrices(ctx context.Context, chainID string, amounts amount.Amounts)
+	PrefetchValidators(ctx context.Context, chain *configTypes.Chain, addresses []string)
+	GetQuoteRates(ctx context.Context, currencies []string) []*amount.QuoteRate
 	GetRewardsAtBlock(
+		ctx context.Context,
 		chain *configTypes.Chain,
 		delegator string,
 		validator string,
 		block int64,
 	) ([]responses.Reward, bool)
 	GetCommissionAtBlock(
+		ctx context.Context,
 		chain *configTypes.Chain,
 		validator string,
 		block int64,
 	) ([]responses.Commission, bool)
-	GetProposal(chain *configTypes.Chain, id string) (*responses.Proposal, bool)
-	GetStakingParams(chain *configTypes.Chain) (*responses.StakingParams, bool)
-	GetIbcRemoteChainID(chainID string, channel, port string) (string, bool)
+	GetProposal(ctx context.Context, chain *configTypes.Chain, id string) (*responses.Proposal, bool)
+	GetStakingParams(ctx context.Context, chain *configTypes.Chain) (*responses.StakingParams, bool)
+	GetIbcRemoteChainID(ctx context.Context, chainID string, channel, port string) (string, bool)
 	FindChainById(chainID string) (*configTypes.Chain, bool)
 	GetDenomTrace(
+		ctx context.Context,
 		chain *configTypes.Chain,
 		denom string,
 	) (*transferTypes.DenomTrace, bool)
 	PopulateWallet(chain *configTypes.Chain, walletLink *configTypes.Link, subscriptionName string)
 	PopulateMultichainWallet(
+		ctx context.Context,
 		chain *configTypes.Chain,
 		channel string,
 		port string,
@@ -48,6 +58,7 @@ type DataFetcher interface {
 		subscriptionName string,
 	)
 	PopulateValidator(
+		ctx context.Context,
 		chain *configTypes.Chain,
 		validatorLink *configTypes.Link,
 	)
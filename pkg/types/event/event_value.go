@@ -1,5 +1,9 @@
 package event
 
+import (
+	abciTypes "github.com/cometbft/cometbft/abci/types"
+)
+
 type EventValue struct {
 	Key   string
 	Value string
@@ -27,3 +31,17 @@ func (v EventValues) ToMap() map[string][]string {
 
 	return eventsMap
 }
+
+// FromAbciEvents converts events emitted by a transaction into values
+// that can be matched against filters, like "transfer.recipient".
+func FromAbciEvents(events []abciTypes.Event) EventValues {
+	values := make(EventValues, 0)
+
+	for _, abciEvent := range events {
+		for _, attribute := range abciEvent.Attributes {
+			values = append(values, From(abciEvent.Type, attribute.Key, attribute.Value))
+		}
+	}
+
+	return values
+}
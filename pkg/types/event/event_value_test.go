@@ -4,6 +4,7 @@ import (
 	eventPkg "main/pkg/types/event"
 	"testing"
 
+	abciTypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,3 +32,29 @@ func TestEventValueMap(t *testing.T) {
 	require.Equal(t, []string{"value1", "value2"}, eventsMap["event.key"])
 	require.Equal(t, []string{"value"}, eventsMap["event.otherkey"])
 }
+
+func TestEventValuesFromAbciEvents(t *testing.T) {
+	t.Parallel()
+
+	events := eventPkg.FromAbciEvents([]abciTypes.Event{
+		{
+			Type: "transfer",
+			Attributes: []abciTypes.EventAttribute{
+				{Key: "sender", Value: "from"},
+				{Key: "recipient", Value: "to"},
+			},
+		},
+		{
+			Type:       "tx",
+			Attributes: []abciTypes.EventAttribute{{Key: "fee", Value: "100uatom"}},
+		},
+	})
+
+	require.Len(t, events, 3)
+	require.Equal(t, "transfer.sender", events[0].Key)
+	require.Equal(t, "from", events[0].Value)
+	require.Equal(t, "transfer.recipient", events[1].Key)
+	require.Equal(t, "to", events[1].Value)
+	require.Equal(t, "tx.fee", events[2].Key)
+	require.Equal(t, "100uatom", events[2].Value)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+// LastBlock tracks the latest block a node is known to have, and the time it was received,
+// which is used to detect nodes that have stalled.
+type LastBlock struct {
+	height int64
+	time   time.Time
+	mutex  sync.Mutex
+}
+
+// Update sets the latest block height, returning true if it has increased.
+func (b *LastBlock) Update(height int64) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if height <= b.height {
+		return false
+	}
+
+	b.height = height
+	b.time = time.Now()
+	return true
+}
+
+func (b *LastBlock) Get() (int64, time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.height, b.time
+}
+
+// Reset makes the node count as not stalled for a while, so a node
+// that has just started listening has time to connect and catch up.
+func (b *LastBlock) Reset() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.time = time.Now()
+}
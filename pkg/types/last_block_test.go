@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastBlock(t *testing.T) {
+	t.Parallel()
+
+	lastBlock := &LastBlock{}
+	height, blockTime := lastBlock.Get()
+	require.Zero(t, height)
+	require.True(t, blockTime.IsZero())
+
+	lastBlock.Reset()
+	height, blockTime = lastBlock.Get()
+	require.Zero(t, height)
+	require.False(t, blockTime.IsZero())
+
+	require.True(t, lastBlock.Update(10))
+	require.False(t, lastBlock.Update(10))
+	require.False(t, lastBlock.Update(9))
+
+	height, _ = lastBlock.Get()
+	require.Equal(t, int64(10), height)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+	"main/pkg/types/amount"
 	"main/pkg/types/event"
 
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -8,10 +10,17 @@ import (
 
 type Message interface {
 	Type() string
-	GetAdditionalData(fetcher DataFetcher, subscriptionName string)
+	GetAdditionalData(ctx context.Context, fetcher DataFetcher, subscriptionName string)
 	GetValues() event.EventValues
+	GetAmounts() amount.Amounts
+	// GetValidators returns the addresses of validators the message references,
+	// not including the ones in its parsed messages.
+	GetValidators() []string
 	GetRawMessages() []*codecTypes.Any
 	AddParsedMessage(message Message)
 	SetParsedMessages(messages []Message)
+	// WithParsedMessages returns a shallow copy of the message with its parsed messages
+	// replaced by the given ones, leaving the message itself unchanged.
+	WithParsedMessages(messages []Message) Message
 	GetParsedMessages() []Message
 }
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"context"
+	"strconv"
+)
+
+// NewBlock is produced when a node sends a new block header. It is only used to track
+// whether the node is alive and is not sent to reporters.
+type NewBlock struct {
+	Height int64
+}
+
+func (b *NewBlock) GetMessages() []Message {
+	return []Message{}
+}
+
+func (b *NewBlock) Type() string {
+	return "NewBlock"
+}
+
+func (b *NewBlock) GetHash() string {
+	return strconv.FormatInt(b.Height, 10)
+}
+
+func (b *NewBlock) GetAdditionalData(ctx context.Context, fetcher DataFetcher, subscriptionName string) {
+}
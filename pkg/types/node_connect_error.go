@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"github.com/google/uuid"
 )
 
@@ -22,5 +23,5 @@ func (e *NodeConnectError) GetHash() string {
 	return uuid.NewString()
 }
 
-func (e *NodeConnectError) GetAdditionalData(fetcher DataFetcher, subscriptionName string) {
+func (e *NodeConnectError) GetAdditionalData(ctx context.Context, fetcher DataFetcher, subscriptionName string) {
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,5 +15,5 @@ func TestNodeConnectError(t *testing.T) {
 	assert.NotEmpty(t, event.GetHash())
 	assert.Empty(t, event.GetMessages())
 
-	event.GetAdditionalData(nil, "test")
+	event.GetAdditionalData(context.Background(), nil, "test")
 }
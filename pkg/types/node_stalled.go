@@ -0,0 +1,38 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NodeStalled is sent when a node stops receiving new blocks,
+// or falls too far behind the other nodes of the same chain.
+type NodeStalled struct {
+	Chain          string
+	URL            string
+	Height         int64
+	LastBlockTime  time.Time
+	BlocksBehind   int64
+	SinceLastBlock time.Duration
+}
+
+func (e *NodeStalled) GetMessages() []Message {
+	return []Message{}
+}
+
+func (e *NodeStalled) Type() string {
+	return "NodeStalled"
+}
+
+func (e *NodeStalled) GetHash() string {
+	return uuid.NewString()
+}
+
+func (e *NodeStalled) GetAdditionalData(ctx context.Context, fetcher DataFetcher, subscriptionName string) {
+}
+
+func (e *NodeStalled) GetSinceLastBlock() time.Duration {
+	return e.SinceLastBlock.Round(time.Second)
+}
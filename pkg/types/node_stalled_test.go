@@ -0,0 +1,19 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeStalled(t *testing.T) {
+	t.Parallel()
+
+	event := NodeStalled{}
+	assert.NotEmpty(t, event.Type())
+	assert.NotEmpty(t, event.GetHash())
+	assert.Empty(t, event.GetMessages())
+
+	event.GetAdditionalData(context.Background(), nil, "test")
+}
@@ -1,8 +1,17 @@
 package types
 
-import configTypes "main/pkg/config/types"
+import (
+	"context"
+	configTypes "main/pkg/config/types"
+	"time"
+)
 
 type PriceFetcher interface {
-	GetPrices(denomInfos configTypes.DenomInfos) (map[*configTypes.DenomInfo]float64, error)
+	GetPrices(ctx context.Context, denomInfos configTypes.DenomInfos) (map[*configTypes.DenomInfo]float64, error)
 	Name() string
 }
+
+// HistoricalPriceFetcher is a PriceFetcher that can also return a denom price at some time in the past.
+type HistoricalPriceFetcher interface {
+	GetHistoricalPrice(ctx context.Context, denomInfo *configTypes.DenomInfo, priceTime time.Time) (float64, error)
+}
@@ -14,9 +14,36 @@ const (
 	QueryTypeIbcConnectionClientState QueryType = "ibc_connection_client_state"
 	QueryTypeIbcDenomTrace            QueryType = "ibc_denom_trace"
 	QueryTypeChainsList               QueryType = "chains_list"
+	QueryTypeChainInfo                QueryType = "chain_info"
 	QueryTypePrices                   QueryType = "prices"
+	QueryTypeExchangeRates            QueryType = "exchange_rates"
+	QueryTypeHistoricalPrices         QueryType = "historical_prices"
+	QueryTypeStatus                   QueryType = "status"
+	QueryTypeTxSearch                 QueryType = "tx_search"
+	QueryTypeBlockchain               QueryType = "blockchain"
 )
 
+func GetQueryTypes() []QueryType {
+	return []QueryType{
+		QueryTypeRewards,
+		QueryTypeCommission,
+		QueryTypeProposal,
+		QueryTypeStakingParams,
+		QueryTypeValidator,
+		QueryTypeIbcChannel,
+		QueryTypeIbcConnectionClientState,
+		QueryTypeIbcDenomTrace,
+		QueryTypeChainsList,
+		QueryTypeChainInfo,
+		QueryTypePrices,
+		QueryTypeExchangeRates,
+		QueryTypeHistoricalPrices,
+		QueryTypeStatus,
+		QueryTypeTxSearch,
+		QueryTypeBlockchain,
+	}
+}
+
 type QueryInfo struct {
 	Success bool
 	Time    time.Duration
@@ -2,6 +2,7 @@ package types
 
 import (
 	"main/pkg/config/types"
+	"main/pkg/types/amount"
 )
 
 type Report struct {
@@ -10,4 +11,8 @@ type Report struct {
 	ChainSubscription *types.ChainSubscription
 	Node              string
 	Reportable        Reportable
+
+	// QuoteRates are the rates of the currencies the reporter displays amounts values in,
+	// fetched along with the additional data, so they are not fetched while rendering.
+	QuoteRates []*amount.QuoteRate
 }
@@ -1,8 +1,10 @@
 package types
 
+import "context"
+
 type Reportable interface {
 	Type() string
 	GetHash() string
 	GetMessages() []Message
-	GetAdditionalData(dataFetcher DataFetcher, subscriptionName string)
+	GetAdditionalData(ctx context.Context, dataFetcher DataFetcher, subscriptionName string)
 }
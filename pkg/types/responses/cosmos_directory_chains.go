@@ -3,6 +3,7 @@ package responses
 import (
 	"fmt"
 	"main/pkg/config/types"
+	"strings"
 )
 
 type CosmosDirectoryChainsResponse struct {
@@ -21,10 +22,18 @@ func (chains CosmosDirectoryChains) FindByChainID(chainID string) (CosmosDirecto
 	return CosmosDirectoryChain{}, false
 }
 
+type CosmosDirectoryChainResponse struct {
+	Chain CosmosDirectoryChain `json:"chain"`
+}
+
 type CosmosDirectoryChain struct {
-	Name    string                 `json:"name"`
-	ChainID string                 `json:"chain_id"`
-	Assets  []CosmosDirectoryAsset `json:"assets"`
+	Name       string                    `json:"name"`
+	PrettyName string                    `json:"pretty_name"`
+	ChainID    string                    `json:"chain_id"`
+	Assets     []CosmosDirectoryAsset    `json:"assets"`
+	Apis       CosmosDirectoryApis       `json:"apis"`
+	BestApis   CosmosDirectoryApis       `json:"best_apis"`
+	Explorers  []CosmosDirectoryExplorer `json:"explorers"`
 }
 
 func (chain CosmosDirectoryChain) GetDenomInfo(baseDenom string) (*types.DenomInfo, error) {
@@ -33,23 +42,119 @@ func (chain CosmosDirectoryChain) GetDenomInfo(baseDenom string) (*types.DenomIn
 			continue
 		}
 
-		if asset.Base.Denom == "" || asset.Display.Denom == "" {
-			return nil, fmt.Errorf(
-				"got malformed cosmos.directory response: base.denom '%s', display.denom '%s'",
-				asset.Base.Denom,
-				asset.Display.Denom,
-			)
+		return asset.ToDenomInfo()
+	}
+
+	return nil, fmt.Errorf("asset is not found on chain %s\n", chain.ChainID)
+}
+
+// GetDenomInfos returns all the chain's assets that have both base and display denoms.
+func (chain CosmosDirectoryChain) GetDenomInfos() types.DenomInfos {
+	denoms := make(types.DenomInfos, 0, len(chain.Assets))
+
+	for _, asset := range chain.Assets {
+		if denom, err := asset.ToDenomInfo(); err == nil {
+			denoms = append(denoms, denom)
 		}
+	}
 
-		return &types.DenomInfo{
-			Denom:             asset.Base.Denom,
-			DisplayDenom:      asset.Display.Denom,
-			CoingeckoCurrency: asset.CoingeckoID,
-			DenomExponent:     asset.Display.Exponent - asset.Base.Exponent,
-		}, nil
+	return denoms
+}
+
+// GetRPCNodes returns the RPC nodes cosmos.directory considers healthy, if any, or all the nodes
+// from the chain registry otherwise.
+func (chain CosmosDirectoryChain) GetRPCNodes() []string {
+	if len(chain.BestApis.RPC) > 0 {
+		return chain.BestApis.RPC.GetAddresses()
 	}
 
-	return nil, fmt.Errorf("asset is not found on chain %s\n", chain.ChainID)
+	return chain.Apis.RPC.GetAddresses()
+}
+
+func (chain CosmosDirectoryChain) GetRestNodes() []string {
+	if len(chain.BestApis.Rest) > 0 {
+		return chain.BestApis.Rest.GetAddresses()
+	}
+
+	return chain.Apis.Rest.GetAddresses()
+}
+
+func (chain CosmosDirectoryChain) GetGrpcNodes() []string {
+	return chain.Apis.Grpc.GetAddresses()
+}
+
+// GetExplorer returns Mintscan or Ping.pub explorer, if the chain has one,
+// or the first of the explorers that has links patterns otherwise.
+func (chain CosmosDirectoryChain) GetExplorer() (types.SupportedExplorer, *types.Explorer) {
+	for _, explorer := range chain.Explorers {
+		// links look like "https://ping.pub/cosmos"
+		url := strings.TrimSuffix(explorer.URL, "/")
+		separatorIndex := strings.LastIndex(url, "/")
+		if separatorIndex < 0 {
+			continue
+		}
+
+		switch explorer.Kind {
+		case "mintscan":
+			return &types.MintscanExplorer{Prefix: url[separatorIndex+1:]}, nil
+		case "ping.pub":
+			return &types.PingExplorer{Prefix: url[separatorIndex+1:], BaseUrl: url[:separatorIndex]}, nil
+		}
+	}
+
+	for _, explorer := range chain.Explorers {
+		if explorer.TxPage != "" || explorer.AccountPage != "" {
+			return nil, explorer.ToExplorer()
+		}
+	}
+
+	return nil, nil
+}
+
+type CosmosDirectoryApis struct {
+	RPC  CosmosDirectoryApiList `json:"rpc"`
+	Rest CosmosDirectoryApiList `json:"rest"`
+	Grpc CosmosDirectoryApiList `json:"grpc"`
+}
+
+type CosmosDirectoryApiList []CosmosDirectoryApi
+
+func (apis CosmosDirectoryApiList) GetAddresses() []string {
+	addresses := make([]string, 0, len(apis))
+	for _, api := range apis {
+		if api.Address != "" {
+			addresses = append(addresses, strings.TrimSuffix(api.Address, "/"))
+		}
+	}
+
+	return addresses
+}
+
+type CosmosDirectoryApi struct {
+	Address  string `json:"address"`
+	Provider string `json:"provider"`
+}
+
+type CosmosDirectoryExplorer struct {
+	Kind          string `json:"kind"`
+	URL           string `json:"url"`
+	TxPage        string `json:"tx_page"`
+	AccountPage   string `json:"account_page"`
+	ValidatorPage string `json:"validator_page"`
+	ProposalPage  string `json:"proposal_page"`
+	BlockPage     string `json:"block_page"`
+}
+
+// ToExplorer converts chain registry links, like "https://explorer.com/tx/${txHash}",
+// to the links patterns used in config, like "https://explorer.com/tx/%s".
+func (e CosmosDirectoryExplorer) ToExplorer() *types.Explorer {
+	return &types.Explorer{
+		ProposalLinkPattern:    strings.ReplaceAll(e.ProposalPage, "${proposalId}", "%s"),
+		WalletLinkPattern:      strings.ReplaceAll(e.AccountPage, "${accountAddress}", "%s"),
+		ValidatorLinkPattern:   strings.ReplaceAll(e.ValidatorPage, "${validatorAddress}", "%s"),
+		TransactionLinkPattern: strings.ReplaceAll(e.TxPage, "${txHash}", "%s"),
+		BlockLinkPattern:       strings.ReplaceAll(e.BlockPage, "${height}", "%s"),
+	}
 }
 
 type CosmosDirectoryAsset struct {
@@ -59,6 +164,23 @@ type CosmosDirectoryAsset struct {
 	Display     CosmosDirectoryAssetDenomInfo `json:"display"`
 }
 
+func (asset CosmosDirectoryAsset) ToDenomInfo() (*types.DenomInfo, error) {
+	if asset.Base.Denom == "" || asset.Display.Denom == "" {
+		return nil, fmt.Errorf(
+			"got malformed cosmos.directory response: base.denom '%s', display.denom '%s'",
+			asset.Base.Denom,
+			asset.Display.Denom,
+		)
+	}
+
+	return &types.DenomInfo{
+		Denom:             asset.Base.Denom,
+		DisplayDenom:      asset.Display.Denom,
+		CoingeckoCurrency: asset.CoingeckoID,
+		DenomExponent:     asset.Display.Exponent - asset.Base.Exponent,
+	}, nil
+}
+
 type CosmosDirectoryAssetDenomInfo struct {
 	Denom    string `json:"denom"`
 	Exponent int    `json:"exponent"`
@@ -1,6 +1,7 @@
 package responses_test
 
 import (
+	"main/pkg/config/types"
 	"main/pkg/types/responses"
 	"testing"
 
@@ -82,3 +83,85 @@ func TestDenomFound(t *testing.T) {
 	require.Equal(t, "coingecko", denom.CoingeckoCurrency)
 	require.Equal(t, 6, denom.DenomExponent)
 }
+
+func TestCosmosDirectoryChainGetNodes(t *testing.T) {
+	t.Parallel()
+
+	chain := responses.CosmosDirectoryChain{
+		Apis: responses.CosmosDirectoryApis{
+			RPC:  responses.CosmosDirectoryApiList{{Address: "https://rpc1.com/"}, {Address: "https://rpc2.com"}},
+			Rest: responses.CosmosDirectoryApiList{{Address: "https://rest1.com"}, {Address: ""}},
+			Grpc: responses.CosmosDirectoryApiList{{Address: "grpc.com:9090"}},
+		},
+		BestApis: responses.CosmosDirectoryApis{
+			RPC: responses.CosmosDirectoryApiList{{Address: "https://rpc2.com"}},
+		},
+	}
+
+	require.Equal(t, []string{"https://rpc2.com"}, chain.GetRPCNodes())
+	require.Equal(t, []string{"https://rest1.com"}, chain.GetRestNodes())
+	require.Equal(t, []string{"grpc.com:9090"}, chain.GetGrpcNodes())
+}
+
+func TestCosmosDirectoryChainGetExplorer(t *testing.T) {
+	t.Parallel()
+
+	chain := responses.CosmosDirectoryChain{
+		Explorers: []responses.CosmosDirectoryExplorer{
+			{Kind: "other", URL: "https://other.com"},
+			{Kind: "ping.pub", URL: "https://ping.pub/cosmos/"},
+		},
+	}
+
+	supportedExplorer, explorer := chain.GetExplorer()
+	require.Nil(t, explorer)
+	require.Equal(t, &types.PingExplorer{Prefix: "cosmos", BaseUrl: "https://ping.pub"}, supportedExplorer)
+
+	chain = responses.CosmosDirectoryChain{
+		Explorers: []responses.CosmosDirectoryExplorer{
+			{Kind: "other", URL: "https://other.com"},
+			{
+				Kind:          "explorer",
+				URL:           "https://explorer.com",
+				TxPage:        "https://explorer.com/tx/${txHash}",
+				AccountPage:   "https://explorer.com/account/${accountAddress}",
+				ValidatorPage: "https://explorer.com/validator/${validatorAddress}",
+				ProposalPage:  "https://explorer.com/proposal/${proposalId}",
+				BlockPage:     "https://explorer.com/block/${height}",
+			},
+		},
+	}
+
+	supportedExplorer, explorer = chain.GetExplorer()
+	require.Nil(t, supportedExplorer)
+	require.Equal(t, &types.Explorer{
+		ProposalLinkPattern:    "https://explorer.com/proposal/%s",
+		WalletLinkPattern:      "https://explorer.com/account/%s",
+		ValidatorLinkPattern:   "https://explorer.com/validator/%s",
+		TransactionLinkPattern: "https://explorer.com/tx/%s",
+		BlockLinkPattern:       "https://explorer.com/block/%s",
+	}, explorer)
+
+	supportedExplorer, explorer = responses.CosmosDirectoryChain{}.GetExplorer()
+	require.Nil(t, supportedExplorer)
+	require.Nil(t, explorer)
+}
+
+func TestCosmosDirectoryChainGetDenomInfos(t *testing.T) {
+	t.Parallel()
+
+	chain := responses.CosmosDirectoryChain{
+		Assets: []responses.CosmosDirectoryAsset{
+			{Denom: "malformed"},
+			{
+				Denom:   "uatom",
+				Base:    responses.CosmosDirectoryAssetDenomInfo{Denom: "uatom"},
+				Display: responses.CosmosDirectoryAssetDenomInfo{Denom: "atom", Exponent: 6},
+			},
+		},
+	}
+
+	denoms := chain.GetDenomInfos()
+	require.Len(t, denoms, 1)
+	require.Equal(t, "uatom", denoms[0].Denom)
+}
@@ -0,0 +1,5 @@
+package responses
+
+type OsmosisSpotPriceResponse struct {
+	SpotPrice string `json:"spot_price"`
+}
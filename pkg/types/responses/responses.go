@@ -122,3 +122,19 @@ func (duration *Duration) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+type CoingeckoExchangeRatesResponse struct {
+	Rates map[string]CoingeckoExchangeRate `json:"rates"`
+}
+
+type CoingeckoExchangeRate struct {
+	Name  string  `json:"name"`
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+	Type  string  `json:"type"`
+}
+
+// CoingeckoMarketChartResponse has prices as [timestamp in milliseconds, price] pairs.
+type CoingeckoMarketChartResponse struct {
+	Prices [][]float64 `json:"prices"`
+}
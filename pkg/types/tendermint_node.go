@@ -0,0 +1,15 @@
+package types
+
+import "time"
+
+// TendermintNode is a source of reports from a single Tendermint node,
+// either subscribed to via websocket or polled via RPC.
+type TendermintNode interface {
+	Listen()
+	Stop()
+	Status() TendermintRPCStatus
+	GetURL() string
+	GetChannel() chan Report
+	GetLastBlock() (int64, time.Time)
+	IsStandby() bool
+}
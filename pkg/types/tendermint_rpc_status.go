@@ -1,6 +1,31 @@
 package types
 
+import "time"
+
 type TendermintRPCStatus struct {
 	Success bool
+	Standby bool
 	Error   error
 }
+
+// ApiNodeStatus is a snapshot of an API node's health, based on its recent queries.
+type ApiNodeStatus struct {
+	Score       float64
+	Latency     time.Duration
+	ErrorRate   float64
+	Queries     int64
+	CircuitOpen bool
+}
+
+type ChainNodesStatus struct {
+	TendermintNodes map[string]TendermintRPCStatus
+	ApiNodes        map[string]ApiNodeStatus
+}
+
+func (s ApiNodeStatus) GetErrorRatePercent() float64 {
+	return s.ErrorRate * 100
+}
+
+func (s ApiNodeStatus) GetLatencyRounded() time.Duration {
+	return s.Latency.Round(time.Millisecond)
+}
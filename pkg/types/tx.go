@@ -1,19 +1,38 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"main/pkg/config/types"
+	"main/pkg/types/amount"
+	"main/pkg/types/event"
+
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 )
 
 type Tx struct {
 	Hash          types.Link
 	Memo          string
 	Height        types.Link
+	Chain         *types.Chain
 	MessagesCount int
 	Code          uint32
 	Log           string
+	Fee           amount.Amounts
+	GasWanted     int64
+	GasUsed       int64
+	Signers       []string
+	Events        event.EventValues
+
+	// Backfilled is set for transactions fetched via /tx_search after reconnecting
+	// to a node, instead of being received via websocket.
+	Backfilled bool
+
+	// Time is the block time, or zero if it's not known.
+	Time time.Time
 
 	Messages []Message
 }
@@ -30,9 +49,55 @@ func (tx *Tx) GetHash() string {
 	return tx.Hash.Value
 }
 
-func (tx *Tx) GetAdditionalData(fetcher DataFetcher, subscriptionName string) {
+func (tx *Tx) GetAdditionalData(ctx context.Context, fetcher DataFetcher, subscriptionName string) {
+	// fetching prices and validators for all messages at once, so messages
+	// would take them from cache instead of each querying them separately
+	if tx.Chain != nil {
+		fetcher.PrefetchPrices(ctx, tx.Chain.ChainID, tx.GetAllAmounts())
+		fetcher.PrefetchValidators(ctx, tx.Chain, tx.GetAllValidators())
+	}
+
 	for _, msg := range tx.Messages {
-		msg.GetAdditionalData(fetcher, subscriptionName)
+		msg.GetAdditionalData(ctx, fetcher, subscriptionName)
+	}
+
+	if tx.Time.IsZero() || tx.Chain == nil {
+		return
+	}
+
+	fetcher.PopulateHistoricalPrices(ctx, tx.Chain.ChainID, tx.GetAllAmounts(), tx.Time)
+}
+
+// GetAllAmounts returns amounts of all tx messages, including the ones inside them (like in MsgExec).
+func (tx *Tx) GetAllAmounts() amount.Amounts {
+	amounts := amount.Amounts{}
+
+	walkMessages(tx.Messages, func(msg Message) {
+		amounts = append(amounts, msg.GetAmounts()...)
+	})
+
+	return amounts
+}
+
+// GetAllValidators returns validators referenced by all tx messages, including the ones inside them.
+func (tx *Tx) GetAllValidators() []string {
+	validators := []string{}
+
+	walkMessages(tx.Messages, func(msg Message) {
+		validators = append(validators, msg.GetValidators()...)
+	})
+
+	return validators
+}
+
+func walkMessages(messages []Message, callback func(msg Message)) {
+	for _, msg := range messages {
+		if msg == nil {
+			continue
+		}
+
+		callback(msg)
+		walkMessages(msg.GetParsedMessages(), callback)
 	}
 }
 
@@ -43,3 +108,28 @@ func (tx *Tx) GetMessagesLabel() string {
 
 	return fmt.Sprintf("%d, %d skipped", tx.MessagesCount, tx.MessagesCount-len(tx.Messages))
 }
+
+// GetValues returns transaction-level values to match tx-filters against:
+// its own fields (like tx.memo or tx.code) and all the events emitted by it.
+func (tx *Tx) GetValues() event.EventValues {
+	values := []event.EventValue{
+		event.From(cosmosTypes.EventTypeTx, "hash", tx.Hash.Value),
+		event.From(cosmosTypes.EventTypeTx, "height", tx.Height.Value),
+		event.From(cosmosTypes.EventTypeTx, "memo", tx.Memo),
+		event.From(cosmosTypes.EventTypeTx, "code", strconv.FormatUint(uint64(tx.Code), 10)),
+		event.From(cosmosTypes.EventTypeTx, "gas_wanted", strconv.FormatInt(tx.GasWanted, 10)),
+		event.From(cosmosTypes.EventTypeTx, "gas_used", strconv.FormatInt(tx.GasUsed, 10)),
+		event.From(cosmosTypes.EventTypeTx, "messages_count", strconv.Itoa(tx.MessagesCount)),
+		event.From(cosmosTypes.EventTypeTx, "signers_count", strconv.Itoa(len(tx.Signers))),
+	}
+
+	if len(tx.Fee) > 0 {
+		values = append(values, event.From(cosmosTypes.EventTypeTx, cosmosTypes.AttributeKeyFee, tx.Fee.String()))
+	}
+
+	for _, signer := range tx.Signers {
+		values = append(values, event.From(cosmosTypes.EventTypeTx, "signer", signer))
+	}
+
+	return append(values, tx.Events...)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"github.com/google/uuid"
 )
 
@@ -20,5 +21,5 @@ func (txError *TxError) GetHash() string {
 	return uuid.NewString()
 }
 
-func (txError *TxError) GetAdditionalData(fetcher DataFetcher, subscriptionName string) {
+func (txError *TxError) GetAdditionalData(ctx context.Context, fetcher DataFetcher, subscriptionName string) {
 }
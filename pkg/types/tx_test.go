@@ -1,11 +1,33 @@
 package types
 
 import (
+	"context"
+	"main/pkg/config/types"
+	"main/pkg/types/amount"
+	"main/pkg/types/event"
 	"testing"
 
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/stretchr/testify/require"
 )
 
+type StubMessage struct {
+	Amounts    amount.Amounts
+	Validators []string
+	Messages   []Message
+}
+
+func (m *StubMessage) Type() string                                                       { return "stub" }
+func (m *StubMessage) GetAdditionalData(_ context.Context, fetcher DataFetcher, _ string) {}
+func (m *StubMessage) GetValues() event.EventValues                                       { return event.EventValues{} }
+func (m *StubMessage) GetAmounts() amount.Amounts                                         { return m.Amounts }
+func (m *StubMessage) GetValidators() []string                                            { return m.Validators }
+func (m *StubMessage) GetRawMessages() []*codecTypes.Any                                  { return []*codecTypes.Any{} }
+func (m *StubMessage) AddParsedMessage(message Message)                                   {}
+func (m *StubMessage) SetParsedMessages(messages []Message)                               {}
+func (m *StubMessage) WithParsedMessages(messages []Message) Message                      { return m }
+func (m *StubMessage) GetParsedMessages() []Message                                       { return m.Messages }
+
 func TestTxGetMessagesLabel(t *testing.T) {
 	t.Parallel()
 
@@ -28,3 +50,60 @@ func TestTxGetMessagesLabel(t *testing.T) {
 
 	require.Equal(t, "2, 1 skipped", event2.GetMessagesLabel())
 }
+
+func TestTxGetValues(t *testing.T) {
+	t.Parallel()
+
+	tx := Tx{
+		Hash:          types.Link{Value: "hash"},
+		Height:        types.Link{Value: "123"},
+		Memo:          "memo",
+		Code:          5,
+		GasWanted:     200,
+		GasUsed:       100,
+		MessagesCount: 1,
+		Fee:           amount.Amounts{amount.AmountFromString("100", "uatom")},
+		Signers:       []string{"signer"},
+		Events: event.EventValues{
+			event.From("transfer", "recipient", "recipient"),
+		},
+	}
+
+	values := tx.GetValues().ToMap()
+	require.Equal(t, []string{"hash"}, values["tx.hash"])
+	require.Equal(t, []string{"123"}, values["tx.height"])
+	require.Equal(t, []string{"memo"}, values["tx.memo"])
+	require.Equal(t, []string{"5"}, values["tx.code"])
+	require.Equal(t, []string{"200"}, values["tx.gas_wanted"])
+	require.Equal(t, []string{"100"}, values["tx.gas_used"])
+	require.Equal(t, []string{"1"}, values["tx.messages_count"])
+	require.Equal(t, []string{"1"}, values["tx.signers_count"])
+	require.Equal(t, []string{"100uatom"}, values["tx.fee"])
+	require.Equal(t, []string{"signer"}, values["tx.signer"])
+	require.Equal(t, []string{"recipient"}, values["transfer.recipient"])
+}
+
+func TestTxGetAllAmountsAndValidators(t *testing.T) {
+	t.Parallel()
+
+	tx := Tx{
+		Messages: []Message{
+			&StubMessage{
+				Amounts:    amount.Amounts{amount.AmountFromString("100", "uatom")},
+				Validators: []string{"validator1"},
+			},
+			nil,
+			&StubMessage{
+				Messages: []Message{
+					&StubMessage{
+						Amounts:    amount.Amounts{amount.AmountFromString("200", "uosmo")},
+						Validators: []string{"validator2", "validator3"},
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, "100uatom,200uosmo", tx.GetAllAmounts().String())
+	require.Equal(t, []string{"validator1", "validator2", "validator3"}, tx.GetAllValidators())
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"github.com/google/uuid"
 )
 
@@ -18,5 +19,5 @@ func (e *UnsupportedReportable) GetHash() string {
 	return uuid.NewString()
 }
 
-func (e *UnsupportedReportable) GetAdditionalData(fetcher DataFetcher, subscriptionName string) {
+func (e *UnsupportedReportable) GetAdditionalData(ctx context.Context, fetcher DataFetcher, subscriptionName string) {
 }
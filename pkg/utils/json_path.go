@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONPath is a parsed JSONPath selector, a list of object keys (strings)
+// and array indexes (ints) to go through.
+type JSONPath []interface{}
+
+// ParseJSONPath parses a JSONPath subset: the root object ($), child keys (.key or ['key'])
+// and array indexes ([0]), like $.data[0]['price'].
+func ParseJSONPath(path string) (JSONPath, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("JSON path should start with $")
+	}
+
+	result := JSONPath{}
+	rest := path[1:]
+
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("empty key in JSON path %s", path)
+			}
+
+			result = append(result, key)
+			rest = rest[end+1:]
+		case '[':
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("unclosed bracket in JSON path %s", path)
+			}
+
+			selector := rest[1:end]
+			rest = rest[end+1:]
+
+			if len(selector) >= 2 &&
+				(selector[0] == '\'' || selector[0] == '"') &&
+				selector[len(selector)-1] == selector[0] {
+				result = append(result, selector[1:len(selector)-1])
+				continue
+			}
+
+			index, err := strconv.Atoi(selector)
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid array index %s in JSON path %s", selector, path)
+			}
+
+			result = append(result, index)
+		default:
+			return nil, fmt.Errorf("unexpected character %c in JSON path %s", rest[0], path)
+		}
+	}
+
+	return result, nil
+}
+
+// Get returns the value the path points to in a decoded JSON value.
+func (p JSONPath) Get(value interface{}) (interface{}, error) {
+	for _, selector := range p {
+		switch typedSelector := selector.(type) {
+		case string:
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected object to get key %s, got %T", typedSelector, value)
+			}
+
+			if value, ok = object[typedSelector]; !ok {
+				return nil, fmt.Errorf("key %s not found", typedSelector)
+			}
+		case int:
+			array, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected array to get index %d, got %T", typedSelector, value)
+			}
+
+			if typedSelector >= len(array) {
+				return nil, fmt.Errorf("index %d is out of range", typedSelector)
+			}
+
+			value = array[typedSelector]
+		}
+	}
+
+	return value, nil
+}
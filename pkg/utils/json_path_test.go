@@ -0,0 +1,65 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"main/pkg/utils"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONPathInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{
+		"",
+		"data.price",
+		"$..price",
+		"$.data[0",
+		"$.data[-1]",
+		"$.data[abc]",
+		"$data",
+	} {
+		_, err := utils.ParseJSONPath(path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestParseJSONPathValid(t *testing.T) {
+	t.Parallel()
+
+	path, err := utils.ParseJSONPath("$.data[0]['usd price'][\"value\"].amount")
+	require.NoError(t, err)
+	require.Equal(t, utils.JSONPath{"data", 0, "usd price", "value", "amount"}, path)
+
+	root, err := utils.ParseJSONPath("$")
+	require.NoError(t, err)
+	require.Empty(t, root)
+}
+
+func TestJSONPathGet(t *testing.T) {
+	t.Parallel()
+
+	var value interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"data":[{"price":"1.5"},{"price":2}]}`), &value))
+
+	path, err := utils.ParseJSONPath("$.data[1].price")
+	require.NoError(t, err)
+
+	result, err := path.Get(value)
+	require.NoError(t, err)
+	require.InDelta(t, 2, result, 0.001)
+
+	for _, invalidPath := range []string{
+		"$.missing",
+		"$.data.price",
+		"$.data[2]",
+		"$.data[0][0]",
+	} {
+		path, err := utils.ParseJSONPath(invalidPath)
+		require.NoError(t, err)
+
+		_, err = path.Get(value)
+		require.Error(t, err, invalidPath)
+	}
+}
@@ -24,6 +24,21 @@ func Contains[T comparable](slice []T, value T) bool {
 	return false
 }
 
+// Dedup returns the slice values without duplicates, keeping the order of their first occurrences.
+func Dedup[T comparable](slice []T) []T {
+	seen := make(map[T]bool, len(slice))
+	result := make([]T, 0, len(slice))
+
+	for _, elt := range slice {
+		if !seen[elt] {
+			seen[elt] = true
+			result = append(result, elt)
+		}
+	}
+
+	return result
+}
+
 func RemoveFirstSlash(str string) string {
 	if len(str) == 0 {
 		return str
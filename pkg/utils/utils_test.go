@@ -39,6 +39,13 @@ func TestContains(t *testing.T) {
 	require.False(t, utils.Contains(source, 4))
 }
 
+func TestDedup(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"b", "a", "c"}, utils.Dedup([]string{"b", "a", "b", "c", "a"}))
+	require.Empty(t, utils.Dedup([]string{}))
+}
+
 func TestRemoveFirstSlash(t *testing.T) {
 	t.Parallel()
 